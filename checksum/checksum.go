@@ -0,0 +1,49 @@
+package checksum
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// FileSummer computes hex-encoded digests of files. Unlike the summers in
+// go-pivnet it also exposes the underlying hash, so callers can digest bytes
+// as they arrive instead of reading the file back from disk.
+type FileSummer struct {
+	newHash func() hash.Hash
+}
+
+func NewSHA256FileSummer() *FileSummer {
+	return &FileSummer{
+		newHash: sha256.New,
+	}
+}
+
+func NewMD5FileSummer() *FileSummer {
+	return &FileSummer{
+		newHash: md5.New,
+	}
+}
+
+func (f FileSummer) NewHash() hash.Hash {
+	return f.newHash()
+}
+
+func (f FileSummer) SumFile(filepath string) (string, error) {
+	fileToSum, err := os.Open(filepath)
+	if err != nil {
+		return "", err
+	}
+	defer fileToSum.Close()
+
+	h := f.newHash()
+	_, err = io.Copy(h, fileToSum)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
@@ -0,0 +1,81 @@
+package checksum_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/checksum"
+)
+
+var _ = Describe("FileSummer", func() {
+	const (
+		fileContents   = "file-contents"
+		expectedSHA256 = "f03779b36bece74893fd6533a67549675e21573eb0e288d87158738f9c24594e"
+		expectedMD5    = "53a08cf9217fc1c69a90fd77c4d765db"
+	)
+
+	var (
+		tempFilePath string
+	)
+
+	BeforeEach(func() {
+		tempFile, err := ioutil.TempFile("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tempFile.WriteString(fileContents)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = tempFile.Close()
+		Expect(err).NotTo(HaveOccurred())
+
+		tempFilePath = tempFile.Name()
+	})
+
+	AfterEach(func() {
+		err := os.Remove(tempFilePath)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("SHA256", func() {
+		It("sums the file", func() {
+			sum, err := checksum.NewSHA256FileSummer().SumFile(tempFilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sum).To(Equal(expectedSHA256))
+		})
+
+		It("returns a hash that produces the same sum", func() {
+			h := checksum.NewSHA256FileSummer().NewHash()
+			_, err := h.Write([]byte(fileContents))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fmt.Sprintf("%x", h.Sum(nil))).To(Equal(expectedSHA256))
+		})
+	})
+
+	Describe("MD5", func() {
+		It("sums the file", func() {
+			sum, err := checksum.NewMD5FileSummer().SumFile(tempFilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sum).To(Equal(expectedMD5))
+		})
+
+		It("returns a hash that produces the same sum", func() {
+			h := checksum.NewMD5FileSummer().NewHash()
+			_, err := h.Write([]byte(fileContents))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fmt.Sprintf("%x", h.Sum(nil))).To(Equal(expectedMD5))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		It("returns an error", func() {
+			_, err := checksum.NewSHA256FileSummer().SumFile("/not/a/valid/filepath")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
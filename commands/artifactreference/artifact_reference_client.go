@@ -23,6 +23,7 @@ type PivnetClient interface {
 	ArtifactReference(productSlug string, artifactReferenceID int) (pivnet.ArtifactReference, error)
 	ArtifactReferenceForRelease(productSlug string, releaseID int, artifactReferenceID int) (pivnet.ArtifactReference, error)
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	CreateArtifactReference(config pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error)
 	DeleteArtifactReference(productSlug string, releaseID int) (pivnet.ArtifactReference, error)
 	AddArtifactReferenceToRelease(productSlug string, artifactReferenceID int, releaseID int) error
@@ -111,7 +112,7 @@ func (c *ArtifactReferenceClient) List(productSlug string, releaseVersion string
 		return c.printArtifactReferences(artifactReferences)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -236,7 +237,7 @@ func (c *ArtifactReferenceClient) Get(
 		return c.printArtifactReference(artifactReference)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
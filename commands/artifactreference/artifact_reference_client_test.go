@@ -136,7 +136,7 @@ var _ = Describe("artifactreference commands", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("releases error")
-					fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+					fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 				})
 
 				It("invokes the error handler", func() {
@@ -309,7 +309,7 @@ var _ = Describe("artifactreference commands", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("releases error")
-					fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+					fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 				})
 
 				It("invokes the error handler", func() {
@@ -119,6 +119,20 @@ type FakePivnetClient struct {
 		result1 pivnet.ArtifactReference
 		result2 error
 	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -172,15 +186,16 @@ func (fake *FakePivnetClient) AddArtifactReferenceToRelease(arg1 string, arg2 in
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddArtifactReferenceToReleaseStub
+	fakeReturns := fake.addArtifactReferenceToReleaseReturns
 	fake.recordInvocation("AddArtifactReferenceToRelease", []interface{}{arg1, arg2, arg3})
 	fake.addArtifactReferenceToReleaseMutex.Unlock()
-	if fake.AddArtifactReferenceToReleaseStub != nil {
-		return fake.AddArtifactReferenceToReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addArtifactReferenceToReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -233,15 +248,16 @@ func (fake *FakePivnetClient) ArtifactReference(arg1 string, arg2 int) (pivnet.A
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.ArtifactReferenceStub
+	fakeReturns := fake.artifactReferenceReturns
 	fake.recordInvocation("ArtifactReference", []interface{}{arg1, arg2})
 	fake.artifactReferenceMutex.Unlock()
-	if fake.ArtifactReferenceStub != nil {
-		return fake.ArtifactReferenceStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.artifactReferenceReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -298,15 +314,16 @@ func (fake *FakePivnetClient) ArtifactReferenceForRelease(arg1 string, arg2 int,
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.ArtifactReferenceForReleaseStub
+	fakeReturns := fake.artifactReferenceForReleaseReturns
 	fake.recordInvocation("ArtifactReferenceForRelease", []interface{}{arg1, arg2, arg3})
 	fake.artifactReferenceForReleaseMutex.Unlock()
-	if fake.ArtifactReferenceForReleaseStub != nil {
-		return fake.ArtifactReferenceForReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.artifactReferenceForReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -361,15 +378,16 @@ func (fake *FakePivnetClient) ArtifactReferences(arg1 string) ([]pivnet.Artifact
 	fake.artifactReferencesArgsForCall = append(fake.artifactReferencesArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.ArtifactReferencesStub
+	fakeReturns := fake.artifactReferencesReturns
 	fake.recordInvocation("ArtifactReferences", []interface{}{arg1})
 	fake.artifactReferencesMutex.Unlock()
-	if fake.ArtifactReferencesStub != nil {
-		return fake.ArtifactReferencesStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.artifactReferencesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -425,15 +443,16 @@ func (fake *FakePivnetClient) ArtifactReferencesForDigest(arg1 string, arg2 stri
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ArtifactReferencesForDigestStub
+	fakeReturns := fake.artifactReferencesForDigestReturns
 	fake.recordInvocation("ArtifactReferencesForDigest", []interface{}{arg1, arg2})
 	fake.artifactReferencesForDigestMutex.Unlock()
-	if fake.ArtifactReferencesForDigestStub != nil {
-		return fake.ArtifactReferencesForDigestStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.artifactReferencesForDigestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -489,15 +508,16 @@ func (fake *FakePivnetClient) ArtifactReferencesForRelease(arg1 string, arg2 int
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.ArtifactReferencesForReleaseStub
+	fakeReturns := fake.artifactReferencesForReleaseReturns
 	fake.recordInvocation("ArtifactReferencesForRelease", []interface{}{arg1, arg2})
 	fake.artifactReferencesForReleaseMutex.Unlock()
-	if fake.ArtifactReferencesForReleaseStub != nil {
-		return fake.ArtifactReferencesForReleaseStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.artifactReferencesForReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -552,15 +572,16 @@ func (fake *FakePivnetClient) CreateArtifactReference(arg1 pivnet.CreateArtifact
 	fake.createArtifactReferenceArgsForCall = append(fake.createArtifactReferenceArgsForCall, struct {
 		arg1 pivnet.CreateArtifactReferenceConfig
 	}{arg1})
+	stub := fake.CreateArtifactReferenceStub
+	fakeReturns := fake.createArtifactReferenceReturns
 	fake.recordInvocation("CreateArtifactReference", []interface{}{arg1})
 	fake.createArtifactReferenceMutex.Unlock()
-	if fake.CreateArtifactReferenceStub != nil {
-		return fake.CreateArtifactReferenceStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createArtifactReferenceReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -616,15 +637,16 @@ func (fake *FakePivnetClient) DeleteArtifactReference(arg1 string, arg2 int) (pi
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.DeleteArtifactReferenceStub
+	fakeReturns := fake.deleteArtifactReferenceReturns
 	fake.recordInvocation("DeleteArtifactReference", []interface{}{arg1, arg2})
 	fake.deleteArtifactReferenceMutex.Unlock()
-	if fake.DeleteArtifactReferenceStub != nil {
-		return fake.DeleteArtifactReferenceStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteArtifactReferenceReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -673,6 +695,71 @@ func (fake *FakePivnetClient) DeleteArtifactReferenceReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -680,15 +767,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -745,15 +833,16 @@ func (fake *FakePivnetClient) RemoveArtifactReferenceFromRelease(arg1 string, ar
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveArtifactReferenceFromReleaseStub
+	fakeReturns := fake.removeArtifactReferenceFromReleaseReturns
 	fake.recordInvocation("RemoveArtifactReferenceFromRelease", []interface{}{arg1, arg2, arg3})
 	fake.removeArtifactReferenceFromReleaseMutex.Unlock()
-	if fake.RemoveArtifactReferenceFromReleaseStub != nil {
-		return fake.RemoveArtifactReferenceFromReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeArtifactReferenceFromReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -806,15 +895,16 @@ func (fake *FakePivnetClient) UpdateArtifactReference(arg1 string, arg2 pivnet.A
 		arg1 string
 		arg2 pivnet.ArtifactReference
 	}{arg1, arg2})
+	stub := fake.UpdateArtifactReferenceStub
+	fakeReturns := fake.updateArtifactReferenceReturns
 	fake.recordInvocation("UpdateArtifactReference", []interface{}{arg1, arg2})
 	fake.updateArtifactReferenceMutex.Unlock()
-	if fake.UpdateArtifactReferenceStub != nil {
-		return fake.UpdateArtifactReferenceStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateArtifactReferenceReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -882,6 +972,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.createArtifactReferenceMutex.RUnlock()
 	fake.deleteArtifactReferenceMutex.RLock()
 	defer fake.deleteArtifactReferenceMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
 	fake.removeArtifactReferenceFromReleaseMutex.RLock()
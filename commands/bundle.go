@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"io"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/bundle"
+)
+
+type ExportReleaseCommand struct {
+	ProductSlug    string `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
+	ReleaseVersion string `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1" required:"true"`
+	Output         string `long:"output" short:"o" description:"Path of the bundle to write e.g. bundle.tar" required:"true"`
+	AcceptEULA     bool   `long:"accept-eula" description:"Automatically accept EULA if necessary (Available for pivots only)"`
+}
+
+type VerifyBundleCommand struct {
+	Bundle string `long:"bundle" short:"b" description:"Path of the bundle to verify e.g. bundle.tar" required:"true"`
+}
+
+//go:generate counterfeiter . BundleClient
+type BundleClient interface {
+	Export(productSlug string, releaseVersion string, outputPath string, acceptEULA bool, progressWriter io.Writer) error
+	Verify(bundlePath string) error
+}
+
+var NewBundleClient = func(client bundle.PivnetClient, downloader bundle.Downloader) BundleClient {
+	return bundle.NewBundleClient(
+		client,
+		downloader,
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+		Pivnet.Logger,
+	)
+}
+
+func (command *ExportReleaseCommand) Execute([]string) error {
+	err := Init(true)
+	if err != nil {
+		return err
+	}
+
+	client := NewPivnetClient()
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	return NewBundleClient(client, NewProductFileClient(client)).Export(
+		command.ProductSlug,
+		command.ReleaseVersion,
+		command.Output,
+		command.AcceptEULA,
+		LogWriter,
+	)
+}
+
+// Execute verifies a bundle without contacting Pivnet, so it does not
+// require a profile or authentication.
+func (command *VerifyBundleCommand) Execute([]string) error {
+	err := Init(false)
+	if err != nil {
+		return err
+	}
+
+	return NewBundleClient(nil, nil).Verify(command.Bundle)
+}
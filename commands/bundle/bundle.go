@@ -0,0 +1,174 @@
+package bundle
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+)
+
+const (
+	metadataFileName = "metadata.json"
+	filesDir         = "files"
+)
+
+// Metadata describes a release as it was on Pivnet when the bundle was
+// exported. It is stored in the bundle next to the product files.
+type Metadata struct {
+	ProductSlug          string                       `json:"product_slug" yaml:"product_slug"`
+	Release              pivnet.Release               `json:"release" yaml:"release"`
+	EULA                 *pivnet.EULA                 `json:"eula,omitempty" yaml:"eula,omitempty"`
+	ProductFiles         []pivnet.ProductFile         `json:"product_files" yaml:"product_files"`
+	FileGroups           []pivnet.FileGroup           `json:"file_groups" yaml:"file_groups"`
+	ArtifactReferences   []pivnet.ArtifactReference   `json:"artifact_references" yaml:"artifact_references"`
+	ReleaseDependencies  []pivnet.ReleaseDependency   `json:"release_dependencies" yaml:"release_dependencies"`
+	DependencySpecifiers []pivnet.DependencySpecifier `json:"dependency_specifiers" yaml:"dependency_specifiers"`
+	ReleaseUpgradePaths  []pivnet.ReleaseUpgradePath  `json:"release_upgrade_paths" yaml:"release_upgrade_paths"`
+	Files                []File                       `json:"files" yaml:"files"`
+}
+
+// File is a product file stored in the bundle.
+type File struct {
+	ProductFileID int    `json:"product_file_id" yaml:"product_file_id"`
+	Path          string `json:"path" yaml:"path"`
+	Size          int64  `json:"size" yaml:"size"`
+	SHA256        string `json:"sha256" yaml:"sha256"`
+}
+
+// writer writes a bundle as a tar archive. Product files are written first
+// and hashed on the way in; the metadata, which records those hashes, is
+// written last.
+type writer struct {
+	tw       *tar.Writer
+	metadata Metadata
+}
+
+func newWriter(w io.Writer, metadata Metadata) *writer {
+	return &writer{
+		tw:       tar.NewWriter(w),
+		metadata: metadata,
+	}
+}
+
+func (w *writer) addFile(productFileID int, name string, localFilepath string) error {
+	file, err := os.Open(localFilepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	bundlePath := path.Join(filesDir, name)
+
+	err = w.tw.WriteHeader(&tar.Header{
+		Name:    bundlePath,
+		Mode:    0644,
+		Size:    stat.Size(),
+		ModTime: stat.ModTime(),
+	})
+	if err != nil {
+		return err
+	}
+
+	h := sha256.New()
+	_, err = io.Copy(w.tw, io.TeeReader(file, h))
+	if err != nil {
+		return err
+	}
+
+	w.metadata.Files = append(w.metadata.Files, File{
+		ProductFileID: productFileID,
+		Path:          bundlePath,
+		Size:          stat.Size(),
+		SHA256:        fmt.Sprintf("%x", h.Sum(nil)),
+	})
+
+	return nil
+}
+
+func (w *writer) close() error {
+	b, err := json.MarshalIndent(w.metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = w.tw.WriteHeader(&tar.Header{
+		Name:    metadataFileName,
+		Mode:    0644,
+		Size:    int64(len(b)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.tw.Write(b)
+	if err != nil {
+		return err
+	}
+
+	return w.tw.Close()
+}
+
+// readBundle reads the metadata of a bundle and the SHA256 and size of every
+// product file in it, without extracting anything.
+func readBundle(r io.Reader) (Metadata, map[string]File, error) {
+	tr := tar.NewReader(r)
+
+	var metadata *Metadata
+	files := map[string]File{}
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Metadata{}, nil, err
+		}
+
+		switch {
+		case header.Name == metadataFileName:
+			b, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return Metadata{}, nil, err
+			}
+
+			metadata = &Metadata{}
+			err = json.Unmarshal(b, metadata)
+			if err != nil {
+				return Metadata{}, nil, fmt.Errorf("could not parse %s: %s", metadataFileName, err.Error())
+			}
+		case strings.HasPrefix(header.Name, filesDir+"/"):
+			h := sha256.New()
+			size, err := io.Copy(h, tr)
+			if err != nil {
+				return Metadata{}, nil, err
+			}
+
+			files[header.Name] = File{
+				Path:   header.Name,
+				Size:   size,
+				SHA256: fmt.Sprintf("%x", h.Sum(nil)),
+			}
+		}
+	}
+
+	if metadata == nil {
+		return Metadata{}, nil, fmt.Errorf("bundle does not contain %s", metadataFileName)
+	}
+
+	return *metadata, files, nil
+}
@@ -0,0 +1,404 @@
+package bundle
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+const (
+	StatusOK         = "ok"
+	StatusMismatch   = "checksum mismatch"
+	StatusMissing    = "missing"
+	StatusUnexpected = "unexpected"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ProductFilesForRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error)
+	FileGroupsForRelease(productSlug string, releaseID int) ([]pivnet.FileGroup, error)
+	ArtifactReferencesForRelease(productSlug string, releaseID int) ([]pivnet.ArtifactReference, error)
+	EULA(eulaSlug string) (pivnet.EULA, error)
+	ReleaseDependencies(productSlug string, releaseID int) ([]pivnet.ReleaseDependency, error)
+	DependencySpecifiers(productSlug string, releaseID int) ([]pivnet.DependencySpecifier, error)
+	ReleaseUpgradePaths(productSlug string, releaseID int) ([]pivnet.ReleaseUpgradePath, error)
+}
+
+//go:generate counterfeiter . Downloader
+type Downloader interface {
+	Download(productSlug string, releaseVersion string, globs []string, productFileIDs []int, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) ([]string, error)
+}
+
+type BundleClient struct {
+	pivnetClient PivnetClient
+	downloader   Downloader
+	eh           errorhandler.ErrorHandler
+	format       string
+	outputWriter io.Writer
+	printer      printer.Printer
+	l            logger.Logger
+}
+
+func NewBundleClient(
+	pivnetClient PivnetClient,
+	downloader Downloader,
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+	l logger.Logger,
+) *BundleClient {
+	return &BundleClient{
+		pivnetClient: pivnetClient,
+		downloader:   downloader,
+		eh:           eh,
+		format:       format,
+		outputWriter: outputWriter,
+		printer:      printer,
+		l:            l,
+	}
+}
+
+// VerifyResult is the outcome of verifying a bundle.
+type VerifyResult struct {
+	ProductSlug string         `json:"product_slug" yaml:"product_slug"`
+	Release     pivnet.Release `json:"release" yaml:"release"`
+	Files       []FileResult   `json:"files" yaml:"files"`
+}
+
+type FileResult struct {
+	ProductFileID int    `json:"product_file_id,omitempty" yaml:"product_file_id,omitempty"`
+	Path          string `json:"path" yaml:"path"`
+	SHA256        string `json:"sha256" yaml:"sha256"`
+	Status        string `json:"status" yaml:"status"`
+}
+
+// Export downloads every product file of a release and writes them, together
+// with the metadata of the release, to a single tar archive at outputPath.
+// The archive is only moved into place once it is complete.
+func (c *BundleClient) Export(
+	productSlug string,
+	releaseVersion string,
+	outputPath string,
+	acceptEULA bool,
+	progressWriter io.Writer,
+) error {
+	metadata, err := c.metadataFor(productSlug, releaseVersion)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	outputDir := filepath.Dir(outputPath)
+
+	downloadDir, err := ioutil.TempDir(outputDir, ".pivnet-export-")
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+	defer os.RemoveAll(downloadDir)
+
+	if len(metadata.ProductFiles) > 0 {
+		var productFileIDs []int
+		for _, pf := range metadata.ProductFiles {
+			productFileIDs = append(productFileIDs, pf.ID)
+		}
+
+		_, err = c.downloader.Download(
+			productSlug,
+			metadata.Release.Version,
+			nil,
+			productFileIDs,
+			downloadDir,
+			acceptEULA,
+			progressWriter,
+			productfile.DownloadOptions{},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	tmpFile, err := ioutil.TempFile(outputDir, ".pivnet-bundle-")
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	err = c.writeBundle(tmpFile, metadata, downloadDir)
+	closeErr := tmpFile.Close()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+	if closeErr != nil {
+		return c.eh.HandleError(closeErr)
+	}
+
+	// TempFile creates the file readable only by its owner.
+	err = os.Chmod(tmpFile.Name(), 0644)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	err = os.Rename(tmpFile.Name(), outputPath)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	c.l.Info(fmt.Sprintf(
+		"Exported %s %s to '%s'",
+		productSlug,
+		metadata.Release.Version,
+		outputPath,
+	))
+
+	return nil
+}
+
+func (c *BundleClient) metadataFor(productSlug string, releaseVersion string) (Metadata, error) {
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata := Metadata{
+		ProductSlug: productSlug,
+		Release:     release,
+	}
+
+	if release.EULA != nil && release.EULA.Slug != "" {
+		eula, err := c.pivnetClient.EULA(release.EULA.Slug)
+		if err != nil {
+			return Metadata{}, err
+		}
+		metadata.EULA = &eula
+	}
+
+	productFiles, err := c.pivnetClient.ProductFilesForRelease(productSlug, release.ID)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata.ProductFiles = productfile.UniqueProductFiles(productFiles)
+
+	metadata.FileGroups, err = c.pivnetClient.FileGroupsForRelease(productSlug, release.ID)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata.ArtifactReferences, err = c.pivnetClient.ArtifactReferencesForRelease(productSlug, release.ID)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata.ReleaseDependencies, err = c.pivnetClient.ReleaseDependencies(productSlug, release.ID)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata.DependencySpecifiers, err = c.pivnetClient.DependencySpecifiers(productSlug, release.ID)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata.ReleaseUpgradePaths, err = c.pivnetClient.ReleaseUpgradePaths(productSlug, release.ID)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	return metadata, nil
+}
+
+func (c *BundleClient) writeBundle(w io.Writer, metadata Metadata, downloadDir string) error {
+	bw := newWriter(w, metadata)
+
+	for _, pf := range metadata.ProductFiles {
+		name := fileNameFor(pf)
+		localFilepath := filepath.Join(downloadDir, name)
+
+		_, err := os.Stat(localFilepath)
+		if err != nil {
+			return fmt.Errorf("product file %d (%s) was not downloaded", pf.ID, pf.Name)
+		}
+
+		c.l.Debug("Adding product file to bundle", logger.Data{"id": pf.ID, "name": name})
+
+		err = bw.addFile(pf.ID, name, localFilepath)
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.close()
+}
+
+// Verify checks the SHA256 of every file in the bundle at bundlePath against
+// its metadata. It does not contact Pivnet.
+func (c *BundleClient) Verify(bundlePath string) error {
+	file, err := os.Open(bundlePath)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+	defer file.Close()
+
+	metadata, files, err := readBundle(file)
+	if err != nil {
+		return c.eh.HandleError(fmt.Errorf("could not read bundle '%s': %s", bundlePath, err.Error()))
+	}
+
+	result := verify(metadata, files)
+
+	err = c.printVerifyResult(result)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	var failed int
+	for _, f := range result.Files {
+		if f.Status != StatusOK {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return c.eh.HandleError(fmt.Errorf(
+			"%d of %d files in bundle failed verification",
+			failed,
+			len(result.Files),
+		))
+	}
+
+	return nil
+}
+
+func verify(metadata Metadata, files map[string]File) VerifyResult {
+	result := VerifyResult{
+		ProductSlug: metadata.ProductSlug,
+		Release:     metadata.Release,
+	}
+
+	expectedSHA256s := map[int]string{}
+	for _, pf := range metadata.ProductFiles {
+		expectedSHA256s[pf.ID] = pf.SHA256
+	}
+
+	recorded := map[string]bool{}
+	for _, expected := range metadata.Files {
+		recorded[expected.Path] = true
+
+		fileResult := FileResult{
+			ProductFileID: expected.ProductFileID,
+			Path:          expected.Path,
+			Status:        StatusOK,
+		}
+
+		actual, ok := files[expected.Path]
+		switch {
+		case !ok:
+			fileResult.Status = StatusMissing
+		case actual.SHA256 != expected.SHA256:
+			fileResult.SHA256 = actual.SHA256
+			fileResult.Status = StatusMismatch
+		case expectedSHA256s[expected.ProductFileID] != "" &&
+			!strings.EqualFold(actual.SHA256, expectedSHA256s[expected.ProductFileID]):
+			fileResult.SHA256 = actual.SHA256
+			fileResult.Status = StatusMismatch
+		default:
+			fileResult.SHA256 = actual.SHA256
+		}
+
+		result.Files = append(result.Files, fileResult)
+	}
+
+	var unexpected []string
+	for p := range files {
+		if !recorded[p] {
+			unexpected = append(unexpected, p)
+		}
+	}
+	sort.Strings(unexpected)
+
+	for _, p := range unexpected {
+		result.Files = append(result.Files, FileResult{
+			Path:   p,
+			SHA256: files[p].SHA256,
+			Status: StatusUnexpected,
+		})
+	}
+
+	return result
+}
+
+func (c *BundleClient) printVerifyResult(result VerifyResult) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"Slug",
+			"Release ID",
+			"Version",
+			"Release Date",
+			"EULA",
+		})
+
+		var eulaSlug string
+		if result.Release.EULA != nil {
+			eulaSlug = result.Release.EULA.Slug
+		}
+
+		table.Append([]string{
+			result.ProductSlug,
+			strconv.Itoa(result.Release.ID),
+			result.Release.Version,
+			result.Release.ReleaseDate,
+			eulaSlug,
+		})
+		table.Render()
+
+		table = tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"Product File ID",
+			"Path",
+			"SHA256",
+			"Status",
+		})
+
+		for _, f := range result.Files {
+			var id string
+			if f.ProductFileID != 0 {
+				id = strconv.Itoa(f.ProductFileID)
+			}
+
+			table.Append([]string{
+				id,
+				f.Path,
+				f.SHA256,
+				f.Status,
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(result)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(result)
+	}
+
+	return nil
+}
+
+func fileNameFor(pf pivnet.ProductFile) string {
+	parts := strings.Split(pf.AWSObjectKey, "/")
+	return parts[len(parts)-1]
+}
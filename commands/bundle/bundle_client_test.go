@@ -0,0 +1,486 @@
+package bundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/bundle"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/bundle/bundlefakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+var _ = Describe("bundle commands", func() {
+	var (
+		fakePivnetClient *bundlefakes.FakePivnetClient
+		fakeDownloader   *bundlefakes.FakeDownloader
+		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+
+		tempDir    string
+		bundlePath string
+
+		release      pivnet.Release
+		productFiles []pivnet.ProductFile
+		contents     map[string]string
+
+		client *bundle.BundleClient
+	)
+
+	sha256Of := func(s string) string {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		bundlePath = filepath.Join(tempDir, "bundle.tar")
+
+		fakePivnetClient = &bundlefakes.FakePivnetClient{}
+		fakeDownloader = &bundlefakes.FakeDownloader{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		outBuffer = bytes.Buffer{}
+
+		release = pivnet.Release{
+			ID:      1234,
+			Version: "1.2.3",
+			EULA: &pivnet.EULA{
+				Slug: "some-eula",
+			},
+		}
+
+		contents = map[string]string{
+			"some-tile.pivotal": "some tile contents",
+			"some-stemcell.tgz": "some stemcell contents",
+		}
+
+		productFiles = []pivnet.ProductFile{
+			{
+				ID:           2345,
+				Name:         "Some Tile",
+				AWSObjectKey: "/remote/path/some-tile.pivotal",
+				SHA256:       sha256Of("some tile contents"),
+			},
+			{
+				ID:           3456,
+				Name:         "Some Stemcell",
+				AWSObjectKey: "/remote/path/some-stemcell.tgz",
+			},
+		}
+
+		fakePivnetClient.ReleaseForConstraintReturns(release, nil)
+		fakePivnetClient.ProductFilesForReleaseReturns(productFiles, nil)
+		fakePivnetClient.EULAReturns(pivnet.EULA{Slug: "some-eula", Content: "some eula text"}, nil)
+		fakePivnetClient.FileGroupsForReleaseReturns([]pivnet.FileGroup{{ID: 4567, Name: "some-file-group"}}, nil)
+		fakePivnetClient.ArtifactReferencesForReleaseReturns([]pivnet.ArtifactReference{{ID: 5678, Name: "some-artifact-reference"}}, nil)
+		fakePivnetClient.ReleaseDependenciesReturns([]pivnet.ReleaseDependency{{Release: pivnet.DependentRelease{ID: 6789}}}, nil)
+		fakePivnetClient.DependencySpecifiersReturns([]pivnet.DependencySpecifier{{ID: 7890, Specifier: "1.2.*"}}, nil)
+		fakePivnetClient.ReleaseUpgradePathsReturns([]pivnet.ReleaseUpgradePath{{Release: pivnet.UpgradePathRelease{ID: 8901}}}, nil)
+
+		fakeDownloader.DownloadStub = func(
+			productSlug string,
+			releaseVersion string,
+			globs []string,
+			productFileIDs []int,
+			downloadDir string,
+			acceptEULA bool,
+			progressWriter io.Writer,
+			options productfile.DownloadOptions,
+		) ([]string, error) {
+			var localPaths []string
+			for name, c := range contents {
+				localPath := filepath.Join(downloadDir, name)
+				err := ioutil.WriteFile(localPath, []byte(c), 0644)
+				if err != nil {
+					return nil, err
+				}
+				localPaths = append(localPaths, localPath)
+			}
+			return localPaths, nil
+		}
+
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = bundle.NewBundleClient(
+			fakePivnetClient,
+			fakeDownloader,
+			fakeErrorHandler,
+			printer.PrintAsJSON,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+			l,
+		)
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	readMetadata := func(path string) bundle.Metadata {
+		file, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+
+		tr := tar.NewReader(file)
+		for {
+			header, err := tr.Next()
+			Expect(err).NotTo(HaveOccurred())
+
+			if header.Name == "metadata.json" {
+				var metadata bundle.Metadata
+				err = json.NewDecoder(tr).Decode(&metadata)
+				Expect(err).NotTo(HaveOccurred())
+				return metadata
+			}
+		}
+	}
+
+	Describe("Export", func() {
+		It("downloads every product file of the release", func() {
+			err := client.Export("some-product", "~1.2", bundlePath, true, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			invokedSlug, invokedVersion := fakePivnetClient.ReleaseForConstraintArgsForCall(0)
+			Expect(invokedSlug).To(Equal("some-product"))
+			Expect(invokedVersion).To(Equal("~1.2"))
+
+			Expect(fakeDownloader.DownloadCallCount()).To(Equal(1))
+			slug, releaseVersion, globs, productFileIDs, _, acceptEULA, _, _ := fakeDownloader.DownloadArgsForCall(0)
+			Expect(slug).To(Equal("some-product"))
+			Expect(releaseVersion).To(Equal("1.2.3"))
+			Expect(globs).To(BeNil())
+			Expect(productFileIDs).To(Equal([]int{2345, 3456}))
+			Expect(acceptEULA).To(BeTrue())
+		})
+
+		It("writes the release metadata and files to the bundle", func() {
+			err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			metadata := readMetadata(bundlePath)
+			Expect(metadata.ProductSlug).To(Equal("some-product"))
+			Expect(metadata.Release.ID).To(Equal(1234))
+			Expect(metadata.EULA.Content).To(Equal("some eula text"))
+			Expect(metadata.ProductFiles).To(HaveLen(2))
+			Expect(metadata.FileGroups[0].ID).To(Equal(4567))
+			Expect(metadata.ArtifactReferences[0].ID).To(Equal(5678))
+			Expect(metadata.ReleaseDependencies[0].Release.ID).To(Equal(6789))
+			Expect(metadata.DependencySpecifiers[0].ID).To(Equal(7890))
+			Expect(metadata.ReleaseUpgradePaths[0].Release.ID).To(Equal(8901))
+
+			Expect(metadata.Files).To(Equal([]bundle.File{
+				{
+					ProductFileID: 2345,
+					Path:          "files/some-tile.pivotal",
+					Size:          int64(len("some tile contents")),
+					SHA256:        sha256Of("some tile contents"),
+				},
+				{
+					ProductFileID: 3456,
+					Path:          "files/some-stemcell.tgz",
+					Size:          int64(len("some stemcell contents")),
+					SHA256:        sha256Of("some stemcell contents"),
+				},
+			}))
+		})
+
+		It("leaves only the bundle behind", func() {
+			err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := ioutil.ReadDir(tempDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Name()).To(Equal("bundle.tar"))
+		})
+
+		It("makes the bundle readable by everyone", func() {
+			err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			info, err := os.Stat(bundlePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0644)))
+		})
+
+		Context("when a product file is returned more than once", func() {
+			BeforeEach(func() {
+				fakePivnetClient.ProductFilesForReleaseReturns(append(productFiles, productFiles[0]), nil)
+			})
+
+			It("downloads and bundles it once", func() {
+				err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, _, productFileIDs, _, _, _, _ := fakeDownloader.DownloadArgsForCall(0)
+				Expect(productFileIDs).To(Equal([]int{2345, 3456}))
+
+				metadata := readMetadata(bundlePath)
+				Expect(metadata.ProductFiles).To(HaveLen(2))
+				Expect(metadata.Files).To(HaveLen(2))
+			})
+		})
+
+		Context("when the release has no EULA", func() {
+			BeforeEach(func() {
+				release.EULA = nil
+				fakePivnetClient.ReleaseForConstraintReturns(release, nil)
+			})
+
+			It("does not fetch one", func() {
+				err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.EULACallCount()).To(Equal(0))
+				Expect(readMetadata(bundlePath).EULA).To(BeNil())
+			})
+		})
+
+		Context("when fetching metadata returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("file groups error")
+				fakePivnetClient.FileGroupsForReleaseReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler without downloading", func() {
+				err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+				Expect(fakeDownloader.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the downloader returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download error")
+				fakeDownloader.DownloadStub = nil
+				fakeDownloader.DownloadReturns(nil, expectedErr)
+			})
+
+			It("returns the error and does not write the bundle", func() {
+				err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+				Expect(err).To(Equal(expectedErr))
+
+				_, err = os.Stat(bundlePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when a product file was not downloaded", func() {
+			BeforeEach(func() {
+				delete(contents, "some-stemcell.tgz")
+			})
+
+			It("invokes the error handler and does not write the bundle", func() {
+				err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("3456"))
+
+				_, err = os.Stat(bundlePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Verify", func() {
+		var (
+			result bundle.VerifyResult
+		)
+
+		verify := func() {
+			err := client.Verify(bundlePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = json.Unmarshal(outBuffer.Bytes(), &result)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		// rewriteBundle copies the bundle, replacing or adding the contents of
+		// the given entries.
+		rewriteBundle := func(replacements map[string]string) {
+			original, err := ioutil.ReadFile(bundlePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			file, err := os.Create(bundlePath)
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			tw := tar.NewWriter(file)
+			tr := tar.NewReader(bytes.NewReader(original))
+
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred())
+
+				b, err := ioutil.ReadAll(tr)
+				Expect(err).NotTo(HaveOccurred())
+
+				if r, ok := replacements[header.Name]; ok {
+					b = []byte(r)
+					delete(replacements, header.Name)
+				}
+
+				header.Size = int64(len(b))
+				Expect(tw.WriteHeader(header)).To(Succeed())
+				_, err = tw.Write(b)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			for name, r := range replacements {
+				Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(r))})).To(Succeed())
+				_, err = tw.Write([]byte(r))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(tw.Close()).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			err := client.Export("some-product", "1.2.3", bundlePath, false, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			outBuffer.Reset()
+			result = bundle.VerifyResult{}
+		})
+
+		It("verifies every file without contacting Pivnet", func() {
+			invocations := len(fakePivnetClient.Invocations())
+
+			verify()
+
+			Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+			Expect(fakePivnetClient.Invocations()).To(HaveLen(invocations))
+
+			Expect(result.ProductSlug).To(Equal("some-product"))
+			Expect(result.Release.Version).To(Equal("1.2.3"))
+			Expect(result.Files).To(Equal([]bundle.FileResult{
+				{
+					ProductFileID: 2345,
+					Path:          "files/some-tile.pivotal",
+					SHA256:        sha256Of("some tile contents"),
+					Status:        bundle.StatusOK,
+				},
+				{
+					ProductFileID: 3456,
+					Path:          "files/some-stemcell.tgz",
+					SHA256:        sha256Of("some stemcell contents"),
+					Status:        bundle.StatusOK,
+				},
+			}))
+		})
+
+		Context("when a file has been modified", func() {
+			BeforeEach(func() {
+				rewriteBundle(map[string]string{
+					"files/some-stemcell.tgz": "tampered contents",
+				})
+			})
+
+			It("reports the mismatch and invokes the error handler", func() {
+				verify()
+
+				Expect(result.Files[1].Status).To(Equal(bundle.StatusMismatch))
+				Expect(result.Files[1].SHA256).To(Equal(sha256Of("tampered contents")))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(Equal("1 of 2 files in bundle failed verification"))
+			})
+		})
+
+		Context("when a file does not match the SHA256 published on Pivnet", func() {
+			BeforeEach(func() {
+				metadata := readMetadata(bundlePath)
+				metadata.ProductFiles[0].SHA256 = sha256Of("something else")
+
+				b, err := json.Marshal(metadata)
+				Expect(err).NotTo(HaveOccurred())
+
+				rewriteBundle(map[string]string{
+					"metadata.json": string(b),
+				})
+			})
+
+			It("reports the mismatch", func() {
+				verify()
+
+				Expect(result.Files[0].Status).To(Equal(bundle.StatusMismatch))
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the bundle contains an unexpected file", func() {
+			BeforeEach(func() {
+				rewriteBundle(map[string]string{
+					"files/extra.tgz": "extra contents",
+				})
+			})
+
+			It("reports the file", func() {
+				verify()
+
+				Expect(result.Files).To(HaveLen(3))
+				Expect(result.Files[2].Path).To(Equal("files/extra.tgz"))
+				Expect(result.Files[2].Status).To(Equal(bundle.StatusUnexpected))
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the bundle has no metadata", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(bundlePath, nil, 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("invokes the error handler", func() {
+				err := client.Verify(bundlePath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("metadata.json"))
+			})
+		})
+
+		Context("when the bundle does not exist", func() {
+			It("invokes the error handler", func() {
+				err := client.Verify(filepath.Join(tempDir, "missing.tar"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
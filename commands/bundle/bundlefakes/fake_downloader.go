@@ -0,0 +1,142 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package bundlefakes
+
+import (
+	"io"
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/bundle"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+)
+
+type FakeDownloader struct {
+	DownloadStub        func(string, string, []string, []int, string, bool, io.Writer, productfile.DownloadOptions) ([]string, error)
+	downloadMutex       sync.RWMutex
+	downloadArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+		arg4 []int
+		arg5 string
+		arg6 bool
+		arg7 io.Writer
+		arg8 productfile.DownloadOptions
+	}
+	downloadReturns struct {
+		result1 []string
+		result2 error
+	}
+	downloadReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDownloader) Download(arg1 string, arg2 string, arg3 []string, arg4 []int, arg5 string, arg6 bool, arg7 io.Writer, arg8 productfile.DownloadOptions) ([]string, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg4Copy []int
+	if arg4 != nil {
+		arg4Copy = make([]int, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.downloadMutex.Lock()
+	ret, specificReturn := fake.downloadReturnsOnCall[len(fake.downloadArgsForCall)]
+	fake.downloadArgsForCall = append(fake.downloadArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+		arg4 []int
+		arg5 string
+		arg6 bool
+		arg7 io.Writer
+		arg8 productfile.DownloadOptions
+	}{arg1, arg2, arg3Copy, arg4Copy, arg5, arg6, arg7, arg8})
+	stub := fake.DownloadStub
+	fakeReturns := fake.downloadReturns
+	fake.recordInvocation("Download", []interface{}{arg1, arg2, arg3Copy, arg4Copy, arg5, arg6, arg7, arg8})
+	fake.downloadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDownloader) DownloadCallCount() int {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	return len(fake.downloadArgsForCall)
+}
+
+func (fake *FakeDownloader) DownloadCalls(stub func(string, string, []string, []int, string, bool, io.Writer, productfile.DownloadOptions) ([]string, error)) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = stub
+}
+
+func (fake *FakeDownloader) DownloadArgsForCall(i int) (string, string, []string, []int, string, bool, io.Writer, productfile.DownloadOptions) {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	argsForCall := fake.downloadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
+}
+
+func (fake *FakeDownloader) DownloadReturns(result1 []string, result2 error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = nil
+	fake.downloadReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDownloader) DownloadReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = nil
+	if fake.downloadReturnsOnCall == nil {
+		fake.downloadReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.downloadReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDownloader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDownloader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ bundle.Downloader = new(FakeDownloader)
@@ -0,0 +1,684 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package bundlefakes
+
+import (
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/bundle"
+)
+
+type FakePivnetClient struct {
+	ArtifactReferencesForReleaseStub        func(string, int) ([]pivnet.ArtifactReference, error)
+	artifactReferencesForReleaseMutex       sync.RWMutex
+	artifactReferencesForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	artifactReferencesForReleaseReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	artifactReferencesForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	DependencySpecifiersStub        func(string, int) ([]pivnet.DependencySpecifier, error)
+	dependencySpecifiersMutex       sync.RWMutex
+	dependencySpecifiersArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	dependencySpecifiersReturns struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}
+	dependencySpecifiersReturnsOnCall map[int]struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}
+	EULAStub        func(string) (pivnet.EULA, error)
+	eULAMutex       sync.RWMutex
+	eULAArgsForCall []struct {
+		arg1 string
+	}
+	eULAReturns struct {
+		result1 pivnet.EULA
+		result2 error
+	}
+	eULAReturnsOnCall map[int]struct {
+		result1 pivnet.EULA
+		result2 error
+	}
+	FileGroupsForReleaseStub        func(string, int) ([]pivnet.FileGroup, error)
+	fileGroupsForReleaseMutex       sync.RWMutex
+	fileGroupsForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	fileGroupsForReleaseReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	fileGroupsForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	ProductFilesForReleaseStub        func(string, int) ([]pivnet.ProductFile, error)
+	productFilesForReleaseMutex       sync.RWMutex
+	productFilesForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	productFilesForReleaseReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	productFilesForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ReleaseDependenciesStub        func(string, int) ([]pivnet.ReleaseDependency, error)
+	releaseDependenciesMutex       sync.RWMutex
+	releaseDependenciesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseDependenciesReturns struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	releaseDependenciesReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	ReleaseUpgradePathsStub        func(string, int) ([]pivnet.ReleaseUpgradePath, error)
+	releaseUpgradePathsMutex       sync.RWMutex
+	releaseUpgradePathsArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseUpgradePathsReturns struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	releaseUpgradePathsReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForRelease(arg1 string, arg2 int) ([]pivnet.ArtifactReference, error) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	ret, specificReturn := fake.artifactReferencesForReleaseReturnsOnCall[len(fake.artifactReferencesForReleaseArgsForCall)]
+	fake.artifactReferencesForReleaseArgsForCall = append(fake.artifactReferencesForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ArtifactReferencesForReleaseStub
+	fakeReturns := fake.artifactReferencesForReleaseReturns
+	fake.recordInvocation("ArtifactReferencesForRelease", []interface{}{arg1, arg2})
+	fake.artifactReferencesForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseCallCount() int {
+	fake.artifactReferencesForReleaseMutex.RLock()
+	defer fake.artifactReferencesForReleaseMutex.RUnlock()
+	return len(fake.artifactReferencesForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseCalls(stub func(string, int) ([]pivnet.ArtifactReference, error)) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	defer fake.artifactReferencesForReleaseMutex.Unlock()
+	fake.ArtifactReferencesForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseArgsForCall(i int) (string, int) {
+	fake.artifactReferencesForReleaseMutex.RLock()
+	defer fake.artifactReferencesForReleaseMutex.RUnlock()
+	argsForCall := fake.artifactReferencesForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	defer fake.artifactReferencesForReleaseMutex.Unlock()
+	fake.ArtifactReferencesForReleaseStub = nil
+	fake.artifactReferencesForReleaseReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	defer fake.artifactReferencesForReleaseMutex.Unlock()
+	fake.ArtifactReferencesForReleaseStub = nil
+	if fake.artifactReferencesForReleaseReturnsOnCall == nil {
+		fake.artifactReferencesForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.artifactReferencesForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) DependencySpecifiers(arg1 string, arg2 int) ([]pivnet.DependencySpecifier, error) {
+	fake.dependencySpecifiersMutex.Lock()
+	ret, specificReturn := fake.dependencySpecifiersReturnsOnCall[len(fake.dependencySpecifiersArgsForCall)]
+	fake.dependencySpecifiersArgsForCall = append(fake.dependencySpecifiersArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DependencySpecifiersStub
+	fakeReturns := fake.dependencySpecifiersReturns
+	fake.recordInvocation("DependencySpecifiers", []interface{}{arg1, arg2})
+	fake.dependencySpecifiersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersCallCount() int {
+	fake.dependencySpecifiersMutex.RLock()
+	defer fake.dependencySpecifiersMutex.RUnlock()
+	return len(fake.dependencySpecifiersArgsForCall)
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersCalls(stub func(string, int) ([]pivnet.DependencySpecifier, error)) {
+	fake.dependencySpecifiersMutex.Lock()
+	defer fake.dependencySpecifiersMutex.Unlock()
+	fake.DependencySpecifiersStub = stub
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersArgsForCall(i int) (string, int) {
+	fake.dependencySpecifiersMutex.RLock()
+	defer fake.dependencySpecifiersMutex.RUnlock()
+	argsForCall := fake.dependencySpecifiersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersReturns(result1 []pivnet.DependencySpecifier, result2 error) {
+	fake.dependencySpecifiersMutex.Lock()
+	defer fake.dependencySpecifiersMutex.Unlock()
+	fake.DependencySpecifiersStub = nil
+	fake.dependencySpecifiersReturns = struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersReturnsOnCall(i int, result1 []pivnet.DependencySpecifier, result2 error) {
+	fake.dependencySpecifiersMutex.Lock()
+	defer fake.dependencySpecifiersMutex.Unlock()
+	fake.DependencySpecifiersStub = nil
+	if fake.dependencySpecifiersReturnsOnCall == nil {
+		fake.dependencySpecifiersReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.DependencySpecifier
+			result2 error
+		})
+	}
+	fake.dependencySpecifiersReturnsOnCall[i] = struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) EULA(arg1 string) (pivnet.EULA, error) {
+	fake.eULAMutex.Lock()
+	ret, specificReturn := fake.eULAReturnsOnCall[len(fake.eULAArgsForCall)]
+	fake.eULAArgsForCall = append(fake.eULAArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.EULAStub
+	fakeReturns := fake.eULAReturns
+	fake.recordInvocation("EULA", []interface{}{arg1})
+	fake.eULAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) EULACallCount() int {
+	fake.eULAMutex.RLock()
+	defer fake.eULAMutex.RUnlock()
+	return len(fake.eULAArgsForCall)
+}
+
+func (fake *FakePivnetClient) EULACalls(stub func(string) (pivnet.EULA, error)) {
+	fake.eULAMutex.Lock()
+	defer fake.eULAMutex.Unlock()
+	fake.EULAStub = stub
+}
+
+func (fake *FakePivnetClient) EULAArgsForCall(i int) string {
+	fake.eULAMutex.RLock()
+	defer fake.eULAMutex.RUnlock()
+	argsForCall := fake.eULAArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePivnetClient) EULAReturns(result1 pivnet.EULA, result2 error) {
+	fake.eULAMutex.Lock()
+	defer fake.eULAMutex.Unlock()
+	fake.EULAStub = nil
+	fake.eULAReturns = struct {
+		result1 pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) EULAReturnsOnCall(i int, result1 pivnet.EULA, result2 error) {
+	fake.eULAMutex.Lock()
+	defer fake.eULAMutex.Unlock()
+	fake.EULAStub = nil
+	if fake.eULAReturnsOnCall == nil {
+		fake.eULAReturnsOnCall = make(map[int]struct {
+			result1 pivnet.EULA
+			result2 error
+		})
+	}
+	fake.eULAReturnsOnCall[i] = struct {
+		result1 pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroupsForRelease(arg1 string, arg2 int) ([]pivnet.FileGroup, error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	ret, specificReturn := fake.fileGroupsForReleaseReturnsOnCall[len(fake.fileGroupsForReleaseArgsForCall)]
+	fake.fileGroupsForReleaseArgsForCall = append(fake.fileGroupsForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.FileGroupsForReleaseStub
+	fakeReturns := fake.fileGroupsForReleaseReturns
+	fake.recordInvocation("FileGroupsForRelease", []interface{}{arg1, arg2})
+	fake.fileGroupsForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseCallCount() int {
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	return len(fake.fileGroupsForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseCalls(stub func(string, int) ([]pivnet.FileGroup, error)) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseArgsForCall(i int) (string, int) {
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	argsForCall := fake.fileGroupsForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = nil
+	fake.fileGroupsForReleaseReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = nil
+	if fake.fileGroupsForReleaseReturnsOnCall == nil {
+		fake.fileGroupsForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.fileGroupsForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFilesForRelease(arg1 string, arg2 int) ([]pivnet.ProductFile, error) {
+	fake.productFilesForReleaseMutex.Lock()
+	ret, specificReturn := fake.productFilesForReleaseReturnsOnCall[len(fake.productFilesForReleaseArgsForCall)]
+	fake.productFilesForReleaseArgsForCall = append(fake.productFilesForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ProductFilesForReleaseStub
+	fakeReturns := fake.productFilesForReleaseReturns
+	fake.recordInvocation("ProductFilesForRelease", []interface{}{arg1, arg2})
+	fake.productFilesForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseCallCount() int {
+	fake.productFilesForReleaseMutex.RLock()
+	defer fake.productFilesForReleaseMutex.RUnlock()
+	return len(fake.productFilesForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseCalls(stub func(string, int) ([]pivnet.ProductFile, error)) {
+	fake.productFilesForReleaseMutex.Lock()
+	defer fake.productFilesForReleaseMutex.Unlock()
+	fake.ProductFilesForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseArgsForCall(i int) (string, int) {
+	fake.productFilesForReleaseMutex.RLock()
+	defer fake.productFilesForReleaseMutex.RUnlock()
+	argsForCall := fake.productFilesForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesForReleaseMutex.Lock()
+	defer fake.productFilesForReleaseMutex.Unlock()
+	fake.ProductFilesForReleaseStub = nil
+	fake.productFilesForReleaseReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesForReleaseMutex.Lock()
+	defer fake.productFilesForReleaseMutex.Unlock()
+	fake.ProductFilesForReleaseStub = nil
+	if fake.productFilesForReleaseReturnsOnCall == nil {
+		fake.productFilesForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.productFilesForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseDependencies(arg1 string, arg2 int) ([]pivnet.ReleaseDependency, error) {
+	fake.releaseDependenciesMutex.Lock()
+	ret, specificReturn := fake.releaseDependenciesReturnsOnCall[len(fake.releaseDependenciesArgsForCall)]
+	fake.releaseDependenciesArgsForCall = append(fake.releaseDependenciesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseDependenciesStub
+	fakeReturns := fake.releaseDependenciesReturns
+	fake.recordInvocation("ReleaseDependencies", []interface{}{arg1, arg2})
+	fake.releaseDependenciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesCallCount() int {
+	fake.releaseDependenciesMutex.RLock()
+	defer fake.releaseDependenciesMutex.RUnlock()
+	return len(fake.releaseDependenciesArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesCalls(stub func(string, int) ([]pivnet.ReleaseDependency, error)) {
+	fake.releaseDependenciesMutex.Lock()
+	defer fake.releaseDependenciesMutex.Unlock()
+	fake.ReleaseDependenciesStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesArgsForCall(i int) (string, int) {
+	fake.releaseDependenciesMutex.RLock()
+	defer fake.releaseDependenciesMutex.RUnlock()
+	argsForCall := fake.releaseDependenciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesReturns(result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.releaseDependenciesMutex.Lock()
+	defer fake.releaseDependenciesMutex.Unlock()
+	fake.ReleaseDependenciesStub = nil
+	fake.releaseDependenciesReturns = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesReturnsOnCall(i int, result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.releaseDependenciesMutex.Lock()
+	defer fake.releaseDependenciesMutex.Unlock()
+	fake.ReleaseDependenciesStub = nil
+	if fake.releaseDependenciesReturnsOnCall == nil {
+		fake.releaseDependenciesReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseDependency
+			result2 error
+		})
+	}
+	fake.releaseDependenciesReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePaths(arg1 string, arg2 int) ([]pivnet.ReleaseUpgradePath, error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	ret, specificReturn := fake.releaseUpgradePathsReturnsOnCall[len(fake.releaseUpgradePathsArgsForCall)]
+	fake.releaseUpgradePathsArgsForCall = append(fake.releaseUpgradePathsArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseUpgradePathsStub
+	fakeReturns := fake.releaseUpgradePathsReturns
+	fake.recordInvocation("ReleaseUpgradePaths", []interface{}{arg1, arg2})
+	fake.releaseUpgradePathsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsCallCount() int {
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	return len(fake.releaseUpgradePathsArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsCalls(stub func(string, int) ([]pivnet.ReleaseUpgradePath, error)) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsArgsForCall(i int) (string, int) {
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	argsForCall := fake.releaseUpgradePathsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsReturns(result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = nil
+	fake.releaseUpgradePathsReturns = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsReturnsOnCall(i int, result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = nil
+	if fake.releaseUpgradePathsReturnsOnCall == nil {
+		fake.releaseUpgradePathsReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseUpgradePath
+			result2 error
+		})
+	}
+	fake.releaseUpgradePathsReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.artifactReferencesForReleaseMutex.RLock()
+	defer fake.artifactReferencesForReleaseMutex.RUnlock()
+	fake.dependencySpecifiersMutex.RLock()
+	defer fake.dependencySpecifiersMutex.RUnlock()
+	fake.eULAMutex.RLock()
+	defer fake.eULAMutex.RUnlock()
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	fake.productFilesForReleaseMutex.RLock()
+	defer fake.productFilesForReleaseMutex.RUnlock()
+	fake.releaseDependenciesMutex.RLock()
+	defer fake.releaseDependenciesMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePivnetClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ bundle.PivnetClient = new(FakePivnetClient)
@@ -0,0 +1,247 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/bundle"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+)
+
+var _ = Describe("bundle commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeBundleClient *commandsfakes.FakeBundleClient
+	)
+
+	BeforeEach(func() {
+		fakeBundleClient = &commandsfakes.FakeBundleClient{}
+
+		commands.NewBundleClient = func(bundle.PivnetClient, bundle.Downloader) commands.BundleClient {
+			return fakeBundleClient
+		}
+	})
+
+	Describe("ExportReleaseCommand", func() {
+		var (
+			cmd *commands.ExportReleaseCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.ExportReleaseCommand{
+				ProductSlug:    "some-product-slug",
+				ReleaseVersion: "1.2.3",
+				Output:         "some/bundle.tar",
+				AcceptEULA:     true,
+			}
+		})
+
+		It("invokes the bundle client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBundleClient.ExportCallCount()).To(Equal(1))
+
+			productSlug, releaseVersion, outputPath, acceptEULA, _ := fakeBundleClient.ExportArgsForCall(0)
+			Expect(productSlug).To(Equal("some-product-slug"))
+			Expect(releaseVersion).To(Equal("1.2.3"))
+			Expect(outputPath).To(Equal("some/bundle.tar"))
+			Expect(acceptEULA).To(BeTrue())
+		})
+
+		Context("when the bundle client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeBundleClient.ExportReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+			})
+		})
+
+		Describe("ProductSlug flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.ExportReleaseCommand{}, "ProductSlug")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("product-slug"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("p"))
+			})
+		})
+
+		Describe("ReleaseVersion flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.ExportReleaseCommand{}, "ReleaseVersion")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("release-version"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("r"))
+			})
+		})
+
+		Describe("Output flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.ExportReleaseCommand{}, "Output")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("output"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("o"))
+			})
+		})
+
+		Describe("AcceptEULA flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.ExportReleaseCommand{}, "AcceptEULA")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("accept-eula"))
+			})
+		})
+	})
+
+	Describe("VerifyBundleCommand", func() {
+		var (
+			cmd *commands.VerifyBundleCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.VerifyBundleCommand{
+				Bundle: "some/bundle.tar",
+			}
+		})
+
+		It("invokes the bundle client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBundleClient.VerifyCallCount()).To(Equal(1))
+			Expect(fakeBundleClient.VerifyArgsForCall(0)).To(Equal("some/bundle.tar"))
+		})
+
+		Context("when the bundle client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeBundleClient.VerifyReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication would return an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("does not authenticate", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("Bundle flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.VerifyBundleCommand{}, "Bundle")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("bundle"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("b"))
+			})
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"github.com/pivotal-cf/pivnet-cli/v3/checksum"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/cache"
+)
+
+type CacheCommand struct {
+	List   CacheListCommand   `command:"list" alias:"ls" description:"List cached product files"`
+	Prune  CachePruneCommand  `command:"prune" description:"Remove cached product files that have not been used recently"`
+	Verify CacheVerifyCommand `command:"verify" description:"Verify the SHA256 of every cached product file"`
+}
+
+type CacheListCommand struct {
+	CacheDir string `long:"cache-dir" description:"Cache directory (default: cache_dir of the profile)"`
+}
+
+type CachePruneCommand struct {
+	CacheDir  string `long:"cache-dir" description:"Cache directory (default: cache_dir of the profile)"`
+	OlderThan string `long:"older-than" description:"Remove files not used for this long e.g. 30d or 72h" required:"true"`
+}
+
+type CacheVerifyCommand struct {
+	CacheDir string `long:"cache-dir" description:"Cache directory (default: cache_dir of the profile)"`
+}
+
+//go:generate counterfeiter . CacheClient
+type CacheClient interface {
+	List() error
+	Prune(olderThan string) error
+	Verify() error
+}
+
+var NewCacheClient = func(cacheDir string) CacheClient {
+	return cache.NewCacheClient(
+		cache.NewStore(cacheDir),
+		checksum.NewSHA256FileSummer(),
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+		Pivnet.Logger,
+	)
+}
+
+// cacheDirFor returns the cache directory given on the command line, falling
+// back to the one set in the profile. It is empty if neither is set.
+func cacheDirFor(cacheDir string) string {
+	if cacheDir != "" {
+		return cacheDir
+	}
+
+	if Pivnet.Profile != nil {
+		return Pivnet.Profile.CacheDir
+	}
+
+	return ""
+}
+
+func (command *CacheListCommand) Execute([]string) error {
+	err := Init(false)
+	if err != nil {
+		return err
+	}
+
+	return NewCacheClient(cacheDirFor(command.CacheDir)).List()
+}
+
+func (command *CachePruneCommand) Execute([]string) error {
+	err := Init(false)
+	if err != nil {
+		return err
+	}
+
+	return NewCacheClient(cacheDirFor(command.CacheDir)).Prune(command.OlderThan)
+}
+
+func (command *CacheVerifyCommand) Execute([]string) error {
+	err := Init(false)
+	if err != nil {
+		return err
+	}
+
+	return NewCacheClient(cacheDirFor(command.CacheDir)).Verify()
+}
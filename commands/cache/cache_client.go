@@ -0,0 +1,269 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+//go:generate counterfeiter --fake-name FakeFileSummer . FileSummer
+type FileSummer interface {
+	SumFile(filepath string) (string, error)
+}
+
+type CacheClient struct {
+	store            *Store
+	sha256FileSummer FileSummer
+	eh               errorhandler.ErrorHandler
+	format           string
+	outputWriter     io.Writer
+	printer          printer.Printer
+	l                logger.Logger
+}
+
+func NewCacheClient(
+	store *Store,
+	sha256FileSummer FileSummer,
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+	l logger.Logger,
+) *CacheClient {
+	return &CacheClient{
+		store:            store,
+		sha256FileSummer: sha256FileSummer,
+		eh:               eh,
+		format:           format,
+		outputWriter:     outputWriter,
+		printer:          printer,
+		l:                l,
+	}
+}
+
+type verifyResult struct {
+	SHA256 string `json:"sha256" yaml:"sha256"`
+	Path   string `json:"path" yaml:"path"`
+	Size   int64  `json:"size" yaml:"size"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func (c *CacheClient) List() error {
+	err := c.checkDir()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	entries, err := c.store.Entries()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	return c.printEntries(entries)
+}
+
+// Prune removes the entries that have not been used for longer than
+// olderThan, e.g. 72h or 30d, and prints them.
+func (c *CacheClient) Prune(olderThan string) error {
+	err := c.checkDir()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	age, err := parseAge(olderThan)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	entries, err := c.store.Entries()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	cutoff := c.store.now().Add(-age)
+
+	var pruned []Entry
+	var prunedBytes int64
+	for _, e := range entries {
+		if !e.LastUsed.Before(cutoff) {
+			continue
+		}
+
+		c.l.Debug("Pruning cache entry", logger.Data{"sha256": e.SHA256, "lastUsed": e.LastUsed})
+
+		err := c.store.Remove(e.SHA256)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		pruned = append(pruned, e)
+		prunedBytes += e.Size
+	}
+
+	c.l.Info(fmt.Sprintf(
+		"Pruned %d of %d cache entries (%d bytes)",
+		len(pruned),
+		len(entries),
+		prunedBytes,
+	))
+
+	return c.printEntries(pruned)
+}
+
+// Verify checks that every entry still has the SHA256 it is stored under.
+// Entries that do not are removed, since they could never be used.
+func (c *CacheClient) Verify() error {
+	err := c.checkDir()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	entries, err := c.store.Entries()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	var results []verifyResult
+	var corrupt int
+	for _, e := range entries {
+		result := verifyResult{
+			SHA256: e.SHA256,
+			Path:   e.Path,
+			Size:   e.Size,
+		}
+
+		actualSHA256, err := c.sha256FileSummer.SumFile(e.Path)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		if actualSHA256 != e.SHA256 {
+			corrupt++
+
+			err := c.store.Remove(e.SHA256)
+			if err != nil {
+				return c.eh.HandleError(err)
+			}
+
+			result.Error = fmt.Sprintf("SHA256 is '%s'. The entry has been removed", actualSHA256)
+		}
+
+		results = append(results, result)
+	}
+
+	err = c.printVerifyResults(results)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	if corrupt > 0 {
+		err := fmt.Errorf(
+			"%d of %d cache entries failed verification",
+			corrupt,
+			len(results),
+		)
+		return c.eh.HandleError(err)
+	}
+
+	return nil
+}
+
+func (c *CacheClient) checkDir() error {
+	if c.store.Dir() == "" {
+		return fmt.Errorf("No cache directory configured. Provide --cache-dir or set cache_dir in the profile")
+	}
+	return nil
+}
+
+func (c *CacheClient) printEntries(entries []Entry) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"SHA256",
+			"Size",
+			"Last Used",
+		})
+
+		for _, e := range entries {
+			table.Append([]string{
+				e.SHA256,
+				strconv.FormatInt(e.Size, 10),
+				e.LastUsed.Format(time.RFC3339),
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(entries)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(entries)
+	}
+
+	return nil
+}
+
+func (c *CacheClient) printVerifyResults(results []verifyResult) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"SHA256",
+			"Size",
+			"Result",
+		})
+
+		for _, r := range results {
+			status := "OK"
+			if r.Error != "" {
+				status = r.Error
+			}
+
+			table.Append([]string{
+				r.SHA256,
+				strconv.FormatInt(r.Size, 10),
+				status,
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(results)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(results)
+	}
+
+	return nil
+}
+
+// parseAge parses a Go duration such as 72h, or a number of days such as 30d.
+func parseAge(s string) (time.Duration, error) {
+	var age time.Duration
+
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid age: '%s'", s)
+		}
+		age = time.Duration(days) * 24 * time.Hour
+	} else {
+		var err error
+		age, err = time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age: '%s'", s)
+		}
+	}
+
+	if age < 0 {
+		return 0, fmt.Errorf("age must not be negative: '%s'", s)
+	}
+
+	return age, nil
+}
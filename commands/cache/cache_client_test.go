@@ -0,0 +1,195 @@
+package cache_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/cache"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/cache/cachefakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+var _ = Describe("cache commands", func() {
+	var (
+		fakeSHA256FileSummer *cachefakes.FakeFileSummer
+		fakeErrorHandler     *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+
+		tempDir      string
+		recentSHA256 string
+		oldSHA256    string
+
+		store  *cache.Store
+		client *cache.CacheClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		store = cache.NewStore(filepath.Join(tempDir, "cache"))
+
+		recentSHA256 = strings.Repeat("ab", 32)
+		oldSHA256 = strings.Repeat("cd", 32)
+
+		for _, sha256 := range []string{recentSHA256, oldSHA256} {
+			sourcePath := filepath.Join(tempDir, sha256)
+			err = ioutil.WriteFile(sourcePath, []byte("some contents"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = store.Add(sha256, sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		old := time.Now().Add(-10 * 24 * time.Hour)
+		err = os.Chtimes(store.Path(oldSHA256), old, old)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeSHA256FileSummer = &cachefakes.FakeFileSummer{}
+		fakeSHA256FileSummer.SumFileStub = func(path string) (string, error) {
+			return filepath.Base(path), nil
+		}
+
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		outBuffer = bytes.Buffer{}
+
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = cache.NewCacheClient(
+			store,
+			fakeSHA256FileSummer,
+			fakeErrorHandler,
+			printer.PrintAsJSON,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+			l,
+		)
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("List", func() {
+		It("prints the entries", func() {
+			err := client.List()
+			Expect(err).NotTo(HaveOccurred())
+
+			var entries []cache.Entry
+			err = json.Unmarshal(outBuffer.Bytes(), &entries)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].SHA256).To(Equal(oldSHA256))
+			Expect(entries[1].SHA256).To(Equal(recentSHA256))
+		})
+	})
+
+	Context("when no cache directory is configured", func() {
+		BeforeEach(func() {
+			client = cache.NewCacheClient(
+				cache.NewStore(""),
+				fakeSHA256FileSummer,
+				fakeErrorHandler,
+				printer.PrintAsJSON,
+				&outBuffer,
+				printer.NewPrinter(&outBuffer),
+				nil,
+			)
+		})
+
+		It("invokes the error handler", func() {
+			Expect(client.List()).To(Succeed())
+			Expect(client.Prune("1d")).To(Succeed())
+			Expect(client.Verify()).To(Succeed())
+
+			Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(3))
+			Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("--cache-dir"))
+		})
+	})
+
+	Describe("Prune", func() {
+		It("removes entries that have not been used recently", func() {
+			err := client.Prune("7d")
+			Expect(err).NotTo(HaveOccurred())
+
+			var pruned []cache.Entry
+			err = json.Unmarshal(outBuffer.Bytes(), &pruned)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pruned).To(HaveLen(1))
+			Expect(pruned[0].SHA256).To(Equal(oldSHA256))
+
+			Expect(store.Path(oldSHA256)).NotTo(BeAnExistingFile())
+			Expect(store.Path(recentSHA256)).To(BeAnExistingFile())
+		})
+
+		It("accepts durations", func() {
+			err := client.Prune("1h")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(store.Path(oldSHA256)).NotTo(BeAnExistingFile())
+			Expect(store.Path(recentSHA256)).To(BeAnExistingFile())
+		})
+
+		Context("when the age is invalid", func() {
+			It("invokes the error handler", func() {
+				err := client.Prune("soon")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError("invalid age: 'soon'"))
+
+				Expect(store.Path(oldSHA256)).To(BeAnExistingFile())
+			})
+		})
+	})
+
+	Describe("Verify", func() {
+		It("checks every entry", func() {
+			err := client.Verify()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSHA256FileSummer.SumFileCallCount()).To(Equal(2))
+			Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+		})
+
+		Context("when an entry does not match its SHA256", func() {
+			BeforeEach(func() {
+				fakeSHA256FileSummer.SumFileStub = func(path string) (string, error) {
+					if filepath.Base(path) == recentSHA256 {
+						return "something-else", nil
+					}
+					return filepath.Base(path), nil
+				}
+			})
+
+			It("removes the entry and invokes the error handler", func() {
+				err := client.Verify()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(store.Path(recentSHA256)).NotTo(BeAnExistingFile())
+				Expect(store.Path(oldSHA256)).To(BeAnExistingFile())
+
+				Expect(outBuffer.String()).To(ContainSubstring("something-else"))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError("1 of 2 cache entries failed verification"))
+			})
+		})
+	})
+})
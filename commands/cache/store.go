@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+var sha256Regexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Store keeps files by the SHA256 of their contents, e.g.
+// <dir>/sha256/ab/ab12...ef. The modification time of an entry records when
+// it was last added or fetched, so that unused entries can be pruned.
+type Store struct {
+	dir string
+	now func() time.Time
+}
+
+// Entry is a file in the store.
+type Entry struct {
+	SHA256   string    `json:"sha256" yaml:"sha256"`
+	Path     string    `json:"path" yaml:"path"`
+	Size     int64     `json:"size" yaml:"size"`
+	LastUsed time.Time `json:"last_used" yaml:"last_used"`
+}
+
+func NewStore(dir string) *Store {
+	return &Store{
+		dir: dir,
+		now: time.Now,
+	}
+}
+
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Path returns where the file with the given SHA256 is stored.
+func (s *Store) Path(sha256 string) string {
+	return filepath.Join(s.dir, "sha256", sha256[:2], sha256)
+}
+
+// Fetch places the file with the given SHA256 at destination, as a hard link
+// if possible and as a copy otherwise. It returns false if the store does not
+// have the file.
+func (s *Store) Fetch(sha256 string, destination string) (bool, error) {
+	err := validateSHA256(sha256)
+	if err != nil {
+		return false, err
+	}
+
+	entryPath := s.Path(sha256)
+
+	_, err = os.Stat(entryPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = linkOrCopy(entryPath, destination)
+	if err != nil {
+		return false, err
+	}
+
+	return true, s.touch(entryPath)
+}
+
+// Add stores the file at source under the given SHA256. The caller is
+// responsible for having verified that the file has that SHA256.
+func (s *Store) Add(sha256 string, source string) error {
+	err := validateSHA256(sha256)
+	if err != nil {
+		return err
+	}
+
+	entryPath := s.Path(sha256)
+
+	_, err = os.Stat(entryPath)
+	if err == nil {
+		return s.touch(entryPath)
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	entryDir := filepath.Dir(entryPath)
+
+	err = os.MkdirAll(entryDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary name first so that a concurrent Fetch never sees
+	// a partially copied entry.
+	tmp, err := ioutil.TempFile(entryDir, sha256+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	err = tmp.Close()
+	if err == nil {
+		err = os.Remove(tmpPath)
+	}
+	if err == nil {
+		err = linkOrCopy(source, tmpPath)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, entryPath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return s.touch(entryPath)
+}
+
+// Remove deletes the file with the given SHA256 from the store.
+func (s *Store) Remove(sha256 string) error {
+	err := validateSHA256(sha256)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(s.Path(sha256))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// Entries lists the files in the store, least recently used first. A store
+// that does not exist yet has no entries.
+func (s *Store) Entries() ([]Entry, error) {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "sha256", "*", "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, p := range paths {
+		name := filepath.Base(p)
+		if !sha256Regexp.MatchString(name) {
+			continue
+		}
+
+		stat, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if !stat.Mode().IsRegular() {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			SHA256:   name,
+			Path:     p,
+			Size:     stat.Size(),
+			LastUsed: stat.ModTime(),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LastUsed.Before(entries[j].LastUsed)
+	})
+
+	return entries, nil
+}
+
+func (s *Store) touch(path string) error {
+	now := s.now()
+	return os.Chtimes(path, now, now)
+}
+
+// IsSHA256 reports whether s can be used as a key in a Store.
+func IsSHA256(s string) bool {
+	return sha256Regexp.MatchString(s)
+}
+
+func validateSHA256(sha256 string) error {
+	if !IsSHA256(sha256) {
+		return fmt.Errorf("invalid SHA256: '%s'", sha256)
+	}
+	return nil
+}
+
+func linkOrCopy(source string, destination string) error {
+	err := os.Link(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	return copyFile(source, destination)
+}
+
+func copyFile(source string, destination string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(destination, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	closeErr := out.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(destination)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package cache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/cache"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		tempDir    string
+		sourcePath string
+		sha256     string
+
+		store *cache.Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		sourcePath = filepath.Join(tempDir, "source")
+		err = ioutil.WriteFile(sourcePath, []byte("some contents"), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		sha256 = strings.Repeat("ab", 32)
+
+		store = cache.NewStore(filepath.Join(tempDir, "cache"))
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Path", func() {
+		It("nests entries by the first two characters of the SHA256", func() {
+			Expect(store.Path(sha256)).To(Equal(filepath.Join(tempDir, "cache", "sha256", "ab", sha256)))
+		})
+	})
+
+	Describe("Add and Fetch", func() {
+		It("stores the file so that it can be fetched", func() {
+			err := store.Add(sha256, sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			destination := filepath.Join(tempDir, "destination")
+			found, err := store.Fetch(sha256, destination)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			contents, err := ioutil.ReadFile(destination)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some contents"))
+		})
+
+		It("keeps the entry when the source is removed", func() {
+			err := store.Add(sha256, sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = os.Remove(sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(store.Path(sha256)).To(BeAnExistingFile())
+		})
+
+		It("marks the entry as used when it is fetched", func() {
+			err := store.Add(sha256, sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			old := time.Now().Add(-48 * time.Hour)
+			err = os.Chtimes(store.Path(sha256), old, old)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = store.Fetch(sha256, filepath.Join(tempDir, "destination"))
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := store.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries[0].LastUsed).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		Context("when the store does not have the file", func() {
+			It("returns false", func() {
+				destination := filepath.Join(tempDir, "destination")
+				found, err := store.Fetch(sha256, destination)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				Expect(destination).NotTo(BeAnExistingFile())
+			})
+		})
+
+		Context("when the SHA256 is invalid", func() {
+			It("returns an error", func() {
+				err := store.Add("../../escape", sourcePath)
+				Expect(err).To(MatchError("invalid SHA256: '../../escape'"))
+
+				_, err = store.Fetch("../../escape", filepath.Join(tempDir, "destination"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Remove", func() {
+		It("removes the entry", func() {
+			err := store.Add(sha256, sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = store.Remove(sha256)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(store.Path(sha256)).NotTo(BeAnExistingFile())
+		})
+
+		It("succeeds when there is no entry", func() {
+			err := store.Remove(sha256)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Entries", func() {
+		It("lists entries, least recently used first", func() {
+			otherSHA256 := strings.Repeat("cd", 32)
+
+			otherSourcePath := filepath.Join(tempDir, "other-source")
+			err := ioutil.WriteFile(otherSourcePath, []byte("other contents"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = store.Add(sha256, sourcePath)
+			Expect(err).NotTo(HaveOccurred())
+			err = store.Add(otherSHA256, otherSourcePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			old := time.Now().Add(-time.Hour)
+			err = os.Chtimes(store.Path(otherSHA256), old, old)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := store.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].SHA256).To(Equal(otherSHA256))
+			Expect(entries[0].Size).To(Equal(int64(len("other contents"))))
+			Expect(entries[1].SHA256).To(Equal(sha256))
+		})
+
+		It("ignores files that are not entries", func() {
+			err := os.MkdirAll(filepath.Join(tempDir, "cache", "sha256", "ab"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(tempDir, "cache", "sha256", "ab", sha256+".123.tmp"), nil, 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := store.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		Context("when the store does not exist", func() {
+			It("returns no entries", func() {
+				entries, err := store.Entries()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,253 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/rc"
+)
+
+var _ = Describe("cache commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeCacheClient *commandsfakes.FakeCacheClient
+
+		invokedCacheDir string
+	)
+
+	BeforeEach(func() {
+		fakeCacheClient = &commandsfakes.FakeCacheClient{}
+		invokedCacheDir = ""
+
+		commands.NewCacheClient = func(cacheDir string) commands.CacheClient {
+			invokedCacheDir = cacheDir
+			return fakeCacheClient
+		}
+	})
+
+	AfterEach(func() {
+		commands.Pivnet.Profile = nil
+	})
+
+	Describe("CacheCommand", func() {
+		It("contains the list subcommand", func() {
+			field = fieldFor(commands.CacheCommand{}, "List")
+			Expect(command(field)).To(Equal("list"))
+			Expect(alias(field)).To(Equal("ls"))
+		})
+
+		It("contains the prune subcommand", func() {
+			field = fieldFor(commands.CacheCommand{}, "Prune")
+			Expect(command(field)).To(Equal("prune"))
+		})
+
+		It("contains the verify subcommand", func() {
+			field = fieldFor(commands.CacheCommand{}, "Verify")
+			Expect(command(field)).To(Equal("verify"))
+		})
+	})
+
+	Describe("CacheListCommand", func() {
+		var (
+			cmd *commands.CacheListCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.CacheListCommand{
+				CacheDir: "/some/cache/dir",
+			}
+		})
+
+		It("invokes the cache client", func() {
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(invokedCacheDir).To(Equal("/some/cache/dir"))
+			Expect(fakeCacheClient.ListCallCount()).To(Equal(1))
+		})
+
+		It("does not require a profile", func() {
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(initInvocationArg).To(BeFalse())
+		})
+
+		Context("when no cache directory is provided", func() {
+			BeforeEach(func() {
+				cmd.CacheDir = ""
+				commands.Pivnet.Profile = &rc.PivnetProfile{CacheDir: "/profile/cache/dir"}
+			})
+
+			It("uses the cache directory of the profile", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(invokedCacheDir).To(Equal("/profile/cache/dir"))
+			})
+		})
+
+		Context("when the cache client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeCacheClient.ListReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Describe("CacheDir flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CacheListCommand{}, "CacheDir")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("cache-dir"))
+			})
+		})
+	})
+
+	Describe("CachePruneCommand", func() {
+		var (
+			cmd *commands.CachePruneCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.CachePruneCommand{
+				CacheDir:  "/some/cache/dir",
+				OlderThan: "30d",
+			}
+		})
+
+		It("invokes the cache client", func() {
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(invokedCacheDir).To(Equal("/some/cache/dir"))
+			Expect(fakeCacheClient.PruneCallCount()).To(Equal(1))
+			Expect(fakeCacheClient.PruneArgsForCall(0)).To(Equal("30d"))
+		})
+
+		Context("when the cache client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeCacheClient.PruneReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Describe("OlderThan flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CachePruneCommand{}, "OlderThan")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("older-than"))
+			})
+		})
+	})
+
+	Describe("CacheVerifyCommand", func() {
+		var (
+			cmd *commands.CacheVerifyCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.CacheVerifyCommand{
+				CacheDir: "/some/cache/dir",
+			}
+		})
+
+		It("invokes the cache client", func() {
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(invokedCacheDir).To(Equal("/some/cache/dir"))
+			Expect(fakeCacheClient.VerifyCallCount()).To(Equal(1))
+		})
+
+		Context("when the cache client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeCacheClient.VerifyReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+	})
+})
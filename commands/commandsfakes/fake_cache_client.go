@@ -0,0 +1,241 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commandsfakes
+
+import (
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+)
+
+type FakeCacheClient struct {
+	ListStub        func() error
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+	}
+	listReturns struct {
+		result1 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PruneStub        func(string) error
+	pruneMutex       sync.RWMutex
+	pruneArgsForCall []struct {
+		arg1 string
+	}
+	pruneReturns struct {
+		result1 error
+	}
+	pruneReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerifyStub        func() error
+	verifyMutex       sync.RWMutex
+	verifyArgsForCall []struct {
+	}
+	verifyReturns struct {
+		result1 error
+	}
+	verifyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCacheClient) List() error {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+	}{})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCacheClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeCacheClient) ListCalls(stub func() error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeCacheClient) ListReturns(result1 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheClient) ListReturnsOnCall(i int, result1 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheClient) Prune(arg1 string) error {
+	fake.pruneMutex.Lock()
+	ret, specificReturn := fake.pruneReturnsOnCall[len(fake.pruneArgsForCall)]
+	fake.pruneArgsForCall = append(fake.pruneArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PruneStub
+	fakeReturns := fake.pruneReturns
+	fake.recordInvocation("Prune", []interface{}{arg1})
+	fake.pruneMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCacheClient) PruneCallCount() int {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	return len(fake.pruneArgsForCall)
+}
+
+func (fake *FakeCacheClient) PruneCalls(stub func(string) error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = stub
+}
+
+func (fake *FakeCacheClient) PruneArgsForCall(i int) string {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	argsForCall := fake.pruneArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCacheClient) PruneReturns(result1 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	fake.pruneReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheClient) PruneReturnsOnCall(i int, result1 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	if fake.pruneReturnsOnCall == nil {
+		fake.pruneReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pruneReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheClient) Verify() error {
+	fake.verifyMutex.Lock()
+	ret, specificReturn := fake.verifyReturnsOnCall[len(fake.verifyArgsForCall)]
+	fake.verifyArgsForCall = append(fake.verifyArgsForCall, struct {
+	}{})
+	stub := fake.VerifyStub
+	fakeReturns := fake.verifyReturns
+	fake.recordInvocation("Verify", []interface{}{})
+	fake.verifyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCacheClient) VerifyCallCount() int {
+	fake.verifyMutex.RLock()
+	defer fake.verifyMutex.RUnlock()
+	return len(fake.verifyArgsForCall)
+}
+
+func (fake *FakeCacheClient) VerifyCalls(stub func() error) {
+	fake.verifyMutex.Lock()
+	defer fake.verifyMutex.Unlock()
+	fake.VerifyStub = stub
+}
+
+func (fake *FakeCacheClient) VerifyReturns(result1 error) {
+	fake.verifyMutex.Lock()
+	defer fake.verifyMutex.Unlock()
+	fake.VerifyStub = nil
+	fake.verifyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheClient) VerifyReturnsOnCall(i int, result1 error) {
+	fake.verifyMutex.Lock()
+	defer fake.verifyMutex.Unlock()
+	fake.VerifyStub = nil
+	if fake.verifyReturnsOnCall == nil {
+		fake.verifyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	fake.verifyMutex.RLock()
+	defer fake.verifyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCacheClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ commands.CacheClient = new(FakeCacheClient)
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commandsfakes
+
+import (
+	"io"
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+)
+
+type FakePivfileClient struct {
+	SyncStub        func(string, string, bool, bool, io.Writer) error
+	syncMutex       sync.RWMutex
+	syncArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 bool
+		arg5 io.Writer
+	}
+	syncReturns struct {
+		result1 error
+	}
+	syncReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePivfileClient) Sync(arg1 string, arg2 string, arg3 bool, arg4 bool, arg5 io.Writer) error {
+	fake.syncMutex.Lock()
+	ret, specificReturn := fake.syncReturnsOnCall[len(fake.syncArgsForCall)]
+	fake.syncArgsForCall = append(fake.syncArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 bool
+		arg5 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SyncStub
+	fakeReturns := fake.syncReturns
+	fake.recordInvocation("Sync", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.syncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivfileClient) SyncCallCount() int {
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	return len(fake.syncArgsForCall)
+}
+
+func (fake *FakePivfileClient) SyncCalls(stub func(string, string, bool, bool, io.Writer) error) {
+	fake.syncMutex.Lock()
+	defer fake.syncMutex.Unlock()
+	fake.SyncStub = stub
+}
+
+func (fake *FakePivfileClient) SyncArgsForCall(i int) (string, string, bool, bool, io.Writer) {
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	argsForCall := fake.syncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePivfileClient) SyncReturns(result1 error) {
+	fake.syncMutex.Lock()
+	defer fake.syncMutex.Unlock()
+	fake.SyncStub = nil
+	fake.syncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivfileClient) SyncReturnsOnCall(i int, result1 error) {
+	fake.syncMutex.Lock()
+	defer fake.syncMutex.Unlock()
+	fake.SyncStub = nil
+	if fake.syncReturnsOnCall == nil {
+		fake.syncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivfileClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePivfileClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ commands.PivfileClient = new(FakePivfileClient)
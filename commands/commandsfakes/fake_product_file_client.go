@@ -7,6 +7,7 @@ import (
 
 	pivnet "github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
 )
 
 type FakeProductFileClient struct {
@@ -59,7 +60,7 @@ type FakeProductFileClient struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DownloadStub        func(string, string, []string, []int, string, bool, io.Writer) error
+	DownloadStub        func(string, string, []string, []int, string, bool, io.Writer, productfile.DownloadOptions) ([]string, error)
 	downloadMutex       sync.RWMutex
 	downloadArgsForCall []struct {
 		arg1 string
@@ -69,12 +70,15 @@ type FakeProductFileClient struct {
 		arg5 string
 		arg6 bool
 		arg7 io.Writer
+		arg8 productfile.DownloadOptions
 	}
 	downloadReturns struct {
-		result1 error
+		result1 []string
+		result2 error
 	}
 	downloadReturnsOnCall map[int]struct {
-		result1 error
+		result1 []string
+		result2 error
 	}
 	GetStub        func(string, string, int) error
 	getMutex       sync.RWMutex
@@ -158,15 +162,16 @@ func (fake *FakeProductFileClient) AddToFileGroup(arg1 string, arg2 int, arg3 in
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddToFileGroupStub
+	fakeReturns := fake.addToFileGroupReturns
 	fake.recordInvocation("AddToFileGroup", []interface{}{arg1, arg2, arg3})
 	fake.addToFileGroupMutex.Unlock()
-	if fake.AddToFileGroupStub != nil {
-		return fake.AddToFileGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addToFileGroupReturns
 	return fakeReturns.result1
 }
 
@@ -220,15 +225,16 @@ func (fake *FakeProductFileClient) AddToRelease(arg1 string, arg2 string, arg3 i
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddToReleaseStub
+	fakeReturns := fake.addToReleaseReturns
 	fake.recordInvocation("AddToRelease", []interface{}{arg1, arg2, arg3})
 	fake.addToReleaseMutex.Unlock()
-	if fake.AddToReleaseStub != nil {
-		return fake.AddToReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addToReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -280,15 +286,16 @@ func (fake *FakeProductFileClient) Create(arg1 pivnet.CreateProductFileConfig) e
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
 		arg1 pivnet.CreateProductFileConfig
 	}{arg1})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
 	fake.recordInvocation("Create", []interface{}{arg1})
 	fake.createMutex.Unlock()
-	if fake.CreateStub != nil {
-		return fake.CreateStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.createReturns
 	return fakeReturns.result1
 }
 
@@ -341,15 +348,16 @@ func (fake *FakeProductFileClient) Delete(arg1 string, arg2 int) error {
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
 	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
 	fake.deleteMutex.Unlock()
-	if fake.DeleteStub != nil {
-		return fake.DeleteStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteReturns
 	return fakeReturns.result1
 }
 
@@ -395,7 +403,7 @@ func (fake *FakeProductFileClient) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeProductFileClient) Download(arg1 string, arg2 string, arg3 []string, arg4 []int, arg5 string, arg6 bool, arg7 io.Writer) error {
+func (fake *FakeProductFileClient) Download(arg1 string, arg2 string, arg3 []string, arg4 []int, arg5 string, arg6 bool, arg7 io.Writer, arg8 productfile.DownloadOptions) ([]string, error) {
 	var arg3Copy []string
 	if arg3 != nil {
 		arg3Copy = make([]string, len(arg3))
@@ -416,17 +424,19 @@ func (fake *FakeProductFileClient) Download(arg1 string, arg2 string, arg3 []str
 		arg5 string
 		arg6 bool
 		arg7 io.Writer
-	}{arg1, arg2, arg3Copy, arg4Copy, arg5, arg6, arg7})
-	fake.recordInvocation("Download", []interface{}{arg1, arg2, arg3Copy, arg4Copy, arg5, arg6, arg7})
+		arg8 productfile.DownloadOptions
+	}{arg1, arg2, arg3Copy, arg4Copy, arg5, arg6, arg7, arg8})
+	stub := fake.DownloadStub
+	fakeReturns := fake.downloadReturns
+	fake.recordInvocation("Download", []interface{}{arg1, arg2, arg3Copy, arg4Copy, arg5, arg6, arg7, arg8})
 	fake.downloadMutex.Unlock()
-	if fake.DownloadStub != nil {
-		return fake.DownloadStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.downloadReturns
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeProductFileClient) DownloadCallCount() int {
@@ -435,40 +445,43 @@ func (fake *FakeProductFileClient) DownloadCallCount() int {
 	return len(fake.downloadArgsForCall)
 }
 
-func (fake *FakeProductFileClient) DownloadCalls(stub func(string, string, []string, []int, string, bool, io.Writer) error) {
+func (fake *FakeProductFileClient) DownloadCalls(stub func(string, string, []string, []int, string, bool, io.Writer, productfile.DownloadOptions) ([]string, error)) {
 	fake.downloadMutex.Lock()
 	defer fake.downloadMutex.Unlock()
 	fake.DownloadStub = stub
 }
 
-func (fake *FakeProductFileClient) DownloadArgsForCall(i int) (string, string, []string, []int, string, bool, io.Writer) {
+func (fake *FakeProductFileClient) DownloadArgsForCall(i int) (string, string, []string, []int, string, bool, io.Writer, productfile.DownloadOptions) {
 	fake.downloadMutex.RLock()
 	defer fake.downloadMutex.RUnlock()
 	argsForCall := fake.downloadArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
-func (fake *FakeProductFileClient) DownloadReturns(result1 error) {
+func (fake *FakeProductFileClient) DownloadReturns(result1 []string, result2 error) {
 	fake.downloadMutex.Lock()
 	defer fake.downloadMutex.Unlock()
 	fake.DownloadStub = nil
 	fake.downloadReturns = struct {
-		result1 error
-	}{result1}
+		result1 []string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeProductFileClient) DownloadReturnsOnCall(i int, result1 error) {
+func (fake *FakeProductFileClient) DownloadReturnsOnCall(i int, result1 []string, result2 error) {
 	fake.downloadMutex.Lock()
 	defer fake.downloadMutex.Unlock()
 	fake.DownloadStub = nil
 	if fake.downloadReturnsOnCall == nil {
 		fake.downloadReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 []string
+			result2 error
 		})
 	}
 	fake.downloadReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 []string
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeProductFileClient) Get(arg1 string, arg2 string, arg3 int) error {
@@ -479,15 +492,16 @@ func (fake *FakeProductFileClient) Get(arg1 string, arg2 string, arg3 int) error
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
 	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3})
 	fake.getMutex.Unlock()
-	if fake.GetStub != nil {
-		return fake.GetStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.getReturns
 	return fakeReturns.result1
 }
 
@@ -540,15 +554,16 @@ func (fake *FakeProductFileClient) List(arg1 string, arg2 string) error {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
 	fake.recordInvocation("List", []interface{}{arg1, arg2})
 	fake.listMutex.Unlock()
-	if fake.ListStub != nil {
-		return fake.ListStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listReturns
 	return fakeReturns.result1
 }
 
@@ -602,15 +617,16 @@ func (fake *FakeProductFileClient) RemoveFromFileGroup(arg1 string, arg2 int, ar
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromFileGroupStub
+	fakeReturns := fake.removeFromFileGroupReturns
 	fake.recordInvocation("RemoveFromFileGroup", []interface{}{arg1, arg2, arg3})
 	fake.removeFromFileGroupMutex.Unlock()
-	if fake.RemoveFromFileGroupStub != nil {
-		return fake.RemoveFromFileGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeFromFileGroupReturns
 	return fakeReturns.result1
 }
 
@@ -664,15 +680,16 @@ func (fake *FakeProductFileClient) RemoveFromRelease(arg1 string, arg2 string, a
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromReleaseStub
+	fakeReturns := fake.removeFromReleaseReturns
 	fake.recordInvocation("RemoveFromRelease", []interface{}{arg1, arg2, arg3})
 	fake.removeFromReleaseMutex.Unlock()
-	if fake.RemoveFromReleaseStub != nil {
-		return fake.RemoveFromReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeFromReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -732,15 +749,16 @@ func (fake *FakeProductFileClient) Update(arg1 int, arg2 string, arg3 *string, a
 		arg8 *string
 		arg9 *[]string
 	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
 	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
 	fake.updateMutex.Unlock()
-	if fake.UpdateStub != nil {
-		return fake.UpdateStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.updateReturns
 	return fakeReturns.result1
 }
 
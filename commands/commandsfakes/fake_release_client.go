@@ -5,16 +5,14 @@ import (
 	"sync"
 
 	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/release"
 )
 
 type FakeReleaseClient struct {
-	CreateStub        func(string, string, string, string) error
+	CreateStub        func(release.Attributes) error
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 string
-		arg4 string
+		arg1 release.Attributes
 	}
 	createReturns struct {
 		result1 error
@@ -57,6 +55,18 @@ type FakeReleaseClient struct {
 	listReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListSortedBySemverStub        func(string, string) error
+	listSortedBySemverMutex       sync.RWMutex
+	listSortedBySemverArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listSortedBySemverReturns struct {
+		result1 error
+	}
+	listSortedBySemverReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ListWithLimitStub        func(string, string) error
 	listWithLimitMutex       sync.RWMutex
 	listWithLimitArgsForCall []struct {
@@ -69,13 +79,14 @@ type FakeReleaseClient struct {
 	listWithLimitReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateStub        func(string, string, *string, *string) error
+	UpdateStub        func(string, string, release.Changes, string, bool) error
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 *string
-		arg4 *string
+		arg3 release.Changes
+		arg4 string
+		arg5 bool
 	}
 	updateReturns struct {
 		result1 error
@@ -87,24 +98,22 @@ type FakeReleaseClient struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeReleaseClient) Create(arg1 string, arg2 string, arg3 string, arg4 string) error {
+func (fake *FakeReleaseClient) Create(arg1 release.Attributes) error {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3, arg4})
+		arg1 release.Attributes
+	}{arg1})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1})
 	fake.createMutex.Unlock()
-	if fake.CreateStub != nil {
-		return fake.CreateStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.createReturns
 	return fakeReturns.result1
 }
 
@@ -114,17 +123,17 @@ func (fake *FakeReleaseClient) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeReleaseClient) CreateCalls(stub func(string, string, string, string) error) {
+func (fake *FakeReleaseClient) CreateCalls(stub func(release.Attributes) error) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = stub
 }
 
-func (fake *FakeReleaseClient) CreateArgsForCall(i int) (string, string, string, string) {
+func (fake *FakeReleaseClient) CreateArgsForCall(i int) release.Attributes {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	argsForCall := fake.createArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1
 }
 
 func (fake *FakeReleaseClient) CreateReturns(result1 error) {
@@ -157,15 +166,16 @@ func (fake *FakeReleaseClient) Delete(arg1 string, arg2 string) error {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
 	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
 	fake.deleteMutex.Unlock()
-	if fake.DeleteStub != nil {
-		return fake.DeleteStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteReturns
 	return fakeReturns.result1
 }
 
@@ -218,15 +228,16 @@ func (fake *FakeReleaseClient) Get(arg1 string, arg2 string) error {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
 	fake.recordInvocation("Get", []interface{}{arg1, arg2})
 	fake.getMutex.Unlock()
-	if fake.GetStub != nil {
-		return fake.GetStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.getReturns
 	return fakeReturns.result1
 }
 
@@ -278,15 +289,16 @@ func (fake *FakeReleaseClient) List(arg1 string) error {
 	fake.listArgsForCall = append(fake.listArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
 	fake.recordInvocation("List", []interface{}{arg1})
 	fake.listMutex.Unlock()
-	if fake.ListStub != nil {
-		return fake.ListStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listReturns
 	return fakeReturns.result1
 }
 
@@ -332,6 +344,68 @@ func (fake *FakeReleaseClient) ListReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeReleaseClient) ListSortedBySemver(arg1 string, arg2 string) error {
+	fake.listSortedBySemverMutex.Lock()
+	ret, specificReturn := fake.listSortedBySemverReturnsOnCall[len(fake.listSortedBySemverArgsForCall)]
+	fake.listSortedBySemverArgsForCall = append(fake.listSortedBySemverArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListSortedBySemverStub
+	fakeReturns := fake.listSortedBySemverReturns
+	fake.recordInvocation("ListSortedBySemver", []interface{}{arg1, arg2})
+	fake.listSortedBySemverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseClient) ListSortedBySemverCallCount() int {
+	fake.listSortedBySemverMutex.RLock()
+	defer fake.listSortedBySemverMutex.RUnlock()
+	return len(fake.listSortedBySemverArgsForCall)
+}
+
+func (fake *FakeReleaseClient) ListSortedBySemverCalls(stub func(string, string) error) {
+	fake.listSortedBySemverMutex.Lock()
+	defer fake.listSortedBySemverMutex.Unlock()
+	fake.ListSortedBySemverStub = stub
+}
+
+func (fake *FakeReleaseClient) ListSortedBySemverArgsForCall(i int) (string, string) {
+	fake.listSortedBySemverMutex.RLock()
+	defer fake.listSortedBySemverMutex.RUnlock()
+	argsForCall := fake.listSortedBySemverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseClient) ListSortedBySemverReturns(result1 error) {
+	fake.listSortedBySemverMutex.Lock()
+	defer fake.listSortedBySemverMutex.Unlock()
+	fake.ListSortedBySemverStub = nil
+	fake.listSortedBySemverReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) ListSortedBySemverReturnsOnCall(i int, result1 error) {
+	fake.listSortedBySemverMutex.Lock()
+	defer fake.listSortedBySemverMutex.Unlock()
+	fake.ListSortedBySemverStub = nil
+	if fake.listSortedBySemverReturnsOnCall == nil {
+		fake.listSortedBySemverReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listSortedBySemverReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReleaseClient) ListWithLimit(arg1 string, arg2 string) error {
 	fake.listWithLimitMutex.Lock()
 	ret, specificReturn := fake.listWithLimitReturnsOnCall[len(fake.listWithLimitArgsForCall)]
@@ -339,15 +413,16 @@ func (fake *FakeReleaseClient) ListWithLimit(arg1 string, arg2 string) error {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ListWithLimitStub
+	fakeReturns := fake.listWithLimitReturns
 	fake.recordInvocation("ListWithLimit", []interface{}{arg1, arg2})
 	fake.listWithLimitMutex.Unlock()
-	if fake.ListWithLimitStub != nil {
-		return fake.ListWithLimitStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listWithLimitReturns
 	return fakeReturns.result1
 }
 
@@ -393,24 +468,26 @@ func (fake *FakeReleaseClient) ListWithLimitReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeReleaseClient) Update(arg1 string, arg2 string, arg3 *string, arg4 *string) error {
+func (fake *FakeReleaseClient) Update(arg1 string, arg2 string, arg3 release.Changes, arg4 string, arg5 bool) error {
 	fake.updateMutex.Lock()
 	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
 	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 *string
-		arg4 *string
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3, arg4})
+		arg3 release.Changes
+		arg4 string
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.updateMutex.Unlock()
-	if fake.UpdateStub != nil {
-		return fake.UpdateStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.updateReturns
 	return fakeReturns.result1
 }
 
@@ -420,17 +497,17 @@ func (fake *FakeReleaseClient) UpdateCallCount() int {
 	return len(fake.updateArgsForCall)
 }
 
-func (fake *FakeReleaseClient) UpdateCalls(stub func(string, string, *string, *string) error) {
+func (fake *FakeReleaseClient) UpdateCalls(stub func(string, string, release.Changes, string, bool) error) {
 	fake.updateMutex.Lock()
 	defer fake.updateMutex.Unlock()
 	fake.UpdateStub = stub
 }
 
-func (fake *FakeReleaseClient) UpdateArgsForCall(i int) (string, string, *string, *string) {
+func (fake *FakeReleaseClient) UpdateArgsForCall(i int) (string, string, release.Changes, string, bool) {
 	fake.updateMutex.RLock()
 	defer fake.updateMutex.RUnlock()
 	argsForCall := fake.updateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeReleaseClient) UpdateReturns(result1 error) {
@@ -467,6 +544,8 @@ func (fake *FakeReleaseClient) Invocations() map[string][][]interface{} {
 	defer fake.getMutex.RUnlock()
 	fake.listMutex.RLock()
 	defer fake.listMutex.RUnlock()
+	fake.listSortedBySemverMutex.RLock()
+	defer fake.listSortedBySemverMutex.RUnlock()
 	fake.listWithLimitMutex.RLock()
 	defer fake.listWithLimitMutex.RUnlock()
 	fake.updateMutex.RLock()
@@ -0,0 +1,130 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commandsfakes
+
+import (
+	"io"
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+)
+
+type FakeStemcellClient struct {
+	DownloadForTileStub        func(string, string, []string, string, bool, io.Writer, productfile.DownloadOptions) error
+	downloadForTileMutex       sync.RWMutex
+	downloadForTileArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+		arg4 string
+		arg5 bool
+		arg6 io.Writer
+		arg7 productfile.DownloadOptions
+	}
+	downloadForTileReturns struct {
+		result1 error
+	}
+	downloadForTileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStemcellClient) DownloadForTile(arg1 string, arg2 string, arg3 []string, arg4 string, arg5 bool, arg6 io.Writer, arg7 productfile.DownloadOptions) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.downloadForTileMutex.Lock()
+	ret, specificReturn := fake.downloadForTileReturnsOnCall[len(fake.downloadForTileArgsForCall)]
+	fake.downloadForTileArgsForCall = append(fake.downloadForTileArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+		arg4 string
+		arg5 bool
+		arg6 io.Writer
+		arg7 productfile.DownloadOptions
+	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7})
+	stub := fake.DownloadForTileStub
+	fakeReturns := fake.downloadForTileReturns
+	fake.recordInvocation("DownloadForTile", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7})
+	fake.downloadForTileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStemcellClient) DownloadForTileCallCount() int {
+	fake.downloadForTileMutex.RLock()
+	defer fake.downloadForTileMutex.RUnlock()
+	return len(fake.downloadForTileArgsForCall)
+}
+
+func (fake *FakeStemcellClient) DownloadForTileCalls(stub func(string, string, []string, string, bool, io.Writer, productfile.DownloadOptions) error) {
+	fake.downloadForTileMutex.Lock()
+	defer fake.downloadForTileMutex.Unlock()
+	fake.DownloadForTileStub = stub
+}
+
+func (fake *FakeStemcellClient) DownloadForTileArgsForCall(i int) (string, string, []string, string, bool, io.Writer, productfile.DownloadOptions) {
+	fake.downloadForTileMutex.RLock()
+	defer fake.downloadForTileMutex.RUnlock()
+	argsForCall := fake.downloadForTileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeStemcellClient) DownloadForTileReturns(result1 error) {
+	fake.downloadForTileMutex.Lock()
+	defer fake.downloadForTileMutex.Unlock()
+	fake.DownloadForTileStub = nil
+	fake.downloadForTileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStemcellClient) DownloadForTileReturnsOnCall(i int, result1 error) {
+	fake.downloadForTileMutex.Lock()
+	defer fake.downloadForTileMutex.Unlock()
+	fake.DownloadForTileStub = nil
+	if fake.downloadForTileReturnsOnCall == nil {
+		fake.downloadForTileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadForTileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStemcellClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.downloadForTileMutex.RLock()
+	defer fake.downloadForTileMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStemcellClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ commands.StemcellClient = new(FakeStemcellClient)
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+)
+
+const (
+	// ExitCodeTimedOut is the exit code when a command runs past --timeout.
+	ExitCodeTimedOut = 124
+
+	// ExitCodeInterrupted is the exit code when a command is stopped by
+	// SIGINT or SIGTERM.
+	ExitCodeInterrupted = 130
+)
+
+// Context is done when the command is interrupted or runs past --timeout.
+// Every request made by the Pivnet client is cancelled with it.
+var Context = context.Background()
+
+var cancelTimeout context.CancelFunc
+
+// NotifyContext returns a copy of parent that is cancelled when one of the
+// signals arrives, so that the command can stop and clean up. A second
+// signal exits straight away.
+func NotifyContext(parent context.Context, signals ...os.Signal) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+
+	go func() {
+		select {
+		case <-ch:
+			cancel()
+		case <-ctx.Done():
+			signal.Stop(ch)
+			return
+		}
+
+		<-ch
+		os.Exit(ExitCodeInterrupted)
+	}()
+
+	return ctx, func() {
+		signal.Stop(ch)
+		cancel()
+	}
+}
+
+// withTimeout applies --timeout to Context. It only does so once, however
+// many times Init is called.
+func withTimeout() {
+	if Pivnet.Timeout <= 0 {
+		return
+	}
+
+	if _, ok := Context.Deadline(); ok {
+		return
+	}
+
+	Context, cancelTimeout = context.WithTimeout(Context, Pivnet.Timeout)
+}
+
+// StopTimeout stops the --timeout timer set up by Init. It is called once the
+// command has finished.
+func StopTimeout() {
+	if cancelTimeout != nil {
+		cancelTimeout()
+		cancelTimeout = nil
+	}
+}
@@ -15,6 +15,7 @@ import (
 //go:generate counterfeiter . PivnetClient
 type PivnetClient interface {
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	DependencySpecifiers(productSlug string, releaseID int) ([]pivnet.DependencySpecifier, error)
 	DependencySpecifier(productSlug string, releaseID int, dependencySpecifierID int) (pivnet.DependencySpecifier, error)
 	CreateDependencySpecifier(productSlug string, releaseID int, dependentProductSlug string, specifier string) (pivnet.DependencySpecifier, error)
@@ -46,7 +47,7 @@ func NewDependencySpecifierClient(
 }
 
 func (c *DependencySpecifierClient) List(productSlug string, releaseVersion string) error {
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -67,7 +68,7 @@ func (c *DependencySpecifierClient) Get(
 	releaseVersion string,
 	dependencySpecifierID int,
 ) error {
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -102,7 +102,7 @@ var _ = Describe("dependencyspecifier commands", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("releases error")
-				fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 			})
 
 			It("invokes the error handler", func() {
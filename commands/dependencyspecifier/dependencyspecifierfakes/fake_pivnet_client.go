@@ -67,6 +67,20 @@ type FakePivnetClient struct {
 		result1 []pivnet.DependencySpecifier
 		result2 error
 	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -94,15 +108,16 @@ func (fake *FakePivnetClient) CreateDependencySpecifier(arg1 string, arg2 int, a
 		arg3 string
 		arg4 string
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateDependencySpecifierStub
+	fakeReturns := fake.createDependencySpecifierReturns
 	fake.recordInvocation("CreateDependencySpecifier", []interface{}{arg1, arg2, arg3, arg4})
 	fake.createDependencySpecifierMutex.Unlock()
-	if fake.CreateDependencySpecifierStub != nil {
-		return fake.CreateDependencySpecifierStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createDependencySpecifierReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -159,15 +174,16 @@ func (fake *FakePivnetClient) DeleteDependencySpecifier(arg1 string, arg2 int, a
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteDependencySpecifierStub
+	fakeReturns := fake.deleteDependencySpecifierReturns
 	fake.recordInvocation("DeleteDependencySpecifier", []interface{}{arg1, arg2, arg3})
 	fake.deleteDependencySpecifierMutex.Unlock()
-	if fake.DeleteDependencySpecifierStub != nil {
-		return fake.DeleteDependencySpecifierStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteDependencySpecifierReturns
 	return fakeReturns.result1
 }
 
@@ -221,15 +237,16 @@ func (fake *FakePivnetClient) DependencySpecifier(arg1 string, arg2 int, arg3 in
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.DependencySpecifierStub
+	fakeReturns := fake.dependencySpecifierReturns
 	fake.recordInvocation("DependencySpecifier", []interface{}{arg1, arg2, arg3})
 	fake.dependencySpecifierMutex.Unlock()
-	if fake.DependencySpecifierStub != nil {
-		return fake.DependencySpecifierStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.dependencySpecifierReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -285,15 +302,16 @@ func (fake *FakePivnetClient) DependencySpecifiers(arg1 string, arg2 int) ([]piv
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.DependencySpecifiersStub
+	fakeReturns := fake.dependencySpecifiersReturns
 	fake.recordInvocation("DependencySpecifiers", []interface{}{arg1, arg2})
 	fake.dependencySpecifiersMutex.Unlock()
-	if fake.DependencySpecifiersStub != nil {
-		return fake.DependencySpecifiersStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.dependencySpecifiersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -342,6 +360,71 @@ func (fake *FakePivnetClient) DependencySpecifiersReturnsOnCall(i int, result1 [
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -349,15 +432,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -417,6 +501,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.dependencySpecifierMutex.RUnlock()
 	fake.dependencySpecifiersMutex.RLock()
 	defer fake.dependencySpecifiersMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
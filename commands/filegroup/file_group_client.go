@@ -18,6 +18,7 @@ type PivnetClient interface {
 	FileGroups(productSlug string) ([]pivnet.FileGroup, error)
 	FileGroupsForRelease(productSlug string, releaseID int) ([]pivnet.FileGroup, error)
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	FileGroup(productSlug string, fileGroupID int) (pivnet.FileGroup, error)
 	CreateFileGroup(productSlug string, name string) (pivnet.FileGroup, error)
 	UpdateFileGroup(productSlug string, fileGroup pivnet.FileGroup) (pivnet.FileGroup, error)
@@ -60,7 +61,7 @@ func (c *FileGroupClient) List(productSlug string, releaseVersion string) error
 		return c.printFileGroups(fileGroups)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -91,6 +91,20 @@ type FakePivnetClient struct {
 		result1 []pivnet.FileGroup
 		result2 error
 	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -144,15 +158,16 @@ func (fake *FakePivnetClient) AddFileGroupToRelease(arg1 string, arg2 int, arg3
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddFileGroupToReleaseStub
+	fakeReturns := fake.addFileGroupToReleaseReturns
 	fake.recordInvocation("AddFileGroupToRelease", []interface{}{arg1, arg2, arg3})
 	fake.addFileGroupToReleaseMutex.Unlock()
-	if fake.AddFileGroupToReleaseStub != nil {
-		return fake.AddFileGroupToReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addFileGroupToReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -205,15 +220,16 @@ func (fake *FakePivnetClient) CreateFileGroup(arg1 string, arg2 string) (pivnet.
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.CreateFileGroupStub
+	fakeReturns := fake.createFileGroupReturns
 	fake.recordInvocation("CreateFileGroup", []interface{}{arg1, arg2})
 	fake.createFileGroupMutex.Unlock()
-	if fake.CreateFileGroupStub != nil {
-		return fake.CreateFileGroupStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createFileGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -269,15 +285,16 @@ func (fake *FakePivnetClient) DeleteFileGroup(arg1 string, arg2 int) (pivnet.Fil
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.DeleteFileGroupStub
+	fakeReturns := fake.deleteFileGroupReturns
 	fake.recordInvocation("DeleteFileGroup", []interface{}{arg1, arg2})
 	fake.deleteFileGroupMutex.Unlock()
-	if fake.DeleteFileGroupStub != nil {
-		return fake.DeleteFileGroupStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteFileGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -333,15 +350,16 @@ func (fake *FakePivnetClient) FileGroup(arg1 string, arg2 int) (pivnet.FileGroup
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.FileGroupStub
+	fakeReturns := fake.fileGroupReturns
 	fake.recordInvocation("FileGroup", []interface{}{arg1, arg2})
 	fake.fileGroupMutex.Unlock()
-	if fake.FileGroupStub != nil {
-		return fake.FileGroupStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.fileGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -396,15 +414,16 @@ func (fake *FakePivnetClient) FileGroups(arg1 string) ([]pivnet.FileGroup, error
 	fake.fileGroupsArgsForCall = append(fake.fileGroupsArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.FileGroupsStub
+	fakeReturns := fake.fileGroupsReturns
 	fake.recordInvocation("FileGroups", []interface{}{arg1})
 	fake.fileGroupsMutex.Unlock()
-	if fake.FileGroupsStub != nil {
-		return fake.FileGroupsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.fileGroupsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -460,15 +479,16 @@ func (fake *FakePivnetClient) FileGroupsForRelease(arg1 string, arg2 int) ([]piv
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.FileGroupsForReleaseStub
+	fakeReturns := fake.fileGroupsForReleaseReturns
 	fake.recordInvocation("FileGroupsForRelease", []interface{}{arg1, arg2})
 	fake.fileGroupsForReleaseMutex.Unlock()
-	if fake.FileGroupsForReleaseStub != nil {
-		return fake.FileGroupsForReleaseStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.fileGroupsForReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -517,6 +537,71 @@ func (fake *FakePivnetClient) FileGroupsForReleaseReturnsOnCall(i int, result1 [
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -524,15 +609,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -589,15 +675,16 @@ func (fake *FakePivnetClient) RemoveFileGroupFromRelease(arg1 string, arg2 int,
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveFileGroupFromReleaseStub
+	fakeReturns := fake.removeFileGroupFromReleaseReturns
 	fake.recordInvocation("RemoveFileGroupFromRelease", []interface{}{arg1, arg2, arg3})
 	fake.removeFileGroupFromReleaseMutex.Unlock()
-	if fake.RemoveFileGroupFromReleaseStub != nil {
-		return fake.RemoveFileGroupFromReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeFileGroupFromReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -650,15 +737,16 @@ func (fake *FakePivnetClient) UpdateFileGroup(arg1 string, arg2 pivnet.FileGroup
 		arg1 string
 		arg2 pivnet.FileGroup
 	}{arg1, arg2})
+	stub := fake.UpdateFileGroupStub
+	fakeReturns := fake.updateFileGroupReturns
 	fake.recordInvocation("UpdateFileGroup", []interface{}{arg1, arg2})
 	fake.updateFileGroupMutex.Unlock()
-	if fake.UpdateFileGroupStub != nil {
-		return fake.UpdateFileGroupStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateFileGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -722,6 +810,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.fileGroupsMutex.RUnlock()
 	fake.fileGroupsForReleaseMutex.RLock()
 	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
 	fake.removeFileGroupFromReleaseMutex.RLock()
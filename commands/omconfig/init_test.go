@@ -0,0 +1,13 @@
+package omconfig_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOMConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OMConfig Suite")
+}
@@ -0,0 +1,144 @@
+package omconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the part of an `om download-product` config file that applies to
+// downloading from Pivnet, e.g.
+//
+//	pivnet-api-token: some-token
+//	pivnet-product-slug: elastic-runtime
+//	pivnet-file-glob: "srt-*.pivotal"
+//	product-version-regex: ^2\.10\..*$
+//	stemcell-iaas: aws
+type Config struct {
+	APIToken            string `yaml:"pivnet-api-token"`
+	DisableSSL          bool   `yaml:"pivnet-disable-ssl"`
+	ProductSlug         string `yaml:"pivnet-product-slug"`
+	FileGlob            string `yaml:"pivnet-file-glob"`
+	ProductVersion      string `yaml:"product-version"`
+	ProductVersionRegex string `yaml:"product-version-regex"`
+	OutputDirectory     string `yaml:"output-directory"`
+	StemcellIaaS        string `yaml:"stemcell-iaas"`
+	StemcellHeavy       bool   `yaml:"stemcell-heavy"`
+}
+
+// fileGlobKey is the name that newer versions of om use for pivnet-file-glob.
+const fileGlobKey = "file-glob"
+
+var supportedKeys = []string{
+	fileGlobKey,
+	"output-directory",
+	"pivnet-api-token",
+	"pivnet-disable-ssl",
+	"pivnet-file-glob",
+	"pivnet-product-slug",
+	"product-version",
+	"product-version-regex",
+	"stemcell-heavy",
+	"stemcell-iaas",
+}
+
+// ReadConfig reads the config file at path. Keys that are not supported fail
+// rather than being ignored, so that a config meant for another source, such
+// as s3, is not silently downloaded from Pivnet instead.
+func ReadConfig(path string) (Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var keys map[string]interface{}
+	err = yaml.Unmarshal(b, &keys)
+	if err != nil {
+		return Config{}, fmt.Errorf("could not parse %s: %s", path, err)
+	}
+
+	var unknownKeys []string
+	for key := range keys {
+		if !isSupported(key) {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+
+	if len(unknownKeys) > 0 {
+		sort.Strings(unknownKeys)
+		return Config{}, fmt.Errorf(
+			"unknown keys in %s: %s (the supported keys are: %s)",
+			path,
+			strings.Join(unknownKeys, ", "),
+			strings.Join(supportedKeys, ", "),
+		)
+	}
+
+	var config Config
+	err = yaml.Unmarshal(b, &config)
+	if err != nil {
+		return Config{}, fmt.Errorf("could not parse %s: %s", path, err)
+	}
+
+	if glob, ok := keys[fileGlobKey].(string); ok && config.FileGlob == "" {
+		config.FileGlob = glob
+	}
+
+	err = config.validate()
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid config in %s: %s", path, err)
+	}
+
+	return config, nil
+}
+
+func (c Config) validate() error {
+	if c.ProductSlug == "" {
+		return fmt.Errorf("pivnet-product-slug is required")
+	}
+
+	if c.FileGlob == "" {
+		return fmt.Errorf("pivnet-file-glob is required")
+	}
+
+	if c.ProductVersion == "" && c.ProductVersionRegex == "" {
+		return fmt.Errorf("one of product-version or product-version-regex is required")
+	}
+
+	if c.ProductVersion != "" && c.ProductVersionRegex != "" {
+		return fmt.Errorf("only one of product-version or product-version-regex can be provided")
+	}
+
+	if c.ProductVersionRegex != "" {
+		_, err := regexp.Compile(c.ProductVersionRegex)
+		if err != nil {
+			return fmt.Errorf("product-version-regex '%s' is not a valid regex: %s", c.ProductVersionRegex, err)
+		}
+	}
+
+	return nil
+}
+
+// StemcellGlob returns the glob that matches the stemcell for StemcellIaaS.
+// Only heavy stemcells are matched when StemcellHeavy is set.
+func (c Config) StemcellGlob() string {
+	if c.StemcellHeavy {
+		return fmt.Sprintf("bosh-stemcell-*-%s*", c.StemcellIaaS)
+	}
+
+	return fmt.Sprintf("*-%s*", c.StemcellIaaS)
+}
+
+func isSupported(key string) bool {
+	for _, k := range supportedKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
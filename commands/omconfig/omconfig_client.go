@@ -0,0 +1,185 @@
+package omconfig
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/semver"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
+}
+
+//go:generate counterfeiter . Filter
+type Filter interface {
+	ReleasesByVersion(releases []pivnet.Release, version string) ([]pivnet.Release, error)
+}
+
+//go:generate counterfeiter . Downloader
+type Downloader interface {
+	Download(productSlug string, releaseVersion string, globs []string, productFileIDs []int, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) ([]string, error)
+}
+
+//go:generate counterfeiter . StemcellDownloader
+type StemcellDownloader interface {
+	DownloadForTile(tilePath string, productSlug string, globs []string, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) error
+}
+
+type OMConfigClient struct {
+	pivnetClient       PivnetClient
+	downloader         Downloader
+	stemcellDownloader StemcellDownloader
+	filter             Filter
+	eh                 errorhandler.ErrorHandler
+	l                  logger.Logger
+}
+
+func NewOMConfigClient(
+	pivnetClient PivnetClient,
+	downloader Downloader,
+	stemcellDownloader StemcellDownloader,
+	filter Filter,
+	eh errorhandler.ErrorHandler,
+	l logger.Logger,
+) *OMConfigClient {
+	return &OMConfigClient{
+		pivnetClient:       pivnetClient,
+		downloader:         downloader,
+		stemcellDownloader: stemcellDownloader,
+		filter:             filter,
+		eh:                 eh,
+		l:                  l,
+	}
+}
+
+// Download downloads the product files described by config to downloadDir.
+// With product-version-regex, the newest matching release by semver is
+// downloaded. With stemcell-iaas, the stemcell for each downloaded tile is
+// downloaded too.
+func (c *OMConfigClient) Download(
+	config Config,
+	downloadDir string,
+	acceptEULA bool,
+	progressWriter io.Writer,
+	options productfile.DownloadOptions,
+) error {
+	releaseVersion := config.ProductVersion
+
+	if config.ProductVersionRegex != "" {
+		var err error
+		releaseVersion, err = c.newestReleaseVersion(config.ProductSlug, config.ProductVersionRegex)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		c.l.Info(fmt.Sprintf(
+			"Resolved product-version-regex '%s' to %s %s",
+			config.ProductVersionRegex,
+			config.ProductSlug,
+			releaseVersion,
+		))
+	}
+
+	localPaths, err := c.downloader.Download(
+		config.ProductSlug,
+		releaseVersion,
+		[]string{config.FileGlob},
+		nil,
+		downloadDir,
+		acceptEULA,
+		progressWriter,
+		options,
+	)
+	if err != nil {
+		return err
+	}
+
+	if config.StemcellIaaS == "" || options.DryRun {
+		return nil
+	}
+
+	var tilePaths []string
+	for _, p := range localPaths {
+		if tile.IsTile(p) {
+			tilePaths = append(tilePaths, p)
+		}
+	}
+
+	if len(tilePaths) == 0 {
+		err := fmt.Errorf(
+			"stemcell-iaas is set but no tile (.pivotal file) matching '%s' was downloaded",
+			config.FileGlob,
+		)
+		return c.eh.HandleError(err)
+	}
+
+	// The stemcell is not one of the files the metadata or inspection is
+	// about.
+	stemcellOptions := options
+	stemcellOptions.Inspect = false
+	stemcellOptions.WriteMetadata = false
+
+	for _, tilePath := range tilePaths {
+		err := c.stemcellDownloader.DownloadForTile(
+			tilePath,
+			"",
+			[]string{config.StemcellGlob()},
+			downloadDir,
+			acceptEULA,
+			progressWriter,
+			stemcellOptions,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newestReleaseVersion returns the highest version by semver of the releases
+// of productSlug that match versionRegex. Versions that cannot be parsed are
+// skipped.
+func (c *OMConfigClient) newestReleaseVersion(productSlug string, versionRegex string) (string, error) {
+	releases, err := c.pivnetClient.ReleasesForProductSlug(productSlug)
+	if err != nil {
+		return "", err
+	}
+
+	matchingReleases, err := c.filter.ReleasesByVersion(releases, versionRegex)
+	if err != nil {
+		return "", err
+	}
+
+	var newest string
+	var newestVersion semver.Version
+	for _, r := range matchingReleases {
+		v, err := semver.ParseLenient(r.Version)
+		if err != nil {
+			c.l.Debug("Skipping release with unparseable version", logger.Data{"version": r.Version})
+			continue
+		}
+
+		if newest == "" || v.Compare(newestVersion) > 0 {
+			newest = r.Version
+			newestVersion = v
+		}
+	}
+
+	if newest == "" {
+		return "", fmt.Errorf(
+			"no release of '%s' matches product-version-regex '%s'",
+			productSlug,
+			versionRegex,
+		)
+	}
+
+	return newest, nil
+}
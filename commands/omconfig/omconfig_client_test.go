@@ -0,0 +1,256 @@
+package omconfig_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/omconfig"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/omconfig/omconfigfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
+)
+
+var _ = Describe("omconfig commands", func() {
+	var (
+		fakePivnetClient       *omconfigfakes.FakePivnetClient
+		fakeDownloader         *omconfigfakes.FakeDownloader
+		fakeStemcellDownloader *omconfigfakes.FakeStemcellDownloader
+		fakeErrorHandler       *errorhandlerfakes.FakeErrorHandler
+
+		downloadDir string
+
+		config  omconfig.Config
+		options productfile.DownloadOptions
+
+		client *omconfig.OMConfigClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		downloadDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakePivnetClient = &omconfigfakes.FakePivnetClient{}
+		fakeDownloader = &omconfigfakes.FakeDownloader{}
+		fakeStemcellDownloader = &omconfigfakes.FakeStemcellDownloader{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		fakePivnetClient.ReleasesForProductSlugReturns([]pivnet.Release{
+			{ID: 1, Version: "2.10.9"},
+			{ID: 2, Version: "2.10.10"},
+			{ID: 3, Version: "2.11.0"},
+			{ID: 4, Version: "not-a-version-2.10"},
+		}, nil)
+
+		config = omconfig.Config{
+			ProductSlug:         "elastic-runtime",
+			FileGlob:            "srt-*.pivotal",
+			ProductVersionRegex: `^2\.10\..*$`,
+		}
+		options = productfile.DownloadOptions{Parallel: 2, Inspect: true, WriteMetadata: true}
+
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = omconfig.NewOMConfigClient(
+			fakePivnetClient,
+			fakeDownloader,
+			fakeStemcellDownloader,
+			filter.NewFilter(l),
+			fakeErrorHandler,
+			l,
+		)
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(downloadDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Download", func() {
+		download := func() error {
+			return client.Download(config, downloadDir, true, GinkgoWriter, options)
+		}
+
+		It("downloads the newest release matching the version regex by semver", func() {
+			err := download()
+			Expect(err).NotTo(HaveOccurred())
+
+			invokedSlug, _ := fakePivnetClient.ReleasesForProductSlugArgsForCall(0)
+			Expect(invokedSlug).To(Equal("elastic-runtime"))
+
+			Expect(fakeDownloader.DownloadCallCount()).To(Equal(1))
+			slug, version, globs, productFileIDs, invokedDownloadDir, acceptEULA, _, invokedOptions := fakeDownloader.DownloadArgsForCall(0)
+			Expect(slug).To(Equal("elastic-runtime"))
+			Expect(version).To(Equal("2.10.10"))
+			Expect(globs).To(Equal([]string{"srt-*.pivotal"}))
+			Expect(productFileIDs).To(BeEmpty())
+			Expect(invokedDownloadDir).To(Equal(downloadDir))
+			Expect(acceptEULA).To(BeTrue())
+			Expect(invokedOptions).To(Equal(options))
+
+			Expect(fakeStemcellDownloader.DownloadForTileCallCount()).To(Equal(0))
+		})
+
+		Context("when an exact version is provided", func() {
+			BeforeEach(func() {
+				config.ProductVersionRegex = ""
+				config.ProductVersion = "2.10.3"
+			})
+
+			It("downloads it without listing releases", func() {
+				err := download()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.ReleasesForProductSlugCallCount()).To(Equal(0))
+
+				_, version, _, _, _, _, _, _ := fakeDownloader.DownloadArgsForCall(0)
+				Expect(version).To(Equal("2.10.3"))
+			})
+		})
+
+		Context("when no release matches the version regex", func() {
+			BeforeEach(func() {
+				config.ProductVersionRegex = `^3\..*$`
+			})
+
+			It("invokes the error handler without downloading", func() {
+				_ = download()
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				err := fakeErrorHandler.HandleErrorArgsForCall(0)
+				Expect(err.Error()).To(ContainSubstring("no release of 'elastic-runtime' matches product-version-regex"))
+
+				Expect(fakeDownloader.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listing releases returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("releases error")
+				fakePivnetClient.ReleasesForProductSlugReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				_ = download()
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when downloading returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				config.StemcellIaaS = "aws"
+				expectedErr = errors.New("download error")
+				fakeDownloader.DownloadReturns(nil, expectedErr)
+			})
+
+			It("forwards the error without downloading stemcells", func() {
+				err := download()
+				Expect(err).To(Equal(expectedErr))
+
+				Expect(fakeStemcellDownloader.DownloadForTileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a stemcell IaaS is provided", func() {
+			var (
+				tilePath string
+			)
+
+			BeforeEach(func() {
+				config.StemcellIaaS = "aws"
+
+				// With a path template the tile is not placed in the
+				// download directory itself.
+				tilePath = filepath.Join(downloadDir, "elastic-runtime", "srt-2.10.10-build.1.pivotal")
+				fakeDownloader.DownloadReturns([]string{
+					tilePath,
+					filepath.Join(downloadDir, "elastic-runtime", "srt-2.10.10-build.1.zip"),
+				}, nil)
+
+				err := ioutil.WriteFile(filepath.Join(downloadDir, "srt-2.10.9-build.3.pivotal"), nil, 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("downloads the stemcell for the downloaded tile only", func() {
+				err := download()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeStemcellDownloader.DownloadForTileCallCount()).To(Equal(1))
+				invokedTilePath, productSlug, globs, invokedDownloadDir, acceptEULA, _, invokedOptions :=
+					fakeStemcellDownloader.DownloadForTileArgsForCall(0)
+				Expect(invokedTilePath).To(Equal(tilePath))
+				Expect(productSlug).To(BeEmpty())
+				Expect(globs).To(Equal([]string{"*-aws*"}))
+				Expect(invokedDownloadDir).To(Equal(downloadDir))
+				Expect(acceptEULA).To(BeTrue())
+				Expect(invokedOptions.Parallel).To(Equal(2))
+				Expect(invokedOptions.Inspect).To(BeFalse())
+				Expect(invokedOptions.WriteMetadata).To(BeFalse())
+			})
+
+			Context("when no tile was downloaded", func() {
+				BeforeEach(func() {
+					config.FileGlob = "srt-*.zip"
+					fakeDownloader.DownloadReturns([]string{
+						filepath.Join(downloadDir, "srt-2.10.10-build.1.zip"),
+					}, nil)
+				})
+
+				It("invokes the error handler", func() {
+					_ = download()
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					err := fakeErrorHandler.HandleErrorArgsForCall(0)
+					Expect(err.Error()).To(ContainSubstring("no tile (.pivotal file) matching 'srt-*.zip' was downloaded"))
+				})
+			})
+
+			Context("when it is a dry run", func() {
+				BeforeEach(func() {
+					options.DryRun = true
+				})
+
+				It("does not download the stemcell", func() {
+					err := download()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStemcellDownloader.DownloadForTileCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when downloading the stemcell returns an error", func() {
+				var (
+					expectedErr error
+				)
+
+				BeforeEach(func() {
+					expectedErr = errors.New("stemcell error")
+					fakeStemcellDownloader.DownloadForTileReturns(expectedErr)
+				})
+
+				It("forwards the error", func() {
+					err := download()
+					Expect(err).To(Equal(expectedErr))
+				})
+			})
+		})
+	})
+})
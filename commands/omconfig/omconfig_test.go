@@ -0,0 +1,173 @@
+package omconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/omconfig"
+)
+
+var _ = Describe("omconfig", func() {
+	var (
+		tempDir    string
+		configPath string
+		contents   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		configPath = filepath.Join(tempDir, "download-product.yml")
+		contents = `---
+pivnet-api-token: some-token
+pivnet-product-slug: elastic-runtime
+pivnet-file-glob: "srt-*.pivotal"
+product-version-regex: ^2\.10\..*$
+output-directory: /some/dir
+stemcell-iaas: aws
+`
+	})
+
+	JustBeforeEach(func() {
+		err := ioutil.WriteFile(configPath, []byte(contents), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("ReadConfig", func() {
+		It("reads the config", func() {
+			config, err := omconfig.ReadConfig(configPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config).To(Equal(omconfig.Config{
+				APIToken:            "some-token",
+				ProductSlug:         "elastic-runtime",
+				FileGlob:            "srt-*.pivotal",
+				ProductVersionRegex: `^2\.10\..*$`,
+				OutputDirectory:     "/some/dir",
+				StemcellIaaS:        "aws",
+			}))
+		})
+
+		Context("when the glob is given as file-glob", func() {
+			BeforeEach(func() {
+				contents = `---
+pivnet-product-slug: elastic-runtime
+file-glob: "srt-*.pivotal"
+product-version: 2.10.3
+`
+			})
+
+			It("reads it as the file glob", func() {
+				config, err := omconfig.ReadConfig(configPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(config.FileGlob).To(Equal("srt-*.pivotal"))
+				Expect(config.ProductVersion).To(Equal("2.10.3"))
+			})
+		})
+
+		Context("when the config has keys that are not supported", func() {
+			BeforeEach(func() {
+				contents += "source: s3\ns3-bucket: some-bucket\n"
+			})
+
+			It("returns an error naming them", func() {
+				_, err := omconfig.ReadConfig(configPath)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unknown keys in %s: s3-bucket, source", configPath))
+				Expect(err.Error()).To(ContainSubstring("the supported keys are: file-glob, output-directory"))
+			})
+		})
+
+		Context("when the product slug is missing", func() {
+			BeforeEach(func() {
+				contents = "pivnet-file-glob: '*.pivotal'\nproduct-version: 2.10.3\n"
+			})
+
+			It("returns an error", func() {
+				_, err := omconfig.ReadConfig(configPath)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("pivnet-product-slug is required"))
+			})
+		})
+
+		Context("when both a version and a version regex are provided", func() {
+			BeforeEach(func() {
+				contents += "product-version: 2.10.3\n"
+			})
+
+			It("returns an error", func() {
+				_, err := omconfig.ReadConfig(configPath)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("only one of product-version or product-version-regex"))
+			})
+		})
+
+		Context("when neither a version nor a version regex are provided", func() {
+			BeforeEach(func() {
+				contents = "pivnet-product-slug: elastic-runtime\npivnet-file-glob: '*.pivotal'\n"
+			})
+
+			It("returns an error", func() {
+				_, err := omconfig.ReadConfig(configPath)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("one of product-version or product-version-regex is required"))
+			})
+		})
+
+		Context("when the version regex is invalid", func() {
+			BeforeEach(func() {
+				contents = "pivnet-product-slug: elastic-runtime\npivnet-file-glob: '*.pivotal'\nproduct-version-regex: '2.10.('\n"
+			})
+
+			It("returns an error", func() {
+				_, err := omconfig.ReadConfig(configPath)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("product-version-regex '2.10.(' is not a valid regex"))
+			})
+		})
+
+		Context("when the file is not valid YAML", func() {
+			BeforeEach(func() {
+				contents = "%%%"
+			})
+
+			It("returns an error", func() {
+				_, err := omconfig.ReadConfig(configPath)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("could not parse"))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns an error", func() {
+				_, err := omconfig.ReadConfig(filepath.Join(tempDir, "missing.yml"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("StemcellGlob", func() {
+		It("matches any stemcell for the IaaS", func() {
+			config := omconfig.Config{StemcellIaaS: "aws"}
+			Expect(config.StemcellGlob()).To(Equal("*-aws*"))
+		})
+
+		Context("when heavy stemcells are requested", func() {
+			It("only matches heavy stemcells", func() {
+				config := omconfig.Config{StemcellIaaS: "vsphere", StemcellHeavy: true}
+				Expect(config.StemcellGlob()).To(Equal("bosh-stemcell-*-vsphere*"))
+			})
+		})
+	})
+})
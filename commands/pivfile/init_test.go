@@ -0,0 +1,13 @@
+package pivfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPivfile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pivfile Suite")
+}
@@ -0,0 +1,109 @@
+package pivfile
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Manifest lists the products to download. It is usually kept in a file
+// named Pivfile, e.g.
+//
+//	products:
+//	- slug: p-mysql
+//	  version: 2.10.3
+//	  globs: ["*.pivotal"]
+//	  download_dir: tiles
+type Manifest struct {
+	Products []ManifestProduct `yaml:"products"`
+}
+
+type ManifestProduct struct {
+	Slug        string   `yaml:"slug"`
+	Version     string   `yaml:"version"`
+	Globs       []string `yaml:"globs"`
+	DownloadDir string   `yaml:"download_dir"`
+}
+
+// Lockfile records what a Manifest resolved to, so that the same files can be
+// downloaded again later.
+type Lockfile struct {
+	Products []LockedProduct `yaml:"products" json:"products"`
+}
+
+type LockedProduct struct {
+	Slug           string       `yaml:"slug" json:"slug"`
+	Version        string       `yaml:"version" json:"version"`
+	ReleaseID      int          `yaml:"release_id" json:"release_id"`
+	ReleaseVersion string       `yaml:"release_version" json:"release_version"`
+	DownloadDir    string       `yaml:"download_dir" json:"download_dir"`
+	ProductFiles   []LockedFile `yaml:"product_files" json:"product_files"`
+}
+
+type LockedFile struct {
+	ID           int    `yaml:"id" json:"id"`
+	Name         string `yaml:"name" json:"name"`
+	AWSObjectKey string `yaml:"aws_object_key" json:"aws_object_key"`
+	SHA256       string `yaml:"sha256,omitempty" json:"sha256,omitempty"`
+	MD5          string `yaml:"md5,omitempty" json:"md5,omitempty"`
+}
+
+func ReadManifest(path string) (Manifest, error) {
+	var manifest Manifest
+
+	err := readYAML(path, &manifest)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	for i, p := range manifest.Products {
+		if p.Slug == "" {
+			return Manifest{}, fmt.Errorf("product %d in %s is missing a slug", i+1, path)
+		}
+
+		if p.Version == "" {
+			return Manifest{}, fmt.Errorf("product '%s' in %s is missing a version", p.Slug, path)
+		}
+
+		if len(p.Globs) == 0 {
+			return Manifest{}, fmt.Errorf("product '%s' in %s is missing globs", p.Slug, path)
+		}
+	}
+
+	return manifest, nil
+}
+
+func ReadLockfile(path string) (Lockfile, error) {
+	var lockfile Lockfile
+
+	err := readYAML(path, &lockfile)
+	if err != nil {
+		return Lockfile{}, err
+	}
+
+	return lockfile, nil
+}
+
+func WriteLockfile(path string, lockfile Lockfile) error {
+	b, err := yaml.Marshal(lockfile)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+func readYAML(path string, out interface{}) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	err = yaml.UnmarshalStrict(b, out)
+	if err != nil {
+		return fmt.Errorf("could not parse %s: %s", path, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,304 @@
+package pivfile
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ProductFilesForRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error)
+}
+
+//go:generate counterfeiter . Filter
+type Filter interface {
+	ProductFileKeysByGlobs(productFiles []pivnet.ProductFile, glob []string) ([]pivnet.ProductFile, error)
+}
+
+//go:generate counterfeiter . Downloader
+type Downloader interface {
+	Download(productSlug string, releaseVersion string, globs []string, productFileIDs []int, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) ([]string, error)
+}
+
+type PivfileClient struct {
+	pivnetClient PivnetClient
+	downloader   Downloader
+	filter       Filter
+	eh           errorhandler.ErrorHandler
+	format       string
+	outputWriter io.Writer
+	printer      printer.Printer
+	l            logger.Logger
+}
+
+func NewPivfileClient(
+	pivnetClient PivnetClient,
+	downloader Downloader,
+	filter Filter,
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+	l logger.Logger,
+) *PivfileClient {
+	return &PivfileClient{
+		pivnetClient: pivnetClient,
+		downloader:   downloader,
+		filter:       filter,
+		eh:           eh,
+		format:       format,
+		outputWriter: outputWriter,
+		printer:      printer,
+		l:            l,
+	}
+}
+
+// Sync resolves the manifest at manifestPath, records the result in the
+// lockfile at lockfilePath and downloads the resolved product files. When
+// locked is true the manifest is ignored and exactly the files recorded in
+// the lockfile are downloaded.
+//
+// Relative download directories are relative to the file they were read from.
+func (c *PivfileClient) Sync(
+	manifestPath string,
+	lockfilePath string,
+	locked bool,
+	acceptEULA bool,
+	progressWriter io.Writer,
+) error {
+	var lockfile Lockfile
+	var baseDir string
+
+	if locked {
+		var err error
+		lockfile, err = ReadLockfile(lockfilePath)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		err = c.checkLockfile(lockfile)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		baseDir = filepath.Dir(lockfilePath)
+	} else {
+		manifest, err := ReadManifest(manifestPath)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		lockfile, err = c.resolve(manifest)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		err = WriteLockfile(lockfilePath, lockfile)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		c.l.Info(fmt.Sprintf("Wrote lockfile to '%s'", lockfilePath))
+
+		baseDir = filepath.Dir(manifestPath)
+	}
+
+	for _, p := range lockfile.Products {
+		downloadDir := p.DownloadDir
+		if !filepath.IsAbs(downloadDir) {
+			downloadDir = filepath.Join(baseDir, downloadDir)
+		}
+
+		err := os.MkdirAll(downloadDir, 0755)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+
+		var productFileIDs []int
+		for _, f := range p.ProductFiles {
+			productFileIDs = append(productFileIDs, f.ID)
+		}
+
+		_, err = c.downloader.Download(
+			p.Slug,
+			p.ReleaseVersion,
+			nil,
+			productFileIDs,
+			downloadDir,
+			acceptEULA,
+			progressWriter,
+			productfile.DownloadOptions{
+				SkipExisting: true,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.printLockfile(lockfile)
+}
+
+func (c *PivfileClient) resolve(manifest Manifest) (Lockfile, error) {
+	var lockfile Lockfile
+
+	for _, p := range manifest.Products {
+		c.l.Debug("Resolving product", logger.Data{"slug": p.Slug, "version": p.Version})
+
+		release, err := c.pivnetClient.ReleaseForConstraint(p.Slug, p.Version)
+		if err != nil {
+			return Lockfile{}, err
+		}
+
+		productFiles, err := c.pivnetClient.ProductFilesForRelease(p.Slug, release.ID)
+		if err != nil {
+			return Lockfile{}, err
+		}
+
+		matched, err := c.filter.ProductFileKeysByGlobs(productFiles, p.Globs)
+		if err != nil {
+			return Lockfile{}, err
+		}
+
+		if len(matched) == 0 {
+			return Lockfile{}, fmt.Errorf(
+				"no product files of %s %s match globs: %s",
+				p.Slug,
+				release.Version,
+				strings.Join(p.Globs, ", "),
+			)
+		}
+
+		locked := LockedProduct{
+			Slug:           p.Slug,
+			Version:        p.Version,
+			ReleaseID:      release.ID,
+			ReleaseVersion: release.Version,
+			DownloadDir:    p.DownloadDir,
+		}
+
+		seen := map[int]bool{}
+		for _, pf := range matched {
+			if seen[pf.ID] {
+				continue
+			}
+			seen[pf.ID] = true
+
+			locked.ProductFiles = append(locked.ProductFiles, LockedFile{
+				ID:           pf.ID,
+				Name:         pf.Name,
+				AWSObjectKey: pf.AWSObjectKey,
+				SHA256:       pf.SHA256,
+				MD5:          pf.MD5,
+			})
+		}
+
+		lockfile.Products = append(lockfile.Products, locked)
+	}
+
+	return lockfile, nil
+}
+
+// checkLockfile makes sure that Pivnet still serves the releases and files
+// recorded in the lockfile, with the same checksums.
+func (c *PivfileClient) checkLockfile(lockfile Lockfile) error {
+	for _, p := range lockfile.Products {
+		release, err := c.pivnetClient.ReleaseForVersion(p.Slug, p.ReleaseVersion)
+		if err != nil {
+			return err
+		}
+
+		if release.ID != p.ReleaseID {
+			return fmt.Errorf(
+				"release %s of %s has ID %d but the lockfile expects %d",
+				p.ReleaseVersion,
+				p.Slug,
+				release.ID,
+				p.ReleaseID,
+			)
+		}
+
+		productFiles, err := c.pivnetClient.ProductFilesForRelease(p.Slug, release.ID)
+		if err != nil {
+			return err
+		}
+
+		byID := map[int]pivnet.ProductFile{}
+		for _, pf := range productFiles {
+			byID[pf.ID] = pf
+		}
+
+		for _, f := range p.ProductFiles {
+			pf, ok := byID[f.ID]
+			if !ok {
+				return fmt.Errorf(
+					"product file %d (%s) is no longer part of %s %s",
+					f.ID,
+					f.Name,
+					p.Slug,
+					p.ReleaseVersion,
+				)
+			}
+
+			if pf.SHA256 != f.SHA256 || pf.MD5 != f.MD5 {
+				return fmt.Errorf(
+					"checksums of product file %d (%s) in %s %s do not match the lockfile",
+					f.ID,
+					f.Name,
+					p.Slug,
+					p.ReleaseVersion,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *PivfileClient) printLockfile(lockfile Lockfile) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"Slug",
+			"Release Version",
+			"Product File IDs",
+			"Download Dir",
+		})
+
+		for _, p := range lockfile.Products {
+			var ids []string
+			for _, f := range p.ProductFiles {
+				ids = append(ids, strconv.Itoa(f.ID))
+			}
+
+			table.Append([]string{
+				p.Slug,
+				p.ReleaseVersion,
+				strings.Join(ids, ", "),
+				p.DownloadDir,
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(lockfile)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(lockfile)
+	}
+
+	return nil
+}
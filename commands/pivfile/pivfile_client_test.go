@@ -0,0 +1,350 @@
+package pivfile_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/pivfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/pivfile/pivfilefakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+var _ = Describe("pivfile commands", func() {
+	const (
+		manifestContents = `---
+products:
+- slug: some-product
+  version: 1.2.3
+  globs: ["*.pivotal"]
+  download_dir: tiles
+`
+	)
+
+	var (
+		fakePivnetClient *pivfilefakes.FakePivnetClient
+		fakeDownloader   *pivfilefakes.FakeDownloader
+		fakeFilter       *pivfilefakes.FakeFilter
+		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+
+		tempDir      string
+		manifestPath string
+		lockfilePath string
+
+		release      pivnet.Release
+		productFiles []pivnet.ProductFile
+
+		client *pivfile.PivfileClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		manifestPath = filepath.Join(tempDir, "Pivfile")
+		lockfilePath = filepath.Join(tempDir, "Pivfile.lock")
+
+		err = ioutil.WriteFile(manifestPath, []byte(manifestContents), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakePivnetClient = &pivfilefakes.FakePivnetClient{}
+		fakeDownloader = &pivfilefakes.FakeDownloader{}
+		fakeFilter = &pivfilefakes.FakeFilter{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		outBuffer = bytes.Buffer{}
+
+		release = pivnet.Release{
+			ID:      1234,
+			Version: "1.2.3",
+		}
+
+		productFiles = []pivnet.ProductFile{
+			{
+				ID:           2345,
+				Name:         "Some Tile",
+				AWSObjectKey: "/remote/path/some-tile.pivotal",
+				SHA256:       "some-sha256",
+			},
+			{
+				ID:           3456,
+				Name:         "Some Stemcell",
+				AWSObjectKey: "/remote/path/some-stemcell.tgz",
+				SHA256:       "other-sha256",
+			},
+		}
+
+		fakePivnetClient.ReleaseForConstraintReturns(release, nil)
+		fakePivnetClient.ReleaseForVersionReturns(release, nil)
+		fakePivnetClient.ProductFilesForReleaseReturns(productFiles, nil)
+		fakeFilter.ProductFileKeysByGlobsReturns(productFiles[:1], nil)
+
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = pivfile.NewPivfileClient(
+			fakePivnetClient,
+			fakeDownloader,
+			fakeFilter,
+			fakeErrorHandler,
+			printer.PrintAsJSON,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+			l,
+		)
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Sync", func() {
+		It("resolves the manifest and writes the lockfile", func() {
+			err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			invokedSlug, invokedVersion := fakePivnetClient.ReleaseForConstraintArgsForCall(0)
+			Expect(invokedSlug).To(Equal("some-product"))
+			Expect(invokedVersion).To(Equal("1.2.3"))
+
+			_, invokedGlobs := fakeFilter.ProductFileKeysByGlobsArgsForCall(0)
+			Expect(invokedGlobs).To(Equal([]string{"*.pivotal"}))
+
+			lockfile, err := pivfile.ReadLockfile(lockfilePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(lockfile).To(Equal(pivfile.Lockfile{
+				Products: []pivfile.LockedProduct{
+					{
+						Slug:           "some-product",
+						Version:        "1.2.3",
+						ReleaseID:      1234,
+						ReleaseVersion: "1.2.3",
+						DownloadDir:    "tiles",
+						ProductFiles: []pivfile.LockedFile{
+							{
+								ID:           2345,
+								Name:         "Some Tile",
+								AWSObjectKey: "/remote/path/some-tile.pivotal",
+								SHA256:       "some-sha256",
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("downloads the resolved product files relative to the manifest", func() {
+			err := client.Sync(manifestPath, lockfilePath, false, true, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeDownloader.DownloadCallCount()).To(Equal(1))
+
+			slug, releaseVersion, globs, productFileIDs, downloadDir, acceptEULA, _, options :=
+				fakeDownloader.DownloadArgsForCall(0)
+			Expect(slug).To(Equal("some-product"))
+			Expect(releaseVersion).To(Equal("1.2.3"))
+			Expect(globs).To(BeEmpty())
+			Expect(productFileIDs).To(Equal([]int{2345}))
+			Expect(downloadDir).To(Equal(filepath.Join(tempDir, "tiles")))
+			Expect(acceptEULA).To(BeTrue())
+			Expect(options.SkipExisting).To(BeTrue())
+
+			Expect(downloadDir).To(BeADirectory())
+		})
+
+		It("prints the lockfile", func() {
+			err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			var lockfile pivfile.Lockfile
+			err = json.Unmarshal(outBuffer.Bytes(), &lockfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(lockfile.Products).To(HaveLen(1))
+			Expect(lockfile.Products[0].ReleaseID).To(Equal(1234))
+		})
+
+		Context("when several globs match the same file", func() {
+			BeforeEach(func() {
+				fakeFilter.ProductFileKeysByGlobsReturns(
+					[]pivnet.ProductFile{productFiles[0], productFiles[0]},
+					nil,
+				)
+			})
+
+			It("locks the file once", func() {
+				err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, _, productFileIDs, _, _, _, _ := fakeDownloader.DownloadArgsForCall(0)
+				Expect(productFileIDs).To(Equal([]int{2345}))
+			})
+		})
+
+		Context("when no product files match the globs", func() {
+			BeforeEach(func() {
+				fakeFilter.ProductFileKeysByGlobsReturns(nil, nil)
+			})
+
+			It("invokes the error handler without writing the lockfile", func() {
+				err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("no product files of some-product 1.2.3 match"))
+
+				Expect(lockfilePath).NotTo(BeAnExistingFile())
+				Expect(fakeDownloader.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the manifest is missing a version", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(manifestPath, []byte("products:\n- slug: some-product\n  globs: ['*']\n"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("invokes the error handler", func() {
+				err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("missing a version"))
+			})
+		})
+
+		Context("when resolving the release returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("release error")
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when the download returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download error")
+				fakeDownloader.DownloadReturns(nil, expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when locked is true", func() {
+			BeforeEach(func() {
+				err := client.Sync(manifestPath, lockfilePath, false, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = os.Remove(manifestPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakePivnetClient.ReleaseForVersionReturns(release, nil)
+				fakeFilter.ProductFileKeysByGlobsReturns(productFiles, nil)
+				fakeDownloader.DownloadReturns(nil, nil)
+			})
+
+			It("downloads the product files from the lockfile without resolving globs", func() {
+				err := client.Sync(manifestPath, lockfilePath, true, false, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeFilter.ProductFileKeysByGlobsCallCount()).To(Equal(1))
+				Expect(fakeDownloader.DownloadCallCount()).To(Equal(2))
+
+				_, releaseVersion, _, productFileIDs, downloadDir, _, _, _ := fakeDownloader.DownloadArgsForCall(1)
+				Expect(releaseVersion).To(Equal("1.2.3"))
+				Expect(productFileIDs).To(Equal([]int{2345}))
+				Expect(downloadDir).To(Equal(filepath.Join(tempDir, "tiles")))
+			})
+
+			Context("when the release has been replaced", func() {
+				BeforeEach(func() {
+					fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{ID: 9999, Version: "1.2.3"}, nil)
+				})
+
+				It("invokes the error handler without downloading", func() {
+					err := client.Sync(manifestPath, lockfilePath, true, false, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("lockfile expects 1234"))
+					Expect(fakeDownloader.DownloadCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a locked product file has been removed from the release", func() {
+				BeforeEach(func() {
+					fakePivnetClient.ProductFilesForReleaseReturns(productFiles[1:], nil)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Sync(manifestPath, lockfilePath, true, false, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("no longer part of some-product 1.2.3"))
+				})
+			})
+
+			Context("when the checksum of a locked product file has changed", func() {
+				BeforeEach(func() {
+					changed := productFiles[0]
+					changed.SHA256 = "changed-sha256"
+					fakePivnetClient.ProductFilesForReleaseReturns([]pivnet.ProductFile{changed}, nil)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Sync(manifestPath, lockfilePath, true, false, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("do not match the lockfile"))
+				})
+			})
+
+			Context("when the lockfile does not exist", func() {
+				BeforeEach(func() {
+					err := os.Remove(lockfilePath)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Sync(manifestPath, lockfilePath, true, false, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,281 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package pivfilefakes
+
+import (
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/pivfile"
+)
+
+type FakePivnetClient struct {
+	ProductFilesForReleaseStub        func(string, int) ([]pivnet.ProductFile, error)
+	productFilesForReleaseMutex       sync.RWMutex
+	productFilesForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	productFilesForReleaseReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	productFilesForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
+	releaseForVersionMutex       sync.RWMutex
+	releaseForVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForVersionReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForVersionReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePivnetClient) ProductFilesForRelease(arg1 string, arg2 int) ([]pivnet.ProductFile, error) {
+	fake.productFilesForReleaseMutex.Lock()
+	ret, specificReturn := fake.productFilesForReleaseReturnsOnCall[len(fake.productFilesForReleaseArgsForCall)]
+	fake.productFilesForReleaseArgsForCall = append(fake.productFilesForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ProductFilesForReleaseStub
+	fakeReturns := fake.productFilesForReleaseReturns
+	fake.recordInvocation("ProductFilesForRelease", []interface{}{arg1, arg2})
+	fake.productFilesForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseCallCount() int {
+	fake.productFilesForReleaseMutex.RLock()
+	defer fake.productFilesForReleaseMutex.RUnlock()
+	return len(fake.productFilesForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseCalls(stub func(string, int) ([]pivnet.ProductFile, error)) {
+	fake.productFilesForReleaseMutex.Lock()
+	defer fake.productFilesForReleaseMutex.Unlock()
+	fake.ProductFilesForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseArgsForCall(i int) (string, int) {
+	fake.productFilesForReleaseMutex.RLock()
+	defer fake.productFilesForReleaseMutex.RUnlock()
+	argsForCall := fake.productFilesForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesForReleaseMutex.Lock()
+	defer fake.productFilesForReleaseMutex.Unlock()
+	fake.ProductFilesForReleaseStub = nil
+	fake.productFilesForReleaseReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFilesForReleaseReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesForReleaseMutex.Lock()
+	defer fake.productFilesForReleaseMutex.Unlock()
+	fake.ProductFilesForReleaseStub = nil
+	if fake.productFilesForReleaseReturnsOnCall == nil {
+		fake.productFilesForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.productFilesForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForVersionMutex.Lock()
+	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
+	fake.releaseForVersionArgsForCall = append(fake.releaseForVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
+	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
+	fake.releaseForVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForVersionCallCount() int {
+	fake.releaseForVersionMutex.RLock()
+	defer fake.releaseForVersionMutex.RUnlock()
+	return len(fake.releaseForVersionArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForVersionCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForVersionMutex.Lock()
+	defer fake.releaseForVersionMutex.Unlock()
+	fake.ReleaseForVersionStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForVersionArgsForCall(i int) (string, string) {
+	fake.releaseForVersionMutex.RLock()
+	defer fake.releaseForVersionMutex.RUnlock()
+	argsForCall := fake.releaseForVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForVersionReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForVersionMutex.Lock()
+	defer fake.releaseForVersionMutex.Unlock()
+	fake.ReleaseForVersionStub = nil
+	fake.releaseForVersionReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForVersionReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForVersionMutex.Lock()
+	defer fake.releaseForVersionMutex.Unlock()
+	fake.ReleaseForVersionStub = nil
+	if fake.releaseForVersionReturnsOnCall == nil {
+		fake.releaseForVersionReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForVersionReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.productFilesForReleaseMutex.RLock()
+	defer fake.productFilesForReleaseMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	fake.releaseForVersionMutex.RLock()
+	defer fake.releaseForVersionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePivnetClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivfile.PivnetClient = new(FakePivnetClient)
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/go-pivnet/v7/logger"
@@ -30,7 +31,9 @@ type Authenticator interface {
 
 type Filterer interface {
 	ReleasesByVersion(releases []pivnet.Release, version string) ([]pivnet.Release, error)
+	ReleasesByConstraint(releases []pivnet.Release, constraint string) ([]pivnet.Release, error)
 	ProductFileKeysByGlobs(productFiles []pivnet.ProductFile, globs []string) ([]pivnet.ProductFile, error)
+	ProductFiles(productFiles []pivnet.ProductFile, predicates ...filter.ProductFilePredicate) ([]pivnet.ProductFile, error)
 }
 
 //go:generate counterfeiter . RCHandler
@@ -62,6 +65,10 @@ type PivnetCommand struct {
 	ConfigFile        string `long:"config" description:"Path to config file"`
 	SkipSSLValidation bool   `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
 
+	MaxRetries   int           `long:"max-retries" default:"3" description:"Number of times to retry a request that fails with a transient error"`
+	RetryTimeout time.Duration `long:"retry-timeout" default:"5m" description:"Maximum time to spend retrying a request e.g. 90s or 10m"`
+	Timeout      time.Duration `long:"timeout" description:"Maximum time for the command to run e.g. 30m. There is no limit by default"`
+
 	Login  LoginCommand  `command:"login" alias:"l" description:"Log in to Pivotal Network."`
 	Logout LogoutCommand `command:"logout" description:"Log out from Pivotal Network."`
 
@@ -90,7 +97,11 @@ type PivnetCommand struct {
 	RemoveProductFile RemoveProductFileCommand `command:"remove-product-file" alias:"rpf" description:"Remove product file from release"`
 	DeleteProductFile DeleteProductFileCommand `command:"delete-product-file" alias:"dpf" description:"Delete product file"`
 
-	DownloadProductFiles DownloadProductFilesCommand `command:"download-product-files" alias:"dlpf" description:"Download product files"`
+	DownloadProductFiles    DownloadProductFilesCommand    `command:"download-product-files" alias:"dlpf" description:"Download product files"`
+	Sync                    SyncCommand                    `command:"sync" alias:"sy" description:"Download the product files listed in a Pivfile"`
+	Cache                   CacheCommand                   `command:"cache" description:"Manage the local cache of product files"`
+	InspectTile             InspectTileCommand             `command:"inspect-tile" alias:"it" description:"Show the metadata of a downloaded tile"`
+	DownloadStemcellForTile DownloadStemcellForTileCommand `command:"download-stemcell-for-tile" alias:"dsft" description:"Download the stemcell that matches the stemcell criteria of a tile"`
 
 	FileGroups                 FileGroupsCommand                 `command:"file-groups" alias:"fgs" description:"List file groups"`
 	FileGroup                  FileGroupCommand                  `command:"file-group" alias:"fg" description:"Show file group"`
@@ -113,6 +124,14 @@ type PivnetCommand struct {
 	CreateRelease CreateReleaseCommand `command:"create-release" alias:"cr" description:"Create release"`
 	DeleteRelease DeleteReleaseCommand `command:"delete-release" alias:"dr" description:"Delete release"`
 	UpdateRelease UpdateReleaseCommand `command:"update-release" alias:"ur" description:"Update release"`
+	CloneRelease  CloneReleaseCommand  `command:"clone-release" alias:"clr" description:"Clone release to a new version"`
+	CopyRelease   CopyReleaseCommand   `command:"copy-release" alias:"cpr" description:"Copy release to another host"`
+
+	ExportRelease ExportReleaseCommand `command:"export-release" alias:"er" description:"Export release metadata and product files to a bundle"`
+	VerifyBundle  VerifyBundleCommand  `command:"verify-bundle" alias:"vb" description:"Verify the product files in a bundle offline"`
+
+	PlanRelease  PlanReleaseCommand  `command:"plan-release" alias:"plr" description:"Show the changes that would make a release match a YAML file"`
+	ApplyRelease ApplyReleaseCommand `command:"apply-release" alias:"apr" description:"Make the changes that make a release match a YAML file"`
 
 	UserGroups      UserGroupsCommand      `command:"user-groups" alias:"ugs" description:"List user groups"`
 	UserGroup       UserGroupCommand       `command:"user-group" alias:"ug" description:"Show user group"`
@@ -178,15 +197,65 @@ func NewPivnetClient() *gp.Client {
 	return NewPivnetClientWithToken(accessTokenService, host)
 }
 
+// newPivnetClientForAPIToken returns a client that authenticates with
+// apiToken rather than the token of the profile. Access tokens for it are not
+// saved to the profile.
+func newPivnetClientForAPIToken(apiToken string) *gp.Client {
+	host := pivnet.DefaultHost
+	if Pivnet.Profile != nil {
+		host = Pivnet.Profile.Host
+	}
+
+	sanitizeWriters(apiToken)
+
+	// The logger still writes to the LogWriter it was built with, which does
+	// not redact apiToken.
+	Pivnet.Logger = newLogger()
+
+	tokenService := pivnet.NewAccessTokenOrLegacyToken(apiToken, host, Pivnet.SkipSSLValidation, "Pivnet CLI")
+	return NewPivnetClientWithToken(tokenService, host)
+}
+
+// newPivnetClientForProfile returns a client for the saved profile
+// profileName rather than the profile of --profile, for commands that talk
+// to more than one host.
+func newPivnetClientForProfile(profileName string) (*gp.Client, error) {
+	profile, err := RC.ProfileForName(profileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == nil {
+		return nil, fmt.Errorf("Please login with --profile %s first", profileName)
+	}
+
+	err = profile.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("Saved profile %s is invalid (%s). Please login again", profileName, err.Error())
+	}
+
+	sanitizeWriters(profile.APIToken)
+	Pivnet.Logger = newLogger()
+
+	accessTokenService := CreateAccessTokenService(RC, profileName, profile.APIToken, profile.Host, Pivnet.SkipSSLValidation)
+	return NewPivnetClientWithToken(accessTokenService, profile.Host), nil
+}
+
 func NewPivnetClientWithToken(tokenService gp.AccessTokenService, host string) *gp.Client {
 	config := pivnet.ClientConfig{
 		Host:              host,
 		UserAgent:         Pivnet.userAgent,
 		SkipSSLValidation: Pivnet.SkipSSLValidation,
 	}
+	retryConfig := gp.RetryConfig{
+		MaxRetries: Pivnet.MaxRetries,
+		Timeout:    Pivnet.RetryTimeout,
+	}
 	return gp.NewClient(
+		Context,
 		tokenService,
 		config,
+		retryConfig,
 		Pivnet.Logger,
 	)
 }
@@ -247,15 +316,14 @@ var Init = func(profileRequired bool) error {
 		sanitizeWriters(profile.APIToken)
 	}
 
-	infoLogger := log.New(LogWriter, "", log.LstdFlags)
-	debugLogger := log.New(LogWriter, "", log.LstdFlags)
-
 	Pivnet.userAgent = fmt.Sprintf(
 		"pivnet-cli/%s",
 		version.Version,
 	)
 
-	Pivnet.Logger = logshim.NewLogShim(infoLogger, debugLogger, Pivnet.Verbose)
+	Pivnet.Logger = newLogger()
+
+	withTimeout()
 
 	if Filter == nil {
 		Filter = filter.NewFilter(
@@ -266,6 +334,13 @@ var Init = func(profileRequired bool) error {
 	return nil
 }
 
+func newLogger() logger.Logger {
+	infoLogger := log.New(LogWriter, "", log.LstdFlags)
+	debugLogger := log.New(LogWriter, "", log.LstdFlags)
+
+	return logshim.NewLogShim(infoLogger, debugLogger, Pivnet.Verbose)
+}
+
 func userHomeDir() (string, error) {
 	home := os.Getenv("HOME")
 	if home != "" {
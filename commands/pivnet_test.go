@@ -2,9 +2,11 @@ package commands_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -105,6 +107,46 @@ var _ = Describe("Pivnet commands", func() {
 			Expect(outBuffer.String()).ShouldNot(ContainSubstring(apiToken))
 		})
 
+		Context("when a timeout is provided", func() {
+			BeforeEach(func() {
+				commands.Pivnet.Timeout = time.Minute
+			})
+
+			AfterEach(func() {
+				commands.StopTimeout()
+				commands.Pivnet.Timeout = 0
+				commands.Context = context.Background()
+			})
+
+			It("sets a deadline on the context", func() {
+				err := commands.Init(profileRequired)
+				Expect(err).NotTo(HaveOccurred())
+
+				deadline, ok := commands.Context.Deadline()
+				Expect(ok).To(BeTrue())
+				Expect(deadline).To(BeTemporally("~", time.Now().Add(time.Minute), 10*time.Second))
+			})
+
+			It("only sets the deadline once", func() {
+				err := commands.Init(profileRequired)
+				Expect(err).NotTo(HaveOccurred())
+				ctx := commands.Context
+
+				err = commands.Init(profileRequired)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(commands.Context).To(BeIdenticalTo(ctx))
+			})
+
+			It("cancels the context when the timeout is stopped", func() {
+				err := commands.Init(profileRequired)
+				Expect(err).NotTo(HaveOccurred())
+
+				commands.StopTimeout()
+
+				Expect(commands.Context.Err()).To(Equal(context.Canceled))
+			})
+		})
+
 		Context("when profile validation returns an error", func() {
 			BeforeEach(func() {
 				profile.APIToken = ""
@@ -243,6 +285,48 @@ var _ = Describe("Pivnet commands", func() {
 		})
 	})
 
+	Describe("MaxRetries flag", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "MaxRetries")
+		})
+
+		It("contains long flag", func() {
+			Expect(longTag(field)).To(Equal("max-retries"))
+		})
+
+		It("defaults to 3", func() {
+			Expect(field.Tag.Get("default")).To(Equal("3"))
+		})
+	})
+
+	Describe("RetryTimeout flag", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "RetryTimeout")
+		})
+
+		It("contains long flag", func() {
+			Expect(longTag(field)).To(Equal("retry-timeout"))
+		})
+
+		It("defaults to 5m", func() {
+			Expect(field.Tag.Get("default")).To(Equal("5m"))
+		})
+	})
+
+	Describe("Timeout flag", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "Timeout")
+		})
+
+		It("contains long flag", func() {
+			Expect(longTag(field)).To(Equal("timeout"))
+		})
+
+		It("is not required", func() {
+			Expect(isRequired(field)).To(BeFalse())
+		})
+	})
+
 	Describe("Login command", func() {
 		BeforeEach(func() {
 			field = fieldFor(commands.Pivnet, "Login")
@@ -449,6 +533,58 @@ var _ = Describe("Pivnet commands", func() {
 		})
 	})
 
+	Describe("Sync command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "Sync")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("sync"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("sy"))
+		})
+	})
+
+	Describe("Cache command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "Cache")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("cache"))
+		})
+	})
+
+	Describe("InspectTile command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "InspectTile")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("inspect-tile"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("it"))
+		})
+	})
+
+	Describe("DownloadStemcellForTile command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "DownloadStemcellForTile")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("download-stemcell-for-tile"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("dsft"))
+		})
+	})
+
 	Describe("FileGroups command", func() {
 		BeforeEach(func() {
 			field = fieldFor(commands.Pivnet, "FileGroups")
@@ -701,6 +837,90 @@ var _ = Describe("Pivnet commands", func() {
 		})
 	})
 
+	Describe("CloneRelease command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "CloneRelease")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("clone-release"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("clr"))
+		})
+	})
+
+	Describe("CopyRelease command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "CopyRelease")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("copy-release"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("cpr"))
+		})
+	})
+
+	Describe("ExportRelease command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "ExportRelease")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("export-release"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("er"))
+		})
+	})
+
+	Describe("VerifyBundle command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "VerifyBundle")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("verify-bundle"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("vb"))
+		})
+	})
+
+	Describe("PlanRelease command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "PlanRelease")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("plan-release"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("plr"))
+		})
+	})
+
+	Describe("ApplyRelease command", func() {
+		BeforeEach(func() {
+			field = fieldFor(commands.Pivnet, "ApplyRelease")
+		})
+
+		It("contains command", func() {
+			Expect(command(field)).To(Equal("apply-release"))
+		})
+
+		It("contains alias", func() {
+			Expect(alias(field)).To(Equal("apr"))
+		})
+	})
+
 	Describe("DeleteRelease command", func() {
 		BeforeEach(func() {
 			field = fieldFor(commands.Pivnet, "DeleteRelease")
@@ -63,16 +63,28 @@ func (c *PivnetVersionsClient) List() error {
 	return nil
 }
 
+// Warn returns a warning if currentVersion is lower than the latest released
+// version of the CLI. Development builds, whose version is empty or not a
+// semantic version, are never warned about.
 func (c *PivnetVersionsClient) Warn(currentVersion string) string {
+	current, err := semver.Parse(currentVersion)
+	if err != nil {
+		return ""
+	}
+
 	pivnetVersions, err := c.pivnetClient.PivnetVersions()
-	if err == nil {
-		comparison, err := semver.Compare(currentVersion, pivnetVersions.PivnetCliVersion)
-		if err == nil && comparison < 0 {
-			return fmt.Sprintf("Warning: Your version of Pivnet CLI (%s) does not match the currently released version (%s).", currentVersion, pivnetVersions.PivnetCliVersion)
-		} else {
-			return ""
-		}
-	} else {
+	if err != nil {
+		return ""
+	}
+
+	latest, err := semver.ParseLenient(pivnetVersions.PivnetCliVersion)
+	if err != nil {
 		return ""
 	}
+
+	if current.Compare(latest) < 0 {
+		return fmt.Sprintf("Warning: Your version of Pivnet CLI (%s) does not match the currently released version (%s).", currentVersion, pivnetVersions.PivnetCliVersion)
+	}
+
+	return ""
 }
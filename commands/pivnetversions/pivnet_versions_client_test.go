@@ -95,6 +95,31 @@ var _ = Describe("pivnetversions commands", func() {
 			Expect(result).To(BeEmpty())
 		})
 
+		It("returns a warning if the current Pivnet CLI version is a prerelease of the latest", func() {
+			result := client.Warn("1.2.3-rc.1")
+			Expect(result).NotTo(BeEmpty())
+		})
+
+		It("returns empty if the current Pivnet CLI version is a prerelease of a newer version", func() {
+			result := client.Warn("1.2.4-dev.1")
+			Expect(result).To(BeEmpty())
+		})
+
+		It("returns empty if the current Pivnet CLI version only adds build metadata", func() {
+			result := client.Warn("1.2.3+dev.5")
+			Expect(result).To(BeEmpty())
+		})
+
+		It("returns empty for development builds without a version", func() {
+			result := client.Warn("")
+			Expect(result).To(BeEmpty())
+		})
+
+		It("returns empty for development builds with a non-semantic version", func() {
+			result := client.Warn("dev")
+			Expect(result).To(BeEmpty())
+		})
+
 		It("returns empty if there is an error getting the latest Pivnet CLI version", func() {
 			expectedErr := errors.New("pivnetversions error")
 			fakePivnetClient.PivnetVersionsReturns(pivnet.PivnetVersions{}, expectedErr)
@@ -2,12 +2,18 @@ package commands
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"os"
 
 	pivnet "github.com/pivotal-cf/go-pivnet/v7"
-	"github.com/pivotal-cf/go-pivnet/v7/md5sum"
-	"github.com/pivotal-cf/go-pivnet/v7/sha256sum"
+	"github.com/pivotal-cf/pivnet-cli/v3/checksum"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/omconfig"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/diskspace"
+	"github.com/pivotal-cf/pivnet-cli/v3/gp"
+	"github.com/pivotal-cf/pivnet-cli/v3/ratelimit"
 )
 
 type ProductFilesCommand struct {
@@ -69,12 +75,28 @@ type DeleteProductFileCommand struct {
 }
 
 type DownloadProductFilesCommand struct {
-	ProductSlug    string   `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
-	ReleaseVersion string   `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1" required:"true"`
+	ProductSlug    string   `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql (Required unless --config-file is provided)"`
+	ReleaseVersion string   `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1 (Required unless --config-file is provided)"`
 	ProductFileIDs []int    `long:"product-file-id" short:"i" description:"Product file ID e.g. 1234"`
 	Globs          []string `long:"glob" short:"g" description:"Glob to match product name e.g. *aws*"`
 	DownloadDir    string   `long:"download-dir" short:"d" default:"." description:"Local existing directory to download files to e.g. /tmp/my-file/"`
 	AcceptEULA     bool     `long:"accept-eula" description:"Automatically accept EULA if necessary (Available for pivots only)"`
+	Parallel       int      `long:"parallel" default:"1" description:"Number of product files to download at the same time"`
+	Resume         bool     `long:"resume" description:"Keep interrupted downloads as .partial files and continue them on the next run"`
+	SkipExisting   bool     `long:"skip-existing" description:"Skip files that already exist in the download directory and match the expected checksums"`
+	QuarantineDir  string   `long:"quarantine-dir" description:"Directory to move files that fail checksum verification to. They are deleted if not provided"`
+	CacheDir       string   `long:"cache-dir" description:"Directory to cache product files in by SHA256 (default: cache_dir of the profile)"`
+	FileGroups     []string `long:"file-group" description:"Only download files in this file group e.g. 'Stemcells'"`
+	FileTypes      []string `long:"file-type" description:"Only download files of this type e.g. Software, Documentation or 'Open Source License'"`
+	Platforms      []string `long:"platform" description:"Only download files for this platform e.g. Linux"`
+	ExcludeGlobs   []string `long:"exclude-glob" description:"Glob to exclude product files by name e.g. *.pdf"`
+	DryRun         bool     `long:"dry-run" description:"Print the product files that would be downloaded without downloading them"`
+	Inspect        bool     `long:"inspect" description:"Print the metadata of the tiles (.pivotal files) once they are downloaded"`
+	WriteMetadata  bool     `long:"write-metadata" description:"Write metadata.json and metadata.yaml describing the release in the format of the pivnet-resource to the download directory"`
+	PathTemplate   string   `long:"path-template" description:"Template for the path of each file within the download directory e.g. '{{.ProductSlug}}/{{.ReleaseVersion}}/{{.FileName}}'"`
+	LimitRate      string   `long:"limit-rate" description:"Maximum download rate in bytes per second across all files, with an optional K, M or G suffix e.g. 50M"`
+	Progress       string   `long:"progress" description:"How to report download progress (default: bar on a terminal, log otherwise)" choice:"bar" choice:"log" choice:"json" choice:"none"`
+	ConfigFile     string   `long:"config-file" description:"Path to an 'om download-product' config file to read the product slug, version, glob and stemcell IaaS from"`
 }
 
 //go:generate counterfeiter . ProductFileClient
@@ -98,14 +120,36 @@ type ProductFileClient interface {
 	AddToFileGroup(productSlug string, fileGroupID int, productFileID int) error
 	RemoveFromFileGroup(productSlug string, fileGroupID int, productFileID int) error
 	Delete(productSlug string, productFileID int) error
-	Download(productSlug string, releaseVersion string, globs []string, productFileIDs []int, downloadDir string, acceptEULA bool, progressWriter io.Writer) error
+	Download(productSlug string, releaseVersion string, globs []string, productFileIDs []int, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) ([]string, error)
+}
+
+//go:generate counterfeiter . OMConfigClient
+type OMConfigClient interface {
+	Download(config omconfig.Config, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) error
+}
+
+var NewOMConfigClient = func(
+	client omconfig.PivnetClient,
+	downloader omconfig.Downloader,
+	stemcellDownloader omconfig.StemcellDownloader,
+) OMConfigClient {
+	return omconfig.NewOMConfigClient(
+		client,
+		downloader,
+		stemcellDownloader,
+		Filter,
+		ErrorHandler,
+		Pivnet.Logger,
+	)
 }
 
 var NewProductFileClient = func(client productfile.PivnetClient) ProductFileClient {
 	return productfile.NewProductFileClient(
 		client,
-		sha256sum.NewFileSummer(),
-		md5sum.NewFileSummer(),
+		checksum.NewSHA256FileSummer(),
+		checksum.NewMD5FileSummer(),
+		diskspace.NewChecker(),
+		tile.NewTileClient(ErrorHandler, Pivnet.Format, OutputWriter, Printer),
 		ErrorHandler,
 		Pivnet.Format,
 		OutputWriter,
@@ -270,6 +314,18 @@ func (command *DeleteProductFileCommand) Execute([]string) error {
 }
 
 func (command *DownloadProductFilesCommand) Execute([]string) error {
+	if command.Parallel < 1 {
+		return fmt.Errorf("--parallel must be at least 1, got: %d", command.Parallel)
+	}
+
+	if command.ConfigFile != "" {
+		return command.downloadFromConfigFile()
+	}
+
+	if command.ProductSlug == "" || command.ReleaseVersion == "" {
+		return fmt.Errorf("--product-slug and --release-version are required unless --config-file is provided")
+	}
+
 	err := Init(true)
 	if err != nil {
 		return err
@@ -281,7 +337,12 @@ func (command *DownloadProductFilesCommand) Execute([]string) error {
 		return err
 	}
 
-	return NewProductFileClient(client).Download(
+	client, err = command.limitRate(client)
+	if err != nil {
+		return err
+	}
+
+	_, err = NewProductFileClient(client).Download(
 		command.ProductSlug,
 		command.ReleaseVersion,
 		command.Globs,
@@ -289,5 +350,121 @@ func (command *DownloadProductFilesCommand) Execute([]string) error {
 		command.DownloadDir,
 		command.AcceptEULA,
 		LogWriter,
+		command.downloadOptions(),
 	)
+	return err
+}
+
+// downloadFromConfigFile downloads the product described by an
+// `om download-product` config file. The config takes the place of the flags
+// that select what to download, so they cannot be combined with it.
+func (command *DownloadProductFilesCommand) downloadFromConfigFile() error {
+	if command.ProductSlug != "" || command.ReleaseVersion != "" ||
+		len(command.Globs) > 0 || len(command.ProductFileIDs) > 0 {
+		return fmt.Errorf("--product-slug, --release-version, --glob and --product-file-id cannot be used with --config-file")
+	}
+
+	config, err := omconfig.ReadConfig(command.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	err = Init(config.APIToken == "")
+	if err != nil {
+		return err
+	}
+
+	if config.DisableSSL {
+		Pivnet.SkipSSLValidation = true
+	}
+
+	client := NewPivnetClient()
+	if config.APIToken != "" {
+		client = newPivnetClientForAPIToken(config.APIToken)
+	}
+
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	client, err = command.limitRate(client)
+	if err != nil {
+		return err
+	}
+
+	downloadDir := command.DownloadDir
+	if config.OutputDirectory != "" {
+		downloadDir = config.OutputDirectory
+	}
+
+	productFileClient := NewProductFileClient(client)
+
+	return NewOMConfigClient(client, productFileClient, NewStemcellClient(client, productFileClient)).Download(
+		config,
+		downloadDir,
+		command.AcceptEULA,
+		LogWriter,
+		command.downloadOptions(),
+	)
+}
+
+func (command *DownloadProductFilesCommand) limitRate(client *gp.Client) (*gp.Client, error) {
+	if command.LimitRate == "" {
+		return client, nil
+	}
+
+	bytesPerSecond, err := ratelimit.ParseRate(command.LimitRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.WithDownloadRateLimit(bytesPerSecond), nil
+}
+
+func (command *DownloadProductFilesCommand) downloadOptions() productfile.DownloadOptions {
+	return productfile.DownloadOptions{
+		Parallel:      command.Parallel,
+		Resume:        command.Resume,
+		SkipExisting:  command.SkipExisting,
+		QuarantineDir: command.QuarantineDir,
+		CacheDir:      cacheDirFor(command.CacheDir),
+		DryRun:        command.DryRun,
+		Inspect:       command.Inspect,
+		WriteMetadata: command.WriteMetadata,
+		PathTemplate:  command.PathTemplate,
+		FileGroups:    command.FileGroups,
+		FileTypes:     command.FileTypes,
+		Platforms:     command.Platforms,
+		ExcludeGlobs:  command.ExcludeGlobs,
+		Progress:      progressFor(command.Progress),
+	}
+}
+
+// progressFor returns the progress format given on the command line. By
+// default a progress bar is drawn if it is going to a terminal, and plain
+// log lines are written otherwise.
+func progressFor(progress string) string {
+	if progress != "" {
+		return progress
+	}
+
+	if isTerminal(LogWriter) {
+		return productfile.ProgressBar
+	}
+	return productfile.ProgressLog
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
 }
@@ -1,16 +1,25 @@
 package commands_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
 	"reflect"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/omconfig"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/rc"
 )
 
 var _ = Describe("product file commands", func() {
@@ -1263,7 +1272,11 @@ var _ = Describe("product file commands", func() {
 		)
 
 		BeforeEach(func() {
-			cmd = commands.DownloadProductFilesCommand{}
+			cmd = commands.DownloadProductFilesCommand{
+				ProductSlug:    "some-product-slug",
+				ReleaseVersion: "some-release-version",
+				Parallel:       1,
+			}
 		})
 
 		It("invokes the ProductFile client", func() {
@@ -1272,6 +1285,364 @@ var _ = Describe("product file commands", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(fakeProductFileClient.DownloadCallCount()).To(Equal(1))
+
+			productSlug, releaseVersion, _, _, _, _, _, _ := fakeProductFileClient.DownloadArgsForCall(0)
+			Expect(productSlug).To(Equal("some-product-slug"))
+			Expect(releaseVersion).To(Equal("some-release-version"))
+		})
+
+		Context("when parallel is 0", func() {
+			BeforeEach(func() {
+				cmd.Parallel = 0
+			})
+
+			It("returns an error without downloading", func() {
+				err := cmd.Execute(nil)
+				Expect(err).To(MatchError("--parallel must be at least 1, got: 0"))
+
+				Expect(fakeProductFileClient.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the release version is not provided", func() {
+			BeforeEach(func() {
+				cmd.ReleaseVersion = ""
+			})
+
+			It("returns an error without downloading", func() {
+				err := cmd.Execute(nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("--release-version are required unless --config-file"))
+
+				Expect(fakeProductFileClient.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		Describe("config file", func() {
+			var (
+				fakeOMConfigClient *commandsfakes.FakeOMConfigClient
+
+				tempDir    string
+				configFile string
+				config     string
+
+				origOutputWriter io.Writer
+				origLogWriter    io.Writer
+			)
+
+			BeforeEach(func() {
+				fakeOMConfigClient = &commandsfakes.FakeOMConfigClient{}
+
+				commands.NewOMConfigClient = func(omconfig.PivnetClient, omconfig.Downloader, omconfig.StemcellDownloader) commands.OMConfigClient {
+					return fakeOMConfigClient
+				}
+
+				var err error
+				tempDir, err = ioutil.TempDir("", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				configFile = filepath.Join(tempDir, "download-product.yml")
+				config = `---
+pivnet-product-slug: elastic-runtime
+pivnet-file-glob: "srt-*.pivotal"
+product-version-regex: ^2\.10\..*$
+stemcell-iaas: aws
+`
+
+				cmd = commands.DownloadProductFilesCommand{
+					ConfigFile:  configFile,
+					DownloadDir: ".",
+					Parallel:    2,
+				}
+
+				origOutputWriter = commands.OutputWriter
+				origLogWriter = commands.LogWriter
+			})
+
+			JustBeforeEach(func() {
+				err := ioutil.WriteFile(configFile, []byte(config), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				commands.OutputWriter = origOutputWriter
+				commands.LogWriter = origLogWriter
+
+				err := os.RemoveAll(tempDir)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("invokes the om config client with the config", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeProductFileClient.DownloadCallCount()).To(Equal(0))
+				Expect(fakeOMConfigClient.DownloadCallCount()).To(Equal(1))
+
+				invokedConfig, downloadDir, _, _, options := fakeOMConfigClient.DownloadArgsForCall(0)
+				Expect(invokedConfig.ProductSlug).To(Equal("elastic-runtime"))
+				Expect(invokedConfig.FileGlob).To(Equal("srt-*.pivotal"))
+				Expect(invokedConfig.ProductVersionRegex).To(Equal(`^2\.10\..*$`))
+				Expect(invokedConfig.StemcellIaaS).To(Equal("aws"))
+				Expect(downloadDir).To(Equal("."))
+				Expect(options.Parallel).To(Equal(2))
+
+				Expect(initInvocationArg).To(BeTrue())
+			})
+
+			Context("when the config has an output directory", func() {
+				BeforeEach(func() {
+					config += "output-directory: /some/output/dir\n"
+				})
+
+				It("downloads to it", func() {
+					err := cmd.Execute(nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, downloadDir, _, _, _ := fakeOMConfigClient.DownloadArgsForCall(0)
+					Expect(downloadDir).To(Equal("/some/output/dir"))
+				})
+			})
+
+			Context("when the config has an api token", func() {
+				BeforeEach(func() {
+					config += "pivnet-api-token: some-api-token\n"
+				})
+
+				It("does not require a profile", func() {
+					err := cmd.Execute(nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(initInvocationArg).To(BeFalse())
+					Expect(fakeOMConfigClient.DownloadCallCount()).To(Equal(1))
+				})
+
+				Context("when the output is verbose", func() {
+					var (
+						logBuffer bytes.Buffer
+					)
+
+					BeforeEach(func() {
+						logBuffer = bytes.Buffer{}
+						commands.LogWriter = &logBuffer
+						commands.Pivnet.Verbose = true
+						commands.Pivnet.Logger = logshim.NewLogShim(
+							log.New(commands.LogWriter, "", 0),
+							log.New(commands.LogWriter, "", 0),
+							true,
+						)
+
+						fakeOMConfigClient.DownloadStub = func(omconfig.Config, string, bool, io.Writer, productfile.DownloadOptions) error {
+							commands.Pivnet.Logger.Debug("Using api token some-api-token")
+							return nil
+						}
+					})
+
+					AfterEach(func() {
+						commands.Pivnet.Verbose = false
+						commands.Pivnet.Logger = nil
+					})
+
+					It("redacts the api token from the log", func() {
+						err := cmd.Execute(nil)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(logBuffer.String()).To(ContainSubstring("*** redacted api token ***"))
+						Expect(logBuffer.String()).NotTo(ContainSubstring("some-api-token"))
+					})
+				})
+			})
+
+			Context("when the config has a key that is not supported", func() {
+				BeforeEach(func() {
+					config += "s3-bucket: some-bucket\n"
+				})
+
+				It("returns an error without downloading", func() {
+					err := cmd.Execute(nil)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("unknown keys in %s: s3-bucket", configFile))
+
+					Expect(fakeOMConfigClient.DownloadCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when flags that select what to download are also provided", func() {
+				BeforeEach(func() {
+					cmd.ProductSlug = "some-product-slug"
+				})
+
+				It("returns an error without downloading", func() {
+					err := cmd.Execute(nil)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("cannot be used with --config-file"))
+
+					Expect(fakeOMConfigClient.DownloadCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the om config client returns an error", func() {
+				var (
+					expectedErr error
+				)
+
+				BeforeEach(func() {
+					expectedErr = errors.New("expected error")
+					fakeOMConfigClient.DownloadReturns(expectedErr)
+				})
+
+				It("forwards the error", func() {
+					err := cmd.Execute(nil)
+					Expect(err).To(Equal(expectedErr))
+				})
+			})
+		})
+
+		It("passes the download options to the ProductFile client", func() {
+			cmd.Parallel = 3
+			cmd.Resume = true
+			cmd.SkipExisting = true
+			cmd.QuarantineDir = "/some/quarantine/dir"
+
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+			Expect(options.Parallel).To(Equal(3))
+			Expect(options.Resume).To(BeTrue())
+			Expect(options.SkipExisting).To(BeTrue())
+			Expect(options.QuarantineDir).To(Equal("/some/quarantine/dir"))
+		})
+
+		It("passes inspect to the ProductFile client", func() {
+			cmd.Inspect = true
+
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+			Expect(options.Inspect).To(BeTrue())
+		})
+
+		It("passes write metadata to the ProductFile client", func() {
+			cmd.WriteMetadata = true
+
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+			Expect(options.WriteMetadata).To(BeTrue())
+		})
+
+		It("passes dry run and the path template to the ProductFile client", func() {
+			cmd.DryRun = true
+			cmd.PathTemplate = "{{.ReleaseVersion}}/{{.FileName}}"
+
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+			Expect(options.DryRun).To(BeTrue())
+			Expect(options.PathTemplate).To(Equal("{{.ReleaseVersion}}/{{.FileName}}"))
+		})
+
+		It("passes the filters to the ProductFile client", func() {
+			cmd.FileGroups = []string{"Some File Group"}
+			cmd.FileTypes = []string{"Software"}
+			cmd.Platforms = []string{"Linux"}
+			cmd.ExcludeGlobs = []string{"*.pdf"}
+
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+			Expect(options.FileGroups).To(Equal([]string{"Some File Group"}))
+			Expect(options.FileTypes).To(Equal([]string{"Software"}))
+			Expect(options.Platforms).To(Equal([]string{"Linux"}))
+			Expect(options.ExcludeGlobs).To(Equal([]string{"*.pdf"}))
+		})
+
+		Describe("progress", func() {
+			It("passes the progress format to the ProductFile client", func() {
+				cmd.Progress = "json"
+
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+				Expect(options.Progress).To(Equal(productfile.ProgressJSON))
+			})
+
+			Context("when no progress format is provided", func() {
+				It("logs progress when the log writer is not a terminal", func() {
+					err := cmd.Execute(nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+					Expect(options.Progress).To(Equal(productfile.ProgressLog))
+				})
+			})
+		})
+
+		Context("when a download rate limit is provided", func() {
+			It("invokes the ProductFile client", func() {
+				cmd.LimitRate = "50M"
+
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeProductFileClient.DownloadCallCount()).To(Equal(1))
+			})
+
+			Context("when the rate is invalid", func() {
+				It("returns an error without downloading", func() {
+					cmd.LimitRate = "fast"
+
+					err := cmd.Execute(nil)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("invalid rate 'fast'"))
+
+					Expect(fakeProductFileClient.DownloadCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("cache directory", func() {
+			AfterEach(func() {
+				commands.Pivnet.Profile = nil
+			})
+
+			It("passes the cache directory to the ProductFile client", func() {
+				cmd.CacheDir = "/some/cache/dir"
+
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+				Expect(options.CacheDir).To(Equal("/some/cache/dir"))
+			})
+
+			Context("when the profile sets a cache directory", func() {
+				BeforeEach(func() {
+					commands.Pivnet.Profile = &rc.PivnetProfile{CacheDir: "/profile/cache/dir"}
+				})
+
+				It("uses it unless one is provided", func() {
+					err := cmd.Execute(nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, _, _, _, _, _, options := fakeProductFileClient.DownloadArgsForCall(0)
+					Expect(options.CacheDir).To(Equal("/profile/cache/dir"))
+
+					cmd.CacheDir = "/some/cache/dir"
+
+					err = cmd.Execute(nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, _, _, _, _, _, options = fakeProductFileClient.DownloadArgsForCall(1)
+					Expect(options.CacheDir).To(Equal("/some/cache/dir"))
+				})
+			})
 		})
 
 		Context("when the ProductFile client returns an error", func() {
@@ -1281,7 +1652,7 @@ var _ = Describe("product file commands", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("expected error")
-				fakeProductFileClient.DownloadReturns(expectedErr)
+				fakeProductFileClient.DownloadReturns(nil, expectedErr)
 			})
 
 			It("forwards the error", func() {
@@ -1320,8 +1691,8 @@ var _ = Describe("product file commands", func() {
 				field = fieldFor(commands.DownloadProductFilesCommand{}, "ProductSlug")
 			})
 
-			It("is required", func() {
-				Expect(isRequired(field)).To(BeTrue())
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
 			})
 
 			It("contains short name", func() {
@@ -1338,8 +1709,8 @@ var _ = Describe("product file commands", func() {
 				field = fieldFor(commands.DownloadProductFilesCommand{}, "ReleaseVersion")
 			})
 
-			It("is required", func() {
-				Expect(isRequired(field)).To(BeTrue())
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
 			})
 
 			It("contains long name", func() {
@@ -1418,5 +1789,238 @@ var _ = Describe("product file commands", func() {
 				Expect(longTag(field)).To(Equal("accept-eula"))
 			})
 		})
+
+		Describe("Parallel flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "Parallel")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("parallel"))
+			})
+
+			It("defaults to 1", func() {
+				Expect(defaultVal(field)).To(Equal("1"))
+			})
+		})
+
+		Describe("Resume flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "Resume")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("resume"))
+			})
+		})
+
+		Describe("SkipExisting flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "SkipExisting")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("skip-existing"))
+			})
+		})
+
+		Describe("QuarantineDir flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "QuarantineDir")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("quarantine-dir"))
+			})
+		})
+
+		Describe("CacheDir flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "CacheDir")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("cache-dir"))
+			})
+		})
+
+		Describe("FileGroups flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "FileGroups")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("file-group"))
+			})
+		})
+
+		Describe("FileTypes flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "FileTypes")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("file-type"))
+			})
+		})
+
+		Describe("Platforms flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "Platforms")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("platform"))
+			})
+		})
+
+		Describe("ExcludeGlobs flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "ExcludeGlobs")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("exclude-glob"))
+			})
+		})
+
+		Describe("DryRun flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "DryRun")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("dry-run"))
+			})
+		})
+
+		Describe("PathTemplate flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "PathTemplate")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("path-template"))
+			})
+		})
+
+		Describe("Inspect flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "Inspect")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("inspect"))
+			})
+		})
+
+		Describe("WriteMetadata flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "WriteMetadata")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("write-metadata"))
+			})
+		})
+
+		Describe("Progress flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "Progress")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("progress"))
+			})
+
+			It("contains choice", func() {
+				Expect(string(field.Tag)).To(
+					MatchRegexp(`choice:"bar".*choice:"log".*choice:"json".*choice:"none"`))
+			})
+		})
+
+		Describe("ConfigFile flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "ConfigFile")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("config-file"))
+			})
+		})
+
+		Describe("LimitRate flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadProductFilesCommand{}, "LimitRate")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("limit-rate"))
+			})
+		})
 	})
 })
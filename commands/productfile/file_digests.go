@@ -0,0 +1,142 @@
+package productfile
+
+import (
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+)
+
+// StreamingFileSummer is a FileSummer that can also hash bytes as they are
+// downloaded, so the file does not have to be read back from disk.
+type StreamingFileSummer interface {
+	FileSummer
+	NewHash() hash.Hash
+}
+
+// fileDigests computes the SHA256 and MD5 of a product file in a single pass.
+// It can be written to while a file downloads, or fed from a file on disk.
+type fileDigests struct {
+	sha256Summer StreamingFileSummer
+	md5Summer    StreamingFileSummer
+
+	sha256 hash.Hash
+	md5    hash.Hash
+	writer io.Writer
+
+	prefixFilepath string
+	prefixLength   int64
+	err            error
+}
+
+// newFileDigests returns nil when a checksum the product file needs cannot be
+// streamed, in which case callers fall back to FileSummer.SumFile.
+func (c *ProductFileClient) newFileDigests(pf pivnet.ProductFile) *fileDigests {
+	if pf.SHA256 == "" && pf.MD5 == "" {
+		return nil
+	}
+
+	d := &fileDigests{}
+
+	if pf.SHA256 != "" {
+		s, ok := c.sha256FileSummer.(StreamingFileSummer)
+		if !ok {
+			return nil
+		}
+		d.sha256Summer = s
+	}
+
+	if pf.MD5 != "" {
+		s, ok := c.md5FileSummer.(StreamingFileSummer)
+		if !ok {
+			return nil
+		}
+		d.md5Summer = s
+	}
+
+	d.Reset()
+
+	return d
+}
+
+func (d *fileDigests) Write(p []byte) (int, error) {
+	return d.writer.Write(p)
+}
+
+// Reset discards everything written so far. If the digests were seeded with a
+// prefix of a file on disk, that prefix is hashed again.
+func (d *fileDigests) Reset() {
+	var writers []io.Writer
+
+	d.sha256 = nil
+	if d.sha256Summer != nil {
+		d.sha256 = d.sha256Summer.NewHash()
+		writers = append(writers, d.sha256)
+	}
+
+	d.md5 = nil
+	if d.md5Summer != nil {
+		d.md5 = d.md5Summer.NewHash()
+		writers = append(writers, d.md5)
+	}
+
+	d.writer = io.MultiWriter(writers...)
+	d.err = nil
+
+	if d.prefixLength > 0 {
+		d.err = d.hashFile(d.prefixFilepath, d.prefixLength)
+	}
+}
+
+// seed hashes the first length bytes of filepath, for downloads that continue
+// an existing partial file.
+func (d *fileDigests) seed(filepath string, length int64) error {
+	d.prefixFilepath = filepath
+	d.prefixLength = length
+
+	d.Reset()
+
+	return d.err
+}
+
+// sumFile hashes the whole of filepath.
+func (d *fileDigests) sumFile(filepath string) error {
+	d.prefixFilepath = ""
+	d.prefixLength = 0
+
+	d.Reset()
+
+	return d.hashFile(filepath, -1)
+}
+
+func (d *fileDigests) hashFile(filepath string, length int64) error {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if length >= 0 {
+		reader = io.LimitReader(file, length)
+	}
+
+	_, err = io.Copy(d.writer, reader)
+	return err
+}
+
+func (d *fileDigests) SHA256() (string, error) {
+	if d.err != nil {
+		return "", d.err
+	}
+	return fmt.Sprintf("%x", d.sha256.Sum(nil)), nil
+}
+
+func (d *fileDigests) MD5() (string, error) {
+	if d.err != nil {
+		return "", d.err
+	}
+	return fmt.Sprintf("%x", d.md5.Sum(nil)), nil
+}
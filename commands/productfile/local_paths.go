@@ -0,0 +1,149 @@
+package productfile
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+)
+
+// pathTemplateData is what a path template is executed against, e.g.
+// {{.ProductSlug}}/{{.ReleaseVersion}}/{{.FileGroup}}/{{.FileName}}. Every
+// field of the product file and release is available under .ProductFile
+// and .Release.
+type pathTemplateData struct {
+	ProductSlug    string
+	ReleaseVersion string
+	FileName       string
+	FileGroup      string
+	ProductFile    pivnet.ProductFile
+	Release        pivnet.Release
+}
+
+// localPaths returns the path in downloadDir to download each product file
+// to, keyed by product file ID. Without a path template every file is
+// downloaded to the top level of downloadDir.
+//
+// Paths from a template must stay within downloadDir, and no two product
+// files may resolve to the same path, with or without a template.
+func localPaths(
+	productFiles []pivnet.ProductFile,
+	productSlug string,
+	release pivnet.Release,
+	fileGroups []pivnet.FileGroup,
+	downloadDir string,
+	pathTemplate string,
+) (map[int]string, error) {
+	var tmpl *template.Template
+	if pathTemplate != "" {
+		var err error
+		tmpl, err = template.New("path").Parse(pathTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path template: %s", err.Error())
+		}
+	}
+
+	paths := map[int]string{}
+	claimedBy := map[string]pivnet.ProductFile{}
+
+	for _, pf := range productFiles {
+		if _, ok := paths[pf.ID]; ok {
+			continue
+		}
+
+		localPath := filepath.Join(downloadDir, fileNameFor(pf))
+
+		if tmpl != nil {
+			var err error
+			localPath, err = templatePath(tmpl, pf, productSlug, release, fileGroups, downloadDir)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if other, ok := claimedBy[localPath]; ok {
+			return nil, fmt.Errorf(
+				"product files %d (%s) and %d (%s) would both be downloaded to '%s'",
+				other.ID,
+				other.Name,
+				pf.ID,
+				pf.Name,
+				localPath,
+			)
+		}
+
+		claimedBy[localPath] = pf
+		paths[pf.ID] = localPath
+	}
+
+	return paths, nil
+}
+
+// templatePath returns the path in downloadDir that tmpl resolves to for the
+// product file.
+func templatePath(
+	tmpl *template.Template,
+	pf pivnet.ProductFile,
+	productSlug string,
+	release pivnet.Release,
+	fileGroups []pivnet.FileGroup,
+	downloadDir string,
+) (string, error) {
+	data := pathTemplateData{
+		ProductSlug:    productSlug,
+		ReleaseVersion: release.Version,
+		FileName:       fileNameFor(pf),
+		FileGroup:      fileGroupNameFor(pf, fileGroups),
+		ProductFile:    pf,
+		Release:        release,
+	}
+
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, data)
+	if err != nil {
+		return "", fmt.Errorf("invalid path template: %s", err.Error())
+	}
+
+	relativePath := filepath.Clean(filepath.FromSlash(b.String()))
+	if filepath.IsAbs(relativePath) ||
+		relativePath == "." ||
+		relativePath == ".." ||
+		strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf(
+			"path template resolves to '%s' for product file %d, which is not within the download directory",
+			b.String(),
+			pf.ID,
+		)
+	}
+
+	return filepath.Join(downloadDir, relativePath), nil
+}
+
+// createParentDirs creates the directories that the product files will be
+// downloaded into.
+func createParentDirs(paths map[int]string) error {
+	for _, p := range paths {
+		err := os.MkdirAll(filepath.Dir(p), os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileGroupNameFor returns the name of the first file group that contains the
+// product file, or the empty string if it is not in a file group.
+func fileGroupNameFor(pf pivnet.ProductFile, fileGroups []pivnet.FileGroup) string {
+	for _, fg := range fileGroups {
+		for _, groupFile := range fg.ProductFiles {
+			if groupFile.ID == pf.ID {
+				return fg.Name
+			}
+		}
+	}
+	return ""
+}
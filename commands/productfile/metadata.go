@@ -0,0 +1,212 @@
+package productfile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// The files the metadata of a release is written to, as the pivnet-resource
+// for Concourse names them.
+const (
+	metadataJSONFile = "metadata.json"
+	metadataYAMLFile = "metadata.yaml"
+)
+
+// resourceMetadata describes a release in the schema of the metadata that
+// the pivnet-resource writes next to the files it downloads, so that tasks
+// reading it do not need to know which of the two downloaded the files.
+type resourceMetadata struct {
+	Release      *resourceRelease      `yaml:"release,omitempty" json:"release,omitempty"`
+	ProductFiles []resourceProductFile `yaml:"product_files,omitempty" json:"product_files,omitempty"`
+	FileGroups   []resourceFileGroup   `yaml:"file_groups,omitempty" json:"file_groups,omitempty"`
+	Dependencies []resourceDependency  `yaml:"dependencies,omitempty" json:"dependencies,omitempty"`
+	UpgradePaths []resourceUpgradePath `yaml:"upgrade_paths,omitempty" json:"upgrade_paths,omitempty"`
+}
+
+type resourceRelease struct {
+	ID                    int    `yaml:"id,omitempty" json:"id,omitempty"`
+	Version               string `yaml:"version" json:"version"`
+	ReleaseType           string `yaml:"release_type" json:"release_type"`
+	EULASlug              string `yaml:"eula_slug" json:"eula_slug"`
+	ReleaseDate           string `yaml:"release_date" json:"release_date"`
+	Description           string `yaml:"description" json:"description"`
+	ReleaseNotesURL       string `yaml:"release_notes_url" json:"release_notes_url"`
+	Availability          string `yaml:"availability" json:"availability"`
+	Controlled            bool   `yaml:"controlled" json:"controlled"`
+	ECCN                  string `yaml:"eccn" json:"eccn"`
+	LicenseException      string `yaml:"license_exception" json:"license_exception"`
+	EndOfSupportDate      string `yaml:"end_of_support_date" json:"end_of_support_date"`
+	EndOfGuidanceDate     string `yaml:"end_of_guidance_date" json:"end_of_guidance_date"`
+	EndOfAvailabilityDate string `yaml:"end_of_availability_date" json:"end_of_availability_date"`
+}
+
+type resourceProductFile struct {
+	ID                 int      `yaml:"id,omitempty" json:"id,omitempty"`
+	File               string   `yaml:"file,omitempty" json:"file,omitempty"`
+	Description        string   `yaml:"description,omitempty" json:"description,omitempty"`
+	AWSObjectKey       string   `yaml:"aws_object_key,omitempty" json:"aws_object_key,omitempty"`
+	FileType           string   `yaml:"file_type,omitempty" json:"file_type,omitempty"`
+	FileVersion        string   `yaml:"file_version,omitempty" json:"file_version,omitempty"`
+	SHA256             string   `yaml:"sha256,omitempty" json:"sha256,omitempty"`
+	MD5                string   `yaml:"md5,omitempty" json:"md5,omitempty"`
+	DocsURL            string   `yaml:"docs_url,omitempty" json:"docs_url,omitempty"`
+	SystemRequirements []string `yaml:"system_requirements,omitempty" json:"system_requirements,omitempty"`
+	Platforms          []string `yaml:"platforms,omitempty" json:"platforms,omitempty"`
+	IncludedFiles      []string `yaml:"included_files,omitempty" json:"included_files,omitempty"`
+}
+
+type resourceFileGroup struct {
+	ID           int                   `yaml:"id,omitempty" json:"id,omitempty"`
+	Name         string                `yaml:"name,omitempty" json:"name,omitempty"`
+	ProductFiles []resourceProductFile `yaml:"product_files,omitempty" json:"product_files,omitempty"`
+}
+
+type resourceDependency struct {
+	Release resourceDependentRelease `yaml:"release,omitempty" json:"release,omitempty"`
+}
+
+type resourceDependentRelease struct {
+	ID      int             `yaml:"id,omitempty" json:"id,omitempty"`
+	Version string          `yaml:"version,omitempty" json:"version,omitempty"`
+	Product resourceProduct `yaml:"product,omitempty" json:"product,omitempty"`
+}
+
+type resourceProduct struct {
+	ID   int    `yaml:"id,omitempty" json:"id,omitempty"`
+	Slug string `yaml:"slug,omitempty" json:"slug,omitempty"`
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+}
+
+type resourceUpgradePath struct {
+	ID      int    `yaml:"id,omitempty" json:"id,omitempty"`
+	Version string `yaml:"version,omitempty" json:"version,omitempty"`
+}
+
+// writeMetadata writes the release, all of its product files, its file
+// groups, dependencies and upgrade paths to metadata.json and metadata.yaml
+// in downloadDir.
+func (c *ProductFileClient) writeMetadata(
+	productSlug string,
+	release pivnet.Release,
+	productFiles []pivnet.ProductFile,
+	fileGroups []pivnet.FileGroup,
+	downloadDir string,
+) error {
+	dependencies, err := c.pivnetClient.ReleaseDependencies(productSlug, release.ID)
+	if err != nil {
+		return err
+	}
+
+	upgradePaths, err := c.pivnetClient.ReleaseUpgradePaths(productSlug, release.ID)
+	if err != nil {
+		return err
+	}
+
+	metadata := newResourceMetadata(release, productFiles, fileGroups, dependencies, upgradePaths)
+
+	jsonMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	yamlMetadata, err := yaml.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(downloadDir, metadataJSONFile), jsonMetadata, 0644)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(downloadDir, metadataYAMLFile), yamlMetadata, 0644)
+}
+
+func newResourceMetadata(
+	release pivnet.Release,
+	productFiles []pivnet.ProductFile,
+	fileGroups []pivnet.FileGroup,
+	dependencies []pivnet.ReleaseDependency,
+	upgradePaths []pivnet.ReleaseUpgradePath,
+) resourceMetadata {
+	metadata := resourceMetadata{
+		Release: &resourceRelease{
+			ID:                    release.ID,
+			Version:               release.Version,
+			ReleaseType:           string(release.ReleaseType),
+			ReleaseDate:           release.ReleaseDate,
+			Description:           release.Description,
+			ReleaseNotesURL:       release.ReleaseNotesURL,
+			Availability:          release.Availability,
+			Controlled:            release.Controlled,
+			ECCN:                  release.ECCN,
+			LicenseException:      release.LicenseException,
+			EndOfSupportDate:      release.EndOfSupportDate,
+			EndOfGuidanceDate:     release.EndOfGuidanceDate,
+			EndOfAvailabilityDate: release.EndOfAvailabilityDate,
+		},
+	}
+
+	if release.EULA != nil {
+		metadata.Release.EULASlug = release.EULA.Slug
+	}
+
+	metadata.ProductFiles = newResourceProductFiles(productFiles)
+
+	for _, fg := range fileGroups {
+		metadata.FileGroups = append(metadata.FileGroups, resourceFileGroup{
+			ID:           fg.ID,
+			Name:         fg.Name,
+			ProductFiles: newResourceProductFiles(fg.ProductFiles),
+		})
+	}
+
+	for _, d := range dependencies {
+		metadata.Dependencies = append(metadata.Dependencies, resourceDependency{
+			Release: resourceDependentRelease{
+				ID:      d.Release.ID,
+				Version: d.Release.Version,
+				Product: resourceProduct{
+					ID:   d.Release.Product.ID,
+					Slug: d.Release.Product.Slug,
+					Name: d.Release.Product.Name,
+				},
+			},
+		})
+	}
+
+	for _, u := range upgradePaths {
+		metadata.UpgradePaths = append(metadata.UpgradePaths, resourceUpgradePath{
+			ID:      u.Release.ID,
+			Version: u.Release.Version,
+		})
+	}
+
+	return metadata
+}
+
+func newResourceProductFiles(productFiles []pivnet.ProductFile) []resourceProductFile {
+	var files []resourceProductFile
+	for _, pf := range productFiles {
+		files = append(files, resourceProductFile{
+			ID:                 pf.ID,
+			File:               pf.Name,
+			Description:        pf.Description,
+			AWSObjectKey:       pf.AWSObjectKey,
+			FileType:           pf.FileType,
+			FileVersion:        pf.FileVersion,
+			SHA256:             pf.SHA256,
+			MD5:                pf.MD5,
+			DocsURL:            pf.DocsURL,
+			SystemRequirements: pf.SystemRequirements,
+			Platforms:          pf.Platforms,
+			IncludedFiles:      pf.IncludedFiles,
+		})
+	}
+
+	return files
+}
@@ -3,16 +3,21 @@ package productfile
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/go-pivnet/v7/download"
 	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/cache"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
 	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
 	"github.com/pivotal-cf/pivnet-cli/v3/printer"
 	"github.com/pivotal-cf/pivnet-cli/v3/ui"
 )
@@ -20,6 +25,7 @@ import (
 //go:generate counterfeiter . PivnetClient
 type PivnetClient interface {
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	ProductFiles(productSlug string) ([]pivnet.ProductFile, error)
 	ProductFilesForRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error)
 	ProductFile(productSlug string, productFileID int) (pivnet.ProductFile, error)
@@ -33,11 +39,16 @@ type PivnetClient interface {
 	DeleteProductFile(productSlug string, productFileID int) (pivnet.ProductFile, error)
 	AcceptEULA(productSlug string, releaseID int) error
 	DownloadProductFile(location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	ResumeProductFileDownload(location *download.FileInfo, productSlug string, releaseID int, productFileID int, startingByte int64, progressWriter io.Writer, digestWriter io.Writer) error
+	FileGroupsForRelease(productSlug string, releaseID int) ([]pivnet.FileGroup, error)
+	ReleaseDependencies(productSlug string, releaseID int) ([]pivnet.ReleaseDependency, error)
+	ReleaseUpgradePaths(productSlug string, releaseID int) ([]pivnet.ReleaseUpgradePath, error)
 }
 
 //go:generate counterfeiter . Filter
 type Filter interface {
 	ProductFileKeysByGlobs(productFiles []pivnet.ProductFile, glob []string) ([]pivnet.ProductFile, error)
+	ProductFiles(productFiles []pivnet.ProductFile, predicates ...filter.ProductFilePredicate) ([]pivnet.ProductFile, error)
 }
 
 //go:generate counterfeiter --fake-name FakeFileSummer . FileSummer
@@ -45,10 +56,22 @@ type FileSummer interface {
 	SumFile(filepath string) (string, error)
 }
 
+//go:generate counterfeiter . DiskSpaceChecker
+type DiskSpaceChecker interface {
+	Free(dir string) (uint64, error)
+}
+
+//go:generate counterfeiter . TileInspector
+type TileInspector interface {
+	InspectAll(tilePaths []string) error
+}
+
 type ProductFileClient struct {
 	pivnetClient     PivnetClient
 	sha256FileSummer FileSummer
 	md5FileSummer    FileSummer
+	diskSpaceChecker DiskSpaceChecker
+	tileInspector    TileInspector
 	eh               errorhandler.ErrorHandler
 	format           string
 	outputWriter     io.Writer
@@ -62,6 +85,8 @@ func NewProductFileClient(
 	pivnetClient PivnetClient,
 	sha256FileSummer FileSummer,
 	md5FileSummer FileSummer,
+	diskSpaceChecker DiskSpaceChecker,
+	tileInspector TileInspector,
 	eh errorhandler.ErrorHandler,
 	format string,
 	outputWriter io.Writer,
@@ -74,6 +99,8 @@ func NewProductFileClient(
 		pivnetClient:     pivnetClient,
 		sha256FileSummer: sha256FileSummer,
 		md5FileSummer:    md5FileSummer,
+		diskSpaceChecker: diskSpaceChecker,
+		tileInspector:    tileInspector,
 		eh:               eh,
 		format:           format,
 		outputWriter:     outputWriter,
@@ -94,7 +121,7 @@ func (c *ProductFileClient) List(productSlug string, releaseVersion string) erro
 		return c.printProductFiles(productFiles)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -201,7 +228,7 @@ func (c *ProductFileClient) Get(
 		return c.printProductFile(productFile)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -439,6 +466,87 @@ func (c *ProductFileClient) Delete(productSlug string, productFileID int) error
 	return c.printProductFile(productFile)
 }
 
+const (
+	partialFileSuffix     = ".partial"
+	temporaryFileSuffix   = ".download"
+	cachedFileSuffix      = ".cached"
+	quarantinedFileSuffix = ".checksum-failed"
+)
+
+// DownloadOptions holds the optional behaviour for Download. The zero value
+// downloads one file at a time.
+//
+// Parallel is the number of files to download at the same time. Below 2 the
+// files are downloaded one at a time; download-product-files checks that
+// --parallel is at least 1.
+//
+// When CacheDir is set, files with a SHA256 are taken from the cache at
+// CacheDir if present there, and added to it once downloaded and verified.
+//
+// When PathTemplate is set, each file is downloaded to the path it resolves
+// to within downloadDir, creating directories as needed.
+//
+// When DryRun is set, the files that would be downloaded are printed and
+// nothing is downloaded.
+//
+// When Inspect is set, the metadata of the tiles that were downloaded is
+// printed once every file has been downloaded.
+//
+// FileGroups, FileTypes and Platforms narrow the files to download and
+// combine with AND; each matches if any of its values does. Files whose name
+// matches one of ExcludeGlobs are never downloaded.
+//
+// Progress is one of ProgressBar, ProgressLog, ProgressJSON or ProgressNone,
+// and defaults to ProgressBar.
+type DownloadOptions struct {
+	Parallel      int
+	Resume        bool
+	SkipExisting  bool
+	QuarantineDir string
+	CacheDir      string
+	DryRun        bool
+	Inspect       bool
+	WriteMetadata bool
+	PathTemplate  string
+	FileGroups    []string
+	FileTypes     []string
+	Platforms     []string
+	ExcludeGlobs  []string
+	Progress      string
+}
+
+// downloadOutcome records how a product file ended up in the download
+// directory.
+type downloadOutcome int
+
+const (
+	outcomeDownloaded downloadOutcome = iota
+	outcomeSkipped
+	outcomeCached
+)
+
+type downloadResult struct {
+	ProductFileID int    `json:"product_file_id" yaml:"product_file_id"`
+	Name          string `json:"name" yaml:"name"`
+	LocalPath     string `json:"local_path" yaml:"local_path"`
+	Skipped       bool   `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+	Cached        bool   `json:"cached,omitempty" yaml:"cached,omitempty"`
+	Error         string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// transferError marks a failure to fetch a file, as opposed to a failure to
+// verify the contents of a file that was fetched.
+type transferError struct {
+	err error
+}
+
+func (e transferError) Error() string {
+	return e.err.Error()
+}
+
+// Download downloads the product files of the release that match globs,
+// productFileIDs and options, and returns the local paths they were written
+// to.
 func (c *ProductFileClient) Download(
 	productSlug string,
 	releaseVersion string,
@@ -447,20 +555,22 @@ func (c *ProductFileClient) Download(
 	downloadDir string,
 	acceptEULA bool,
 	progressWriter io.Writer,
-) error {
+	options DownloadOptions,
+) ([]string, error) {
 	if len(globs) > 0 && len(productFileIDs) > 0 {
 		err := fmt.Errorf("Cannot provide both globs and product file IDs")
-		return c.eh.HandleError(err)
+		return nil, c.eh.HandleError(err)
 	}
 
-	if len(globs) == 0 && len(productFileIDs) == 0 {
-		err := fmt.Errorf("Must provide either globs (-g) or product file IDs (-i)")
-		return c.eh.HandleError(err)
+	if len(globs) == 0 && len(productFileIDs) == 0 &&
+		len(options.FileGroups) == 0 && len(options.FileTypes) == 0 && len(options.Platforms) == 0 {
+		err := fmt.Errorf("Must provide globs (-g), product file IDs (-i), file groups, file types or platforms")
+		return nil, c.eh.HandleError(err)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
-		return c.eh.HandleError(err)
+		return nil, c.eh.HandleError(err)
 	}
 
 	productFiles, err := c.pivnetClient.ProductFilesForRelease(
@@ -468,10 +578,12 @@ func (c *ProductFileClient) Download(
 		release.ID,
 	)
 	if err != nil {
-		return c.eh.HandleError(err)
+		return nil, c.eh.HandleError(err)
 	}
 
-	var filteredProductFiles []pivnet.ProductFile
+	productFiles = UniqueProductFiles(productFiles)
+
+	filteredProductFiles := productFiles
 
 	if len(productFileIDs) > 0 {
 		filteredProductFiles = filterProductFilesByIDs(productFiles, productFileIDs)
@@ -481,7 +593,27 @@ func (c *ProductFileClient) Download(
 		var err error
 		filteredProductFiles, err = c.filter.ProductFileKeysByGlobs(productFiles, globs)
 		if err != nil {
-			return c.eh.HandleError(err)
+			return nil, c.eh.HandleError(err)
+		}
+	}
+
+	var fileGroups []pivnet.FileGroup
+	if len(options.FileGroups) > 0 || options.PathTemplate != "" || options.WriteMetadata {
+		fileGroups, err = c.pivnetClient.FileGroupsForRelease(productSlug, release.ID)
+		if err != nil {
+			return nil, c.eh.HandleError(err)
+		}
+	}
+
+	predicates, err := c.downloadPredicates(fileGroups, options)
+	if err != nil {
+		return nil, c.eh.HandleError(err)
+	}
+
+	if len(predicates) > 0 {
+		filteredProductFiles, err = c.filter.ProductFiles(filteredProductFiles, predicates...)
+		if err != nil {
+			return nil, c.eh.HandleError(err)
 		}
 	}
 
@@ -491,102 +623,878 @@ func (c *ProductFileClient) Download(
 			productFileIDs,
 			globs,
 		)
-		return c.eh.HandleError(err)
+		return nil, c.eh.HandleError(err)
+	}
+
+	paths, err := localPaths(
+		filteredProductFiles,
+		productSlug,
+		release,
+		fileGroups,
+		downloadDir,
+		options.PathTemplate,
+	)
+	if err != nil {
+		return nil, c.eh.HandleError(err)
+	}
+
+	if options.DryRun {
+		return nil, c.printDownloadPlan(filteredProductFiles, paths)
+	}
+
+	err = c.checkFreeSpace(filteredProductFiles, paths, downloadDir, options)
+	if err != nil {
+		return nil, c.eh.HandleError(err)
+	}
+
+	if options.PathTemplate != "" {
+		err = createParentDirs(paths)
+		if err != nil {
+			return nil, c.eh.HandleError(err)
+		}
 	}
 
 	if acceptEULA {
 		c.l.Debug("Accepting EULA")
 		err = c.pivnetClient.AcceptEULA(productSlug, release.ID)
 		if err != nil {
-			return c.eh.HandleError(err)
+			return nil, c.eh.HandleError(err)
 		}
 	}
 
-	for _, pf := range filteredProductFiles {
-		parts := strings.Split(pf.AWSObjectKey, "/")
-		fileName := parts[len(parts)-1]
+	var localPaths []string
+	if options.Parallel > 1 {
+		localPaths, err = c.downloadInParallel(
+			filteredProductFiles,
+			productSlug,
+			release.ID,
+			paths,
+			options,
+			progressWriter,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var progressMutex sync.Mutex
+		for _, pf := range filteredProductFiles {
+			progress := newFileProgress(options.Progress, progressWriter, &progressMutex, pf, false)
 
-		localFilepath := filepath.Join(downloadDir, fileName)
+			localFilepath, _, err := c.downloadProductFile(pf, productSlug, release.ID, paths[pf.ID], options, progress)
+			if err != nil {
+				if e, ok := err.(transferError); ok {
+					return nil, c.eh.HandleError(e.err)
+				}
+				return nil, err
+			}
 
-		c.l.Debug(
-			"Creating local file",
-			logger.Data{"name": pf.Name, "localFilepath": localFilepath},
-		)
-		file, err := os.Create(localFilepath)
+			localPaths = append(localPaths, localFilepath)
+		}
+	}
+
+	if options.WriteMetadata {
+		err := c.writeMetadata(productSlug, release, productFiles, fileGroups, downloadDir)
 		if err != nil {
-			return c.eh.HandleError(err)
+			return nil, c.eh.HandleError(err)
 		}
+	}
 
-		fileInfo, err := download.NewFileInfo(file)
+	if options.Inspect {
+		err := c.inspectTiles(localPaths)
 		if err != nil {
-			return c.eh.HandleError(err)
+			return nil, err
+		}
+	}
+
+	return localPaths, nil
+}
+
+// inspectTiles prints the metadata of the tiles among the downloaded files.
+func (c *ProductFileClient) inspectTiles(localPaths []string) error {
+	var tilePaths []string
+	for _, p := range localPaths {
+		if tile.IsTile(p) {
+			tilePaths = append(tilePaths, p)
 		}
+	}
+
+	if len(tilePaths) == 0 {
+		c.l.Info("No tiles (.pivotal files) were downloaded to inspect")
+		return nil
+	}
+
+	return c.tileInspector.InspectAll(tilePaths)
+}
+
+// downloadPredicates builds the filters for Download from options.
+func (c *ProductFileClient) downloadPredicates(
+	fileGroups []pivnet.FileGroup,
+	options DownloadOptions,
+) ([]filter.ProductFilePredicate, error) {
+	var predicates []filter.ProductFilePredicate
 
-		err = file.Close()
+	if len(options.FileGroups) > 0 {
+		inFileGroups, err := filter.InFileGroups(fileGroups, options.FileGroups)
 		if err != nil {
-			return c.eh.HandleError(err)
+			return nil, err
+		}
+		predicates = append(predicates, inFileGroups)
+	}
+
+	if len(options.FileTypes) > 0 {
+		predicates = append(predicates, filter.OfFileTypes(options.FileTypes))
+	}
+
+	if len(options.Platforms) > 0 {
+		predicates = append(predicates, filter.ForPlatforms(options.Platforms))
+	}
+
+	if len(options.ExcludeGlobs) > 0 {
+		predicates = append(predicates, filter.Not(filter.MatchingGlobs(options.ExcludeGlobs)))
+	}
+
+	return predicates, nil
+}
+
+type plannedDownload struct {
+	ProductFileID int    `json:"product_file_id" yaml:"product_file_id"`
+	Name          string `json:"name" yaml:"name"`
+	FileType      string `json:"file_type" yaml:"file_type"`
+	Size          int    `json:"size" yaml:"size"`
+	SHA256        string `json:"sha256" yaml:"sha256"`
+	LocalPath     string `json:"local_path" yaml:"local_path"`
+}
+
+func (c *ProductFileClient) printDownloadPlan(productFiles []pivnet.ProductFile, paths map[int]string) error {
+	plan := make([]plannedDownload, len(productFiles))
+
+	totalSize := 0
+	for i, pf := range productFiles {
+		plan[i] = plannedDownload{
+			ProductFileID: pf.ID,
+			Name:          pf.Name,
+			FileType:      pf.FileType,
+			Size:          pf.Size,
+			SHA256:        pf.SHA256,
+			LocalPath:     paths[pf.ID],
+		}
+		totalSize += pf.Size
+	}
+
+	switch c.format {
+	case printer.PrintAsTable:
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"ID",
+			"Name",
+			"File Type",
+			"Size",
+			"SHA256",
+			"Local Path",
+		})
+
+		for _, p := range plan {
+			table.Append([]string{
+				strconv.Itoa(p.ProductFileID),
+				p.Name,
+				p.FileType,
+				formatBytes(uint64(p.Size)),
+				p.SHA256,
+				p.LocalPath,
+			})
 		}
+		table.Render()
 
 		c.l.Info(fmt.Sprintf(
-			"Downloading '%s' to '%s'",
-			fileName,
-			localFilepath,
+			"Dry run: %d product files (%s) would be downloaded",
+			len(plan),
+			formatBytes(uint64(totalSize)),
 		))
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(plan)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(plan)
+	}
 
-		err = c.pivnetClient.DownloadProductFile(fileInfo, productSlug, release.ID, pf.ID, progressWriter)
-		if err != nil {
-			_ = os.Remove(localFilepath)
-			return c.eh.HandleError(err)
+	return nil
+}
+
+// checkFreeSpace returns an error if downloadDir does not have room for the
+// product files at paths. Files that would be skipped as already present, and the
+// downloaded part of files that would be resumed, are not counted. A failure
+// to determine the free space is only logged.
+func (c *ProductFileClient) checkFreeSpace(
+	productFiles []pivnet.ProductFile,
+	paths map[int]string,
+	downloadDir string,
+	options DownloadOptions,
+) error {
+	var needed uint64
+	for _, pf := range productFiles {
+		size := int64(pf.Size)
+		localFilepath := paths[pf.ID]
+
+		if options.SkipExisting {
+			info, err := os.Stat(localFilepath)
+			if err == nil && info.Size() == size {
+				continue
+			}
 		}
 
-		if pf.FileType == pivnet.FileTypeSoftware && pf.SHA256 == "" && pf.MD5 == "" {
-			return fmt.Errorf("cannot check file integrity of file %s: missing sha256 and md5 fields", file.Name())
+		if options.Resume {
+			info, err := os.Stat(localFilepath + partialFileSuffix)
+			if err == nil && info.Size() <= size {
+				size -= info.Size()
+			}
 		}
 
-		if pf.SHA256 != "" {
-			c.l.Info("Verifying SHA256")
+		needed += uint64(size)
+	}
+
+	if needed == 0 {
+		return nil
+	}
+
+	free, err := c.diskSpaceChecker.Free(downloadDir)
+	if err != nil {
+		c.l.Info(fmt.Sprintf("Unable to determine free space in '%s': %s", downloadDir, err.Error()))
+		return nil
+	}
+
+	if needed > free {
+		return fmt.Errorf(
+			"Not enough free space in '%s': %s needed, %s available",
+			downloadDir,
+			formatBytes(needed),
+			formatBytes(free),
+		)
+	}
+
+	return nil
+}
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+func (c *ProductFileClient) downloadInParallel(
+	productFiles []pivnet.ProductFile,
+	productSlug string,
+	releaseID int,
+	paths map[int]string,
+	options DownloadOptions,
+	progressWriter io.Writer,
+) ([]string, error) {
+	results := make([]downloadResult, len(productFiles))
+
+	var progressMutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, options.Parallel)
+
+	for i, pf := range productFiles {
+		wg.Add(1)
+		go func(i int, pf pivnet.ProductFile) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			progress := newFileProgress(options.Progress, progressWriter, &progressMutex, pf, true)
+
+			localFilepath, outcome, err := c.downloadProductFile(pf, productSlug, releaseID, paths[pf.ID], options, progress)
+
+			result := downloadResult{
+				ProductFileID: pf.ID,
+				Name:          pf.Name,
+				LocalPath:     localFilepath,
+				Skipped:       outcome == outcomeSkipped,
+				Cached:        outcome == outcomeCached,
+			}
 
-			actualSHA256, err := c.sha256FileSummer.SumFile(file.Name())
 			if err != nil {
-				return err
+				result.Error = err.Error()
 			}
 
-			if actualSHA256 != pf.SHA256 {
-				return fmt.Errorf(
-					"SHA256 comparison failed for downloaded file: '%s'. Expected (from pivnet): '%s' - actual (from file): '%s'",
-					file.Name(),
-					pf.SHA256,
-					actualSHA256,
-				)
+			results[i] = result
+		}(i, pf)
+	}
+
+	wg.Wait()
+
+	err := c.printDownloadResults(results)
+	if err != nil {
+		return nil, c.eh.HandleError(err)
+	}
+
+	var failed int
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		err := fmt.Errorf(
+			"%d of %d product files failed to download",
+			failed,
+			len(results),
+		)
+		return nil, c.eh.HandleError(err)
+	}
+
+	localPaths := make([]string, len(results))
+	for i, result := range results {
+		localPaths[i] = result.LocalPath
+	}
+
+	return localPaths, nil
+}
+
+func (c *ProductFileClient) printDownloadResults(results []downloadResult) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"ID",
+			"Name",
+			"Local Path",
+			"Result",
+		})
+
+		for _, result := range results {
+			status := ui.SuccessColor.SprintFunc()("OK")
+			if result.Skipped {
+				status = ui.SuccessColor.SprintFunc()("Skipped (already verified)")
+			}
+			if result.Cached {
+				status = ui.SuccessColor.SprintFunc()("OK (from cache)")
 			}
+			if result.Error != "" {
+				status = ui.ErrorColor.SprintFunc()(result.Error)
+			}
+
+			table.Append([]string{
+				strconv.Itoa(result.ProductFileID),
+				result.Name,
+				result.LocalPath,
+				status,
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(results)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(results)
+	}
+
+	return nil
+}
+
+// downloadProductFile fetches and verifies a single product file. It returns
+// the local path of the file and whether it was downloaded, skipped because a
+// verified copy was already present, or taken from the cache.
+func (c *ProductFileClient) downloadProductFile(
+	pf pivnet.ProductFile,
+	productSlug string,
+	releaseID int,
+	localFilepath string,
+	options DownloadOptions,
+	progress fileProgress,
+) (string, downloadOutcome, error) {
+	localPath, outcome, err := c.placeProductFile(pf, productSlug, releaseID, localFilepath, options, progress.writer())
+	if err != nil {
+		progress.failed(err)
+		return localPath, outcome, err
+	}
+
+	if pf.SHA256 != "" || pf.MD5 != "" {
+		progress.verified()
+	}
+	progress.completed(localPath, outcome)
+
+	return localPath, outcome, nil
+}
+
+// placeProductFile puts a verified copy of a product file at localFilepath,
+// from the download directory, the cache or Pivnet, in that order.
+func (c *ProductFileClient) placeProductFile(
+	pf pivnet.ProductFile,
+	productSlug string,
+	releaseID int,
+	localFilepath string,
+	options DownloadOptions,
+	progressWriter io.Writer,
+) (string, downloadOutcome, error) {
+	fileName := fileNameFor(pf)
+
+	if options.SkipExisting {
+		verified, err := c.existingFileVerified(pf, localFilepath)
+		if err != nil {
+			return "", outcomeDownloaded, err
+		}
+
+		if verified {
+			c.l.Info(fmt.Sprintf(
+				"Skipping '%s': '%s' already exists and matches the expected checksums",
+				fileName,
+				localFilepath,
+			))
+			return localFilepath, outcomeSkipped, nil
+		}
+	}
+
+	var store *cache.Store
+	if options.CacheDir != "" && cache.IsSHA256(pf.SHA256) {
+		store = cache.NewStore(options.CacheDir)
+
+		cached, err := c.fetchFromCache(pf, store, localFilepath)
+		if err != nil {
+			return "", outcomeDownloaded, err
+		}
+
+		if cached {
+			return localFilepath, outcomeCached, nil
+		}
+	}
+
+	var err error
+	if !options.Resume {
+		var tempFilepath string
+		tempFilepath, err = c.fetchProductFile(pf, productSlug, releaseID, filepath.Dir(localFilepath), progressWriter)
+		if err != nil {
+			return "", outcomeDownloaded, transferError{err}
+		}
+
+		err = c.promoteProductFile(pf, tempFilepath, localFilepath, options.QuarantineDir, nil)
+	} else {
+		partialFilepath := localFilepath + partialFileSuffix
+
+		var digests *fileDigests
+		digests, err = c.resumeProductFile(pf, productSlug, releaseID, partialFilepath, progressWriter)
+		if err != nil {
+			return "", outcomeDownloaded, transferError{err}
+		}
+
+		err = c.promoteProductFile(pf, partialFilepath, localFilepath, options.QuarantineDir, digests)
+	}
+	if err != nil {
+		return "", outcomeDownloaded, err
+	}
+
+	if store != nil {
+		c.addToCache(pf, store, localFilepath)
+	}
+
+	return localFilepath, outcomeDownloaded, nil
+}
+
+// fetchFromCache places the cached copy of a product file at localFilepath if
+// the cache has one that still verifies. A cached copy that does not verify
+// is removed from the cache so that the file is downloaded again.
+func (c *ProductFileClient) fetchFromCache(
+	pf pivnet.ProductFile,
+	store *cache.Store,
+	localFilepath string,
+) (bool, error) {
+	cachedFilepath := localFilepath + cachedFileSuffix
+	_ = os.Remove(cachedFilepath)
+
+	found, err := store.Fetch(pf.SHA256, cachedFilepath)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		c.l.Debug("Product file not in cache", logger.Data{"name": pf.Name, "sha256": pf.SHA256})
+		return false, nil
+	}
+
+	err = c.verifyProductFile(pf, cachedFilepath, nil)
+	if err != nil {
+		_ = os.Remove(cachedFilepath)
+
+		c.l.Info(fmt.Sprintf(
+			"Removing '%s' from cache at '%s': %s",
+			fileNameFor(pf),
+			store.Dir(),
+			err,
+		))
+
+		return false, store.Remove(pf.SHA256)
+	}
+
+	err = os.Rename(cachedFilepath, localFilepath)
+	if err != nil {
+		_ = os.Remove(cachedFilepath)
+		return false, err
+	}
+
+	c.l.Info(fmt.Sprintf(
+		"Using cached copy of '%s' from '%s'",
+		fileNameFor(pf),
+		store.Dir(),
+	))
+
+	return true, nil
+}
+
+// addToCache stores a verified product file in the cache. Failing to do so
+// does not fail the download.
+func (c *ProductFileClient) addToCache(pf pivnet.ProductFile, store *cache.Store, localFilepath string) {
+	err := store.Add(pf.SHA256, localFilepath)
+	if err != nil {
+		c.l.Info(fmt.Sprintf(
+			"Could not add '%s' to cache at '%s': %s",
+			fileNameFor(pf),
+			store.Dir(),
+			err,
+		))
+		return
+	}
+
+	c.l.Debug("Added product file to cache", logger.Data{"name": pf.Name, "sha256": pf.SHA256})
+}
+
+// promoteProductFile verifies a downloaded file and only then renames it to
+// its final path, so a file that fails verification never appears there.
+// digests may hold checksums computed while the file downloaded; if nil they
+// are computed from the file.
+func (c *ProductFileClient) promoteProductFile(
+	pf pivnet.ProductFile,
+	downloadedFilepath string,
+	localFilepath string,
+	quarantineDir string,
+	digests *fileDigests,
+) error {
+	err := c.verifyProductFile(pf, downloadedFilepath, digests)
+	if err != nil {
+		return c.discardProductFile(downloadedFilepath, fileNameFor(pf), quarantineDir, err)
+	}
+
+	err = os.Rename(downloadedFilepath, localFilepath)
+	if err != nil {
+		_ = os.Remove(downloadedFilepath)
+		return transferError{err}
+	}
+
+	return nil
+}
+
+// discardProductFile removes a file that failed verification, or moves it to
+// quarantineDir when one is given. The returned error describes both the
+// verification failure and what happened to the file.
+func (c *ProductFileClient) discardProductFile(
+	downloadedFilepath string,
+	fileName string,
+	quarantineDir string,
+	verifyErr error,
+) error {
+	if quarantineDir == "" {
+		_ = os.Remove(downloadedFilepath)
+		return fmt.Errorf("%s. The file has been deleted", verifyErr)
+	}
+
+	quarantinedFilepath := filepath.Join(quarantineDir, fileName+quarantinedFileSuffix)
+
+	err := os.MkdirAll(quarantineDir, 0755)
+	if err == nil {
+		err = os.Rename(downloadedFilepath, quarantinedFilepath)
+	}
+
+	if err != nil {
+		_ = os.Remove(downloadedFilepath)
+		return fmt.Errorf("%s. The file has been deleted because it could not be quarantined: %s", verifyErr, err)
+	}
+
+	c.l.Info(fmt.Sprintf(
+		"Moved '%s' to quarantine at '%s'",
+		fileName,
+		quarantinedFilepath,
+	))
+
+	return fmt.Errorf("%s. The file has been quarantined at '%s'", verifyErr, quarantinedFilepath)
+}
+
+// fetchProductFile downloads a product file to a temporary file in
+// downloadDir and returns the path of that temporary file. The file is
+// downloaded in concurrent ranges, which arrive out of order, so its
+// checksums are computed from the finished file.
+func (c *ProductFileClient) fetchProductFile(
+	pf pivnet.ProductFile,
+	productSlug string,
+	releaseID int,
+	downloadDir string,
+	progressWriter io.Writer,
+) (string, error) {
+	fileName := fileNameFor(pf)
+
+	c.l.Debug(
+		"Creating temporary file",
+		logger.Data{"name": pf.Name, "downloadDir": downloadDir},
+	)
+	file, err := ioutil.TempFile(downloadDir, fileName+".*"+temporaryFileSuffix)
+	if err != nil {
+		return "", err
+	}
+	tempFilepath := file.Name()
+
+	err = file.Chmod(0644)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(tempFilepath)
+		return "", err
+	}
+
+	fileInfo, err := download.NewFileInfo(file)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(tempFilepath)
+		return "", err
+	}
 
-			c.l.Info("Successfully verified SHA256")
+	err = file.Close()
+	if err != nil {
+		_ = os.Remove(tempFilepath)
+		return "", err
+	}
+
+	c.l.Info(fmt.Sprintf(
+		"Downloading '%s' to '%s'",
+		fileName,
+		tempFilepath,
+	))
+
+	err = c.pivnetClient.DownloadProductFile(fileInfo, productSlug, releaseID, pf.ID, progressWriter)
+	if err != nil {
+		_ = os.Remove(tempFilepath)
+		return "", err
+	}
+
+	return tempFilepath, nil
+}
+
+// resumeProductFile continues downloading into partialFilepath from its
+// current size. The partial file is kept when the transfer fails so that a
+// later run can pick up where this one stopped. Because the bytes arrive in
+// order, the checksums are computed as they are written and returned, or nil
+// if the configured summers cannot stream.
+func (c *ProductFileClient) resumeProductFile(
+	pf pivnet.ProductFile,
+	productSlug string,
+	releaseID int,
+	partialFilepath string,
+	progressWriter io.Writer,
+) (*fileDigests, error) {
+	c.l.Debug(
+		"Opening partial file",
+		logger.Data{"name": pf.Name, "partialFilepath": partialFilepath},
+	)
+	file, err := os.OpenFile(partialFilepath, os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	fileInfo, err := download.NewFileInfo(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if stat.Size() > 0 {
+		c.l.Info(fmt.Sprintf(
+			"Resuming '%s' to '%s' from byte %d",
+			fileNameFor(pf),
+			partialFilepath,
+			stat.Size(),
+		))
+	} else {
+		c.l.Info(fmt.Sprintf(
+			"Downloading '%s' to '%s'",
+			fileNameFor(pf),
+			partialFilepath,
+		))
+	}
+
+	var digestWriter io.Writer
+	digests := c.newFileDigests(pf)
+	if digests != nil {
+		err = digests.seed(partialFilepath, stat.Size())
+		if err != nil {
+			return nil, err
 		}
+		digestWriter = digests
+	}
+
+	err = c.pivnetClient.ResumeProductFileDownload(fileInfo, productSlug, releaseID, pf.ID, stat.Size(), progressWriter, digestWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	return digests, nil
+}
+
+// existingFileVerified reports whether localFilepath already exists and
+// matches every checksum Pivnet has for the product file. Files without any
+// checksum are never considered verified.
+func (c *ProductFileClient) existingFileVerified(pf pivnet.ProductFile, localFilepath string) (bool, error) {
+	_, err := os.Stat(localFilepath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
 
-		if pf.MD5 != "" {
-			c.l.Info("Verifying MD5")
+	if pf.SHA256 == "" && pf.MD5 == "" {
+		c.l.Debug(
+			"Cannot verify existing file without checksums",
+			logger.Data{"name": pf.Name, "localFilepath": localFilepath},
+		)
+		return false, nil
+	}
 
-			actualMD5, err := c.md5FileSummer.SumFile(file.Name())
+	digests := c.newFileDigests(pf)
+	if digests != nil {
+		err := digests.sumFile(localFilepath)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if pf.SHA256 != "" {
+		actualSHA256, err := c.sumSHA256(localFilepath, digests)
+		if err != nil {
+			return false, err
+		}
+
+		if actualSHA256 != pf.SHA256 {
+			c.l.Debug(
+				"Existing file does not match SHA256",
+				logger.Data{"name": pf.Name, "localFilepath": localFilepath},
+			)
+			return false, nil
+		}
+	}
+
+	if pf.MD5 != "" {
+		actualMD5, err := c.sumMD5(localFilepath, digests)
+		if err != nil {
+			return false, err
+		}
+
+		if actualMD5 != pf.MD5 {
+			c.l.Debug(
+				"Existing file does not match MD5",
+				logger.Data{"name": pf.Name, "localFilepath": localFilepath},
+			)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (c *ProductFileClient) verifyProductFile(pf pivnet.ProductFile, localFilepath string, digests *fileDigests) error {
+	if pf.FileType == pivnet.FileTypeSoftware && pf.SHA256 == "" && pf.MD5 == "" {
+		return fmt.Errorf("cannot check file integrity of file %s: missing sha256 and md5 fields", localFilepath)
+	}
+
+	if digests == nil {
+		digests = c.newFileDigests(pf)
+		if digests != nil {
+			err := digests.sumFile(localFilepath)
 			if err != nil {
 				return err
 			}
+		}
+	}
 
-			if actualMD5 != pf.MD5 {
-				return fmt.Errorf(
-					"MD5 comparison failed for downloaded file: '%s'. Expected (from pivnet): '%s' - actual (from file): '%s'",
-					file.Name(),
-					pf.MD5,
-					actualMD5,
-				)
-			}
+	if pf.SHA256 != "" {
+		c.l.Info("Verifying SHA256")
+
+		actualSHA256, err := c.sumSHA256(localFilepath, digests)
+		if err != nil {
+			return err
+		}
+
+		if actualSHA256 != pf.SHA256 {
+			return fmt.Errorf(
+				"SHA256 comparison failed for downloaded file: '%s'. Expected (from pivnet): '%s' - actual (from file): '%s'",
+				localFilepath,
+				pf.SHA256,
+				actualSHA256,
+			)
+		}
+
+		c.l.Info("Successfully verified SHA256")
+	}
+
+	if pf.MD5 != "" {
+		c.l.Info("Verifying MD5")
 
-			c.l.Info("Successfully verified MD5")
+		actualMD5, err := c.sumMD5(localFilepath, digests)
+		if err != nil {
+			return err
+		}
+
+		if actualMD5 != pf.MD5 {
+			return fmt.Errorf(
+				"MD5 comparison failed for downloaded file: '%s'. Expected (from pivnet): '%s' - actual (from file): '%s'",
+				localFilepath,
+				pf.MD5,
+				actualMD5,
+			)
 		}
+
+		c.l.Info("Successfully verified MD5")
 	}
 
 	return nil
 }
 
+func (c *ProductFileClient) sumSHA256(localFilepath string, digests *fileDigests) (string, error) {
+	if digests != nil {
+		return digests.SHA256()
+	}
+	return c.sha256FileSummer.SumFile(localFilepath)
+}
+
+func (c *ProductFileClient) sumMD5(localFilepath string, digests *fileDigests) (string, error) {
+	if digests != nil {
+		return digests.MD5()
+	}
+	return c.md5FileSummer.SumFile(localFilepath)
+}
+
+func fileNameFor(pf pivnet.ProductFile) string {
+	parts := strings.Split(pf.AWSObjectKey, "/")
+	return parts[len(parts)-1]
+}
+
 func filterProductFilesByIDs(productFiles []pivnet.ProductFile, ids []int) []pivnet.ProductFile {
 	var foundProductFiles []pivnet.ProductFile
 	for _, pf := range productFiles {
@@ -597,6 +1505,23 @@ func filterProductFilesByIDs(productFiles []pivnet.ProductFile, ids []int) []piv
 	return foundProductFiles
 }
 
+// UniqueProductFiles returns productFiles without repeated IDs. A product
+// file that is also in one of the file groups of a release is returned more
+// than once for the release, but is downloaded only once.
+func UniqueProductFiles(productFiles []pivnet.ProductFile) []pivnet.ProductFile {
+	var unique []pivnet.ProductFile
+	seen := map[int]bool{}
+	for _, pf := range productFiles {
+		if seen[pf.ID] {
+			continue
+		}
+		seen[pf.ID] = true
+
+		unique = append(unique, pf)
+	}
+	return unique
+}
+
 func intContains(i int, ints []int) bool {
 	for _, val := range ints {
 		if val == i {
@@ -4,18 +4,28 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/download"
 	"github.com/pivotal-cf/go-pivnet/v7/logger"
 	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/checksum"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/cache"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile/productfilefakes"
 	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
 	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+	"gopkg.in/yaml.v2"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 var _ = Describe("productfile commands", func() {
@@ -25,6 +35,8 @@ var _ = Describe("productfile commands", func() {
 		fakePivnetClient     *productfilefakes.FakePivnetClient
 		fakeSHA256FileSummer *productfilefakes.FakeFileSummer
 		fakeMD5FileSummer    *productfilefakes.FakeFileSummer
+		fakeDiskSpaceChecker *productfilefakes.FakeDiskSpaceChecker
+		fakeTileInspector    *productfilefakes.FakeTileInspector
 
 		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
 
@@ -52,6 +64,11 @@ var _ = Describe("productfile commands", func() {
 			return "mymd5", nil
 		}
 
+		fakeDiskSpaceChecker = &productfilefakes.FakeDiskSpaceChecker{}
+		fakeDiskSpaceChecker.FreeReturns(1<<40, nil)
+
+		fakeTileInspector = &productfilefakes.FakeTileInspector{}
+
 		outBuffer = bytes.Buffer{}
 		logBuffer = bytes.Buffer{}
 
@@ -93,7 +110,7 @@ var _ = Describe("productfile commands", func() {
 				ID:           7890,
 				Name:         "My Documentation",
 				FileType:     "Documentation",
-				AWSObjectKey: "/remote/path/some-other-file",
+				AWSObjectKey: "/remote/path/documentation-file",
 				Links: &pivnet.Links{
 					Download: map[string]string{"href": "download-link-1"},
 				},
@@ -104,6 +121,8 @@ var _ = Describe("productfile commands", func() {
 			fakePivnetClient,
 			fakeSHA256FileSummer,
 			fakeMD5FileSummer,
+			fakeDiskSpaceChecker,
+			fakeTileInspector,
 			fakeErrorHandler,
 			printer.PrintAsJSON,
 			&outBuffer,
@@ -181,7 +200,7 @@ var _ = Describe("productfile commands", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("releases error")
-					fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+					fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 				})
 
 				It("invokes the error handler", func() {
@@ -328,7 +347,7 @@ var _ = Describe("productfile commands", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("releases error")
-					fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+					fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 				})
 
 				It("invokes the error handler", func() {
@@ -753,7 +772,7 @@ var _ = Describe("productfile commands", func() {
 			filterErr = nil
 			downloadErr = nil
 
-			fakePivnetClient.ReleaseForVersionReturns(returnedRelease, nil)
+			fakePivnetClient.ReleaseForConstraintReturns(returnedRelease, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -769,7 +788,7 @@ var _ = Describe("productfile commands", func() {
 		})
 
 		It("downloads ProductFile", func() {
-			err := client.Download(
+			localPaths, err := client.Download(
 				productSlug,
 				releaseVersion,
 				globs,
@@ -777,9 +796,17 @@ var _ = Describe("productfile commands", func() {
 				downloadDir,
 				acceptEULA,
 				GinkgoWriter,
+				productfile.DownloadOptions{},
 			)
 			Expect(err).NotTo(HaveOccurred())
 
+			Expect(localPaths).To(Equal([]string{
+				filepath.Join(downloadDir, "some-file"),
+				filepath.Join(downloadDir, "some-other-file"),
+				filepath.Join(downloadDir, "third-other-file"),
+				filepath.Join(downloadDir, "documentation-file"),
+			}))
+
 			Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(4))
 
 			for i, pf := range productFiles {
@@ -793,10 +820,440 @@ var _ = Describe("productfile commands", func() {
 			}
 		})
 
+		Describe("inspecting tiles", func() {
+			var (
+				options productfile.DownloadOptions
+			)
+
+			BeforeEach(func() {
+				productFiles[0].AWSObjectKey = "/remote/path/cf-2.10.3.pivotal"
+				options = productfile.DownloadOptions{Inspect: true}
+			})
+
+			It("inspects the tiles once they are downloaded", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTileInspector.InspectAllCallCount()).To(Equal(1))
+				Expect(fakeTileInspector.InspectAllArgsForCall(0)).To(Equal([]string{
+					filepath.Join(downloadDir, "cf-2.10.3.pivotal"),
+				}))
+			})
+
+			Context("when downloading in parallel", func() {
+				BeforeEach(func() {
+					options.Parallel = 2
+				})
+
+				It("inspects the tiles once they are downloaded", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeTileInspector.InspectAllCallCount()).To(Equal(1))
+					Expect(fakeTileInspector.InspectAllArgsForCall(0)).To(Equal([]string{
+						filepath.Join(downloadDir, "cf-2.10.3.pivotal"),
+					}))
+				})
+			})
+
+			Context("when no tiles are downloaded", func() {
+				BeforeEach(func() {
+					productFileIDs = []int{productFiles[1].ID}
+				})
+
+				It("does not inspect anything", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeTileInspector.InspectAllCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when inspect is not set", func() {
+				BeforeEach(func() {
+					options.Inspect = false
+				})
+
+				It("does not inspect anything", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeTileInspector.InspectAllCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("writing metadata", func() {
+			var (
+				options productfile.DownloadOptions
+			)
+
+			readMetadata := func(name string) map[string]interface{} {
+				contents, err := ioutil.ReadFile(filepath.Join(downloadDir, name))
+				Expect(err).NotTo(HaveOccurred())
+
+				var metadata map[string]interface{}
+				if filepath.Ext(name) == ".json" {
+					err = json.Unmarshal(contents, &metadata)
+				} else {
+					err = yaml.Unmarshal(contents, &metadata)
+				}
+				Expect(err).NotTo(HaveOccurred())
+
+				return metadata
+			}
+
+			BeforeEach(func() {
+				productFileIDs = []int{productFiles[0].ID}
+				options = productfile.DownloadOptions{WriteMetadata: true}
+
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{
+					ID:          releaseID,
+					Version:     releaseVersion,
+					ReleaseType: "Major Release",
+					EULA:        &pivnet.EULA{Slug: "some-eula"},
+				}, nil)
+
+				fakePivnetClient.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+					{
+						ID:           5678,
+						Name:         "some-file-group",
+						ProductFiles: []pivnet.ProductFile{{ID: productFiles[1].ID, Name: productFiles[1].Name}},
+					},
+				}, nil)
+
+				fakePivnetClient.ReleaseDependenciesReturns([]pivnet.ReleaseDependency{
+					{
+						Release: pivnet.DependentRelease{
+							ID:      9876,
+							Version: "1.2.3",
+							Product: pivnet.Product{ID: 23, Slug: "some-dependency", Name: "Some Dependency"},
+						},
+					},
+				}, nil)
+
+				fakePivnetClient.ReleaseUpgradePathsReturns([]pivnet.ReleaseUpgradePath{
+					{Release: pivnet.UpgradePathRelease{ID: 8765, Version: "0.9.0"}},
+				}, nil)
+			})
+
+			It("writes the metadata of the release in the format of the pivnet-resource", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				invokedSlug, invokedReleaseID := fakePivnetClient.ReleaseDependenciesArgsForCall(0)
+				Expect(invokedSlug).To(Equal(productSlug))
+				Expect(invokedReleaseID).To(Equal(releaseID))
+
+				for _, name := range []string{"metadata.json", "metadata.yaml"} {
+					metadata := readMetadata(name)
+
+					Expect(metadata).To(HaveKey("release"))
+					Expect(metadata["product_files"]).To(HaveLen(len(productFiles)))
+					Expect(metadata["file_groups"]).To(HaveLen(1))
+					Expect(metadata["dependencies"]).To(HaveLen(1))
+					Expect(metadata["upgrade_paths"]).To(HaveLen(1))
+				}
+
+				metadata := readMetadata("metadata.json")
+
+				release := metadata["release"].(map[string]interface{})
+				Expect(release["version"]).To(Equal(releaseVersion))
+				Expect(release["release_type"]).To(Equal("Major Release"))
+				Expect(release["eula_slug"]).To(Equal("some-eula"))
+
+				Expect(metadata["product_files"].([]interface{})[0]).To(HaveKeyWithValue("file", productFiles[0].Name))
+				Expect(metadata["file_groups"].([]interface{})[0]).To(HaveKeyWithValue("name", "some-file-group"))
+				Expect(metadata["upgrade_paths"].([]interface{})[0]).To(HaveKeyWithValue("version", "0.9.0"))
+
+				dependency := metadata["dependencies"].([]interface{})[0].(map[string]interface{})
+				Expect(dependency["release"]).To(HaveKeyWithValue("version", "1.2.3"))
+			})
+
+			Context("when downloading in parallel", func() {
+				BeforeEach(func() {
+					options.Parallel = 2
+				})
+
+				It("writes the metadata once the files are downloaded", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(filepath.Join(downloadDir, "metadata.json")).To(BeARegularFile())
+					Expect(filepath.Join(downloadDir, "metadata.yaml")).To(BeARegularFile())
+				})
+			})
+
+			Context("when listing the dependencies returns an error", func() {
+				var (
+					expectedErr error
+				)
+
+				BeforeEach(func() {
+					expectedErr = errors.New("dependencies error")
+					fakePivnetClient.ReleaseDependenciesReturns(nil, expectedErr)
+				})
+
+				It("invokes the error handler", func() {
+					_, _ = client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+				})
+			})
+
+			Context("when the download is a dry run", func() {
+				BeforeEach(func() {
+					options.DryRun = true
+				})
+
+				It("does not write metadata", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(filepath.Join(downloadDir, "metadata.json")).NotTo(BeAnExistingFile())
+				})
+			})
+
+			Context("when write metadata is not set", func() {
+				BeforeEach(func() {
+					options.WriteMetadata = false
+				})
+
+				It("does not write metadata", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.ReleaseDependenciesCallCount()).To(Equal(0))
+					Expect(filepath.Join(downloadDir, "metadata.json")).NotTo(BeAnExistingFile())
+				})
+			})
+		})
+
+		Describe("progress", func() {
+			type progressReporter interface {
+				StartProgress(done int64, total int64)
+				Progress(done int64)
+			}
+
+			var (
+				progressBuffer bytes.Buffer
+				options        productfile.DownloadOptions
+			)
+
+			BeforeEach(func() {
+				progressBuffer = bytes.Buffer{}
+				productFileIDs = []int{productFiles[0].ID}
+				options = productfile.DownloadOptions{}
+			})
+
+			JustBeforeEach(func() {
+				fakePivnetClient.DownloadProductFileStub = func(location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error {
+					reporter, ok := progressWriter.(progressReporter)
+					Expect(ok).To(BeTrue())
+
+					reporter.StartProgress(0, 100)
+					for done := int64(25); done <= 100; done += 25 {
+						reporter.Progress(done)
+					}
+
+					if downloadErr != nil {
+						return downloadErr
+					}
+					return ioutil.WriteFile(location.Name, []byte(fileContents), 0644)
+				}
+			})
+
+			runDownload := func() error {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					&progressBuffer,
+					options,
+				)
+				return err
+			}
+
+			events := func() []map[string]interface{} {
+				var events []map[string]interface{}
+				for _, line := range strings.Split(strings.TrimSpace(progressBuffer.String()), "\n") {
+					var event map[string]interface{}
+					err := json.Unmarshal([]byte(line), &event)
+					Expect(err).NotTo(HaveOccurred())
+					events = append(events, event)
+				}
+				return events
+			}
+
+			Context("when progress is json", func() {
+				BeforeEach(func() {
+					options.Progress = productfile.ProgressJSON
+				})
+
+				It("writes a line of JSON for each step of the download", func() {
+					err := runDownload()
+					Expect(err).NotTo(HaveOccurred())
+
+					var names []string
+					var done []float64
+					for _, event := range events() {
+						names = append(names, event["event"].(string))
+						done = append(done, event["bytes_done"].(float64))
+
+						Expect(event["product_file_id"]).To(BeEquivalentTo(productFiles[0].ID))
+						Expect(event["bytes_total"]).To(BeEquivalentTo(100))
+						Expect(event).To(HaveKey("bytes_per_second"))
+					}
+
+					Expect(names).To(Equal([]string{"started", "progress", "progress", "progress", "progress", "verified", "completed"}))
+					Expect(done).To(Equal([]float64{0, 25, 50, 75, 100, 100, 100}))
+				})
+
+				It("writes the local path with the completed event", func() {
+					err := runDownload()
+					Expect(err).NotTo(HaveOccurred())
+
+					completed := events()[6]
+					Expect(completed["local_path"]).To(Equal(filepath.Join(downloadDir, "some-file")))
+				})
+
+				Context("when the download fails", func() {
+					BeforeEach(func() {
+						downloadErr = errors.New("download error")
+					})
+
+					It("writes a failed event with the error", func() {
+						err := runDownload()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(downloadErr))
+
+						failed := events()[5]
+						Expect(failed["event"]).To(Equal("failed"))
+						Expect(failed["error"]).To(Equal("download error"))
+					})
+				})
+			})
+
+			Context("when progress is log", func() {
+				BeforeEach(func() {
+					options.Progress = productfile.ProgressLog
+				})
+
+				It("logs a line each time another 10% has downloaded", func() {
+					err := runDownload()
+					Expect(err).NotTo(HaveOccurred())
+
+					lines := strings.Split(strings.TrimSpace(progressBuffer.String()), "\n")
+					Expect(lines).To(HaveLen(4))
+					Expect(lines[0]).To(HavePrefix("some-file: 25% (25 B of 100 B) at "))
+					Expect(lines[3]).To(HavePrefix("some-file: 100% (100 B of 100 B) at "))
+				})
+			})
+
+			Context("when progress is none", func() {
+				BeforeEach(func() {
+					options.Progress = productfile.ProgressNone
+				})
+
+				It("writes nothing", func() {
+					err := runDownload()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(progressBuffer.String()).To(BeEmpty())
+				})
+			})
+		})
+
 		Describe("checks the checksum for software files", func() {
 			Context("when file has only sha256", func() {
 				It("succeeds when sha256 matches", func() {
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -804,6 +1261,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).NotTo(HaveOccurred())
 
@@ -816,7 +1274,7 @@ var _ = Describe("productfile commands", func() {
 						return "incorrectsha256", nil
 					}
 
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -824,6 +1282,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring("SHA256 comparison failed for downloaded file:"))
@@ -832,7 +1291,7 @@ var _ = Describe("productfile commands", func() {
 
 			Context("when file has only md5", func() {
 				It("succeeds when md5 matches", func() {
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -840,6 +1299,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).NotTo(HaveOccurred())
 
@@ -852,7 +1312,7 @@ var _ = Describe("productfile commands", func() {
 						return "incorrectmd5", nil
 					}
 
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -860,6 +1320,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring("MD5 comparison failed for downloaded file:"))
@@ -868,7 +1329,7 @@ var _ = Describe("productfile commands", func() {
 
 			Context("when file has both sha256 and md5", func() {
 				It("succeeds when both sha256 and md5 matches", func() {
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -876,6 +1337,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).NotTo(HaveOccurred())
 
@@ -888,7 +1350,7 @@ var _ = Describe("productfile commands", func() {
 						return "incorrectsha256", nil
 					}
 
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -896,6 +1358,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring("SHA256 comparison failed for downloaded file:"))
@@ -906,7 +1369,7 @@ var _ = Describe("productfile commands", func() {
 						return "incorrectmd5", nil
 					}
 
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -914,6 +1377,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring("MD5 comparison failed for downloaded file:"))
@@ -937,7 +1401,7 @@ var _ = Describe("productfile commands", func() {
 
 						fakePivnetClient.ProductFilesForReleaseReturns(invalidProductFiles, nil)
 
-						err := client.Download(
+						_, err := client.Download(
 							productSlug,
 							releaseVersion,
 							globs,
@@ -945,6 +1409,7 @@ var _ = Describe("productfile commands", func() {
 							downloadDir,
 							acceptEULA,
 							GinkgoWriter,
+							productfile.DownloadOptions{},
 						)
 						Expect(err).To(HaveOccurred())
 						Expect(err.Error()).To(ContainSubstring("missing sha256 and md5 fields"))
@@ -953,7 +1418,7 @@ var _ = Describe("productfile commands", func() {
 
 				Context("when file type is not software", func() {
 					It("does not check sha256 nor md5", func() {
-						err := client.Download(
+						_, err := client.Download(
 							productSlug,
 							releaseVersion,
 							globs,
@@ -961,6 +1426,7 @@ var _ = Describe("productfile commands", func() {
 							downloadDir,
 							acceptEULA,
 							GinkgoWriter,
+							productfile.DownloadOptions{},
 						)
 						Expect(err).NotTo(HaveOccurred())
 
@@ -971,44 +1437,958 @@ var _ = Describe("productfile commands", func() {
 			})
 		})
 
-		Context("when globs are provided", func() {
+		Context("when parallel is greater than one", func() {
+			var (
+				options productfile.DownloadOptions
+			)
+
 			BeforeEach(func() {
-				globs = []string{"glob1", "glob2"}
-				productFileIDs = []int{}
+				options = productfile.DownloadOptions{
+					Parallel: 2,
+				}
 			})
 
-			It("downloads matching files", func() {
-				err := client.Download(
+			It("downloads all product files and prints a summary", func() {
+				progressBuffer := bytes.Buffer{}
+
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
 					productFileIDs,
 					downloadDir,
 					acceptEULA,
-					GinkgoWriter,
+					&progressBuffer,
+					options,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(4))
 
-				for i, pf := range productFiles {
+				var invokedProductFileIDs []int
+				for i := 0; i < fakePivnetClient.DownloadProductFileCallCount(); i++ {
 					_, invokedProductSlug, invokedReleaseID, invokedProductFileID, w :=
 						fakePivnetClient.DownloadProductFileArgsForCall(i)
 
 					Expect(invokedProductSlug).To(Equal(productSlug))
 					Expect(invokedReleaseID).To(Equal(releaseID))
-					Expect(invokedProductFileID).To(Equal(pf.ID))
-					Expect(w).To(Equal(GinkgoWriter))
+					Expect(w).NotTo(Equal(&progressBuffer))
+
+					invokedProductFileIDs = append(invokedProductFileIDs, invokedProductFileID)
+				}
+				Expect(invokedProductFileIDs).To(ConsistOf(productFileIDs))
+
+				_, _, _, _, w := fakePivnetClient.DownloadProductFileArgsForCall(0)
+				_, err = w.Write([]byte("\r 10 MiB / 20 MiB  50.00%"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progressBuffer.String()).To(MatchRegexp(`^[a-z-]+-file: 10 MiB / 20 MiB  50.00%\n$`))
+
+				var results []map[string]interface{}
+				err = json.Unmarshal(outBuffer.Bytes(), &results)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(results).To(HaveLen(4))
+				for i, result := range results {
+					Expect(result["product_file_id"]).To(BeEquivalentTo(productFiles[i].ID))
+					Expect(result).NotTo(HaveKey("error"))
+				}
+			})
+
+			Context("when a download fails", func() {
+				BeforeEach(func() {
+					downloadErr = errors.New("download error")
+				})
+
+				It("downloads every file before invoking the error handler", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(4))
+
+					var results []map[string]interface{}
+					err = json.Unmarshal(outBuffer.Bytes(), &results)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(results).To(HaveLen(4))
+					for _, result := range results {
+						Expect(result["error"]).To(Equal("download error"))
+					}
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(Equal("4 of 4 product files failed to download"))
+				})
+			})
+
+			Context("when a checksum does not match", func() {
+				BeforeEach(func() {
+					fakeMD5FileSummer.SumFileStub = func(path string) (string, error) {
+						return "incorrectmd5", nil
+					}
+				})
+
+				It("reports the failed files", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					var results []map[string]interface{}
+					err = json.Unmarshal(outBuffer.Bytes(), &results)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(results[0]).NotTo(HaveKey("error"))
+					Expect(results[1]["error"]).To(ContainSubstring("MD5 comparison failed"))
+					Expect(results[2]["error"]).To(ContainSubstring("MD5 comparison failed"))
+					Expect(results[3]).NotTo(HaveKey("error"))
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(Equal("2 of 4 product files failed to download"))
+				})
+			})
+		})
+
+		Describe("placing downloaded files", func() {
+			BeforeEach(func() {
+				productFileIDs = []int{productFiles[0].ID}
+			})
+
+			It("only leaves the verified file in the download directory", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				location, _, _, _, _ := fakePivnetClient.DownloadProductFileArgsForCall(0)
+				Expect(filepath.Dir(location.Name)).To(Equal(filepath.Clean(tempDir)))
+				Expect(location.Name).NotTo(Equal(filepath.Join(tempDir, "some-file")))
+
+				files, err := ioutil.ReadDir(tempDir)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+				Expect(files[0].Name()).To(Equal("some-file"))
+			})
+
+			Context("when the checksum does not match", func() {
+				BeforeEach(func() {
+					fakeSHA256FileSummer.SumFileStub = func(path string) (string, error) {
+						Expect(path).NotTo(Equal(filepath.Join(tempDir, "some-file")))
+						return "incorrectsha256", nil
+					}
+				})
+
+				It("deletes the file", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						productfile.DownloadOptions{},
+					)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("The file has been deleted"))
+
+					files, err := ioutil.ReadDir(tempDir)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(files).To(BeEmpty())
+				})
+
+				Context("when a quarantine directory is provided", func() {
+					var (
+						quarantineDir string
+					)
+
+					BeforeEach(func() {
+						quarantineDir = filepath.Join(tempDir, "quarantine")
+					})
+
+					It("moves the file to the quarantine directory", func() {
+						_, err := client.Download(
+							productSlug,
+							releaseVersion,
+							globs,
+							productFileIDs,
+							downloadDir,
+							acceptEULA,
+							GinkgoWriter,
+							productfile.DownloadOptions{QuarantineDir: quarantineDir},
+						)
+						Expect(err).To(HaveOccurred())
+
+						quarantinedFilepath := filepath.Join(quarantineDir, "some-file.checksum-failed")
+						Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("The file has been quarantined at '%s'", quarantinedFilepath)))
+
+						Expect(quarantinedFilepath).To(BeARegularFile())
+						Expect(filepath.Join(tempDir, "some-file")).NotTo(BeAnExistingFile())
+					})
+				})
+			})
+		})
+
+		Context("when resume is true", func() {
+			var (
+				options         productfile.DownloadOptions
+				localFilepath   string
+				partialFilepath string
+			)
+
+			BeforeEach(func() {
+				options = productfile.DownloadOptions{
+					Resume: true,
+				}
+				productFileIDs = []int{productFiles[0].ID}
+
+				localFilepath = filepath.Join(tempDir, "some-file")
+				partialFilepath = localFilepath + ".partial"
+			})
+
+			It("downloads into a partial file and moves it into place", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.ResumeProductFileDownloadCallCount()).To(Equal(1))
+
+				location, invokedProductSlug, invokedReleaseID, invokedProductFileID, startingByte, _, _ :=
+					fakePivnetClient.ResumeProductFileDownloadArgsForCall(0)
+				Expect(location.Name).To(Equal(partialFilepath))
+				Expect(invokedProductSlug).To(Equal(productSlug))
+				Expect(invokedReleaseID).To(Equal(releaseID))
+				Expect(invokedProductFileID).To(Equal(productFiles[0].ID))
+				Expect(startingByte).To(BeZero())
+
+				Expect(localFilepath).To(BeARegularFile())
+				Expect(partialFilepath).NotTo(BeAnExistingFile())
+			})
+
+			Context("when a partial file already exists", func() {
+				BeforeEach(func() {
+					err := ioutil.WriteFile(partialFilepath, []byte(fileContents[:4]), 0644)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("continues from the end of the partial file", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, _, _, startingByte, _, _ := fakePivnetClient.ResumeProductFileDownloadArgsForCall(0)
+					Expect(startingByte).To(Equal(int64(4)))
+				})
+			})
+
+			Context("when the download fails", func() {
+				var (
+					expectedErr error
+				)
+
+				BeforeEach(func() {
+					expectedErr = errors.New("resume error")
+					fakePivnetClient.ResumeProductFileDownloadReturns(expectedErr)
+				})
+
+				It("keeps the partial file and invokes the error handler", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+					Expect(partialFilepath).To(BeARegularFile())
+					Expect(localFilepath).NotTo(BeAnExistingFile())
+				})
+			})
+
+			Context("when the checksum does not match", func() {
+				BeforeEach(func() {
+					fakeSHA256FileSummer.SumFileStub = func(path string) (string, error) {
+						return "incorrectsha256", nil
+					}
+				})
+
+				It("removes the partial file and returns an error", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("SHA256 comparison failed for downloaded file:"))
+
+					Expect(partialFilepath).NotTo(BeAnExistingFile())
+					Expect(localFilepath).NotTo(BeAnExistingFile())
+				})
+			})
+		})
+
+		Context("when the checksum summers can hash while downloading", func() {
+			var (
+				localFilepath   string
+				partialFilepath string
+			)
+
+			BeforeEach(func() {
+				productFiles[2].SHA256 = "f03779b36bece74893fd6533a67549675e21573eb0e288d87158738f9c24594e"
+				productFiles[2].MD5 = "53a08cf9217fc1c69a90fd77c4d765db"
+				productFileIDs = []int{productFiles[2].ID}
+
+				localFilepath = filepath.Join(tempDir, "third-other-file")
+				partialFilepath = localFilepath + ".partial"
+
+				client = productfile.NewProductFileClient(
+					fakePivnetClient,
+					checksum.NewSHA256FileSummer(),
+					checksum.NewMD5FileSummer(),
+					fakeDiskSpaceChecker,
+					fakeTileInspector,
+					fakeErrorHandler,
+					printer.PrintAsJSON,
+					&outBuffer,
+					&logBuffer,
+					printer.NewPrinter(&outBuffer),
+					l,
+					fakeFilter,
+				)
+
+				fakePivnetClient.ResumeProductFileDownloadStub = func(location *download.FileInfo, productSlug string, releaseID int, productFileID int, startingByte int64, progressWriter io.Writer, digestWriter io.Writer) error {
+					remaining := []byte(fileContents[startingByte:])
+
+					_, err := digestWriter.Write(remaining)
+					if err != nil {
+						return err
+					}
+
+					file, err := os.OpenFile(location.Name, os.O_WRONLY|os.O_APPEND, 0644)
+					if err != nil {
+						return err
+					}
+					defer file.Close()
+
+					_, err = file.Write(remaining)
+					return err
+				}
+			})
+
+			It("downloads the file in ranges and verifies it in a single pass once it is written", func() {
+				fakePivnetClient.DownloadProductFileStub = func(location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error {
+					return ioutil.WriteFile(location.Name, []byte(fileContents), 0644)
+				}
+
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+				Expect(fakePivnetClient.ResumeProductFileDownloadCallCount()).To(Equal(0))
+
+				Expect(localFilepath).To(BeARegularFile())
+			})
+
+			Context("when the downloaded file does not match the checksum", func() {
+				BeforeEach(func() {
+					fakePivnetClient.DownloadProductFileStub = func(location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error {
+						return ioutil.WriteFile(location.Name, []byte("corrupted"), 0644)
+					}
+				})
+
+				It("returns an error", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						productfile.DownloadOptions{},
+					)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("SHA256 comparison failed for downloaded file:"))
+
+					Expect(localFilepath).NotTo(BeAnExistingFile())
+				})
+			})
+
+			Context("when resume is true", func() {
+				var (
+					options productfile.DownloadOptions
+				)
+
+				BeforeEach(func() {
+					options = productfile.DownloadOptions{
+						Resume: true,
+					}
+
+					err := ioutil.WriteFile(partialFilepath, []byte(fileContents[:4]), 0644)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("verifies the checksums of the bytes as they were written", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, _, _, _, _, digestWriter := fakePivnetClient.ResumeProductFileDownloadArgsForCall(0)
+					Expect(digestWriter).NotTo(BeNil())
+
+					Expect(localFilepath).To(BeARegularFile())
+				})
+
+				Context("when the streamed bytes do not match the checksum", func() {
+					BeforeEach(func() {
+						fakePivnetClient.ResumeProductFileDownloadStub = func(location *download.FileInfo, productSlug string, releaseID int, productFileID int, startingByte int64, progressWriter io.Writer, digestWriter io.Writer) error {
+							_, err := digestWriter.Write([]byte("corrupted"))
+							return err
+						}
+					})
+
+					It("returns an error", func() {
+						_, err := client.Download(
+							productSlug,
+							releaseVersion,
+							globs,
+							productFileIDs,
+							downloadDir,
+							acceptEULA,
+							GinkgoWriter,
+							options,
+						)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("SHA256 comparison failed for downloaded file:"))
+
+						Expect(localFilepath).NotTo(BeAnExistingFile())
+					})
+				})
+			})
+		})
+
+		Context("when skip existing is true", func() {
+			var (
+				options productfile.DownloadOptions
+			)
+
+			BeforeEach(func() {
+				options = productfile.DownloadOptions{
+					SkipExisting: true,
 				}
+				productFileIDs = []int{productFiles[2].ID}
+			})
+
+			Context("when the file does not exist", func() {
+				It("downloads the file", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the file exists", func() {
+				BeforeEach(func() {
+					err := ioutil.WriteFile(filepath.Join(tempDir, "third-other-file"), []byte(fileContents), 0644)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("skips the download when the checksums match", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+					Expect(fakeSHA256FileSummer.SumFileCallCount()).To(Equal(1))
+					Expect(fakeMD5FileSummer.SumFileCallCount()).To(Equal(1))
+				})
+
+				Context("when the sha256 does not match", func() {
+					BeforeEach(func() {
+						fakeSHA256FileSummer.SumFileStub = nil
+						fakeSHA256FileSummer.SumFileReturns("mysha256", nil)
+						fakeSHA256FileSummer.SumFileReturnsOnCall(0, "incorrectsha256", nil)
+					})
+
+					It("downloads the file again", func() {
+						_, err := client.Download(
+							productSlug,
+							releaseVersion,
+							globs,
+							productFileIDs,
+							downloadDir,
+							acceptEULA,
+							GinkgoWriter,
+							options,
+						)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the md5 does not match", func() {
+					BeforeEach(func() {
+						fakeMD5FileSummer.SumFileStub = nil
+						fakeMD5FileSummer.SumFileReturns("mymd5", nil)
+						fakeMD5FileSummer.SumFileReturnsOnCall(0, "incorrectmd5", nil)
+					})
+
+					It("downloads the file again", func() {
+						_, err := client.Download(
+							productSlug,
+							releaseVersion,
+							globs,
+							productFileIDs,
+							downloadDir,
+							acceptEULA,
+							GinkgoWriter,
+							options,
+						)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the product file has no checksums", func() {
+				BeforeEach(func() {
+					productFileIDs = []int{productFiles[3].ID}
+
+					err := ioutil.WriteFile(filepath.Join(tempDir, "documentation-file"), []byte(fileContents), 0644)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("downloads the file", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when a cache directory is provided", func() {
+			var (
+				options     productfile.DownloadOptions
+				cacheSHA256 string
+				store       *cache.Store
+			)
+
+			BeforeEach(func() {
+				cacheSHA256 = strings.Repeat("a", 64)
+				productFiles[0].SHA256 = cacheSHA256
+				productFileIDs = []int{productFiles[0].ID}
+
+				fakeSHA256FileSummer.SumFileStub = nil
+				fakeSHA256FileSummer.SumFileReturns(cacheSHA256, nil)
+
+				options = productfile.DownloadOptions{
+					CacheDir: filepath.Join(tempDir, "cache"),
+				}
+				store = cache.NewStore(options.CacheDir)
+			})
+
+			Context("when the file is not in the cache", func() {
+				It("downloads the file and adds it to the cache", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+					Expect(filepath.Join(tempDir, "some-file")).To(BeAnExistingFile())
+					Expect(store.Path(cacheSHA256)).To(BeAnExistingFile())
+				})
+			})
+
+			Context("when the file is in the cache", func() {
+				BeforeEach(func() {
+					sourceFilepath := filepath.Join(tempDir, "source")
+					err := ioutil.WriteFile(sourceFilepath, []byte(fileContents), 0644)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = store.Add(cacheSHA256, sourceFilepath)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("places the cached file in the download directory without downloading", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+
+					contents, err := ioutil.ReadFile(filepath.Join(tempDir, "some-file"))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal(fileContents))
+				})
+
+				It("reports the file as cached when downloading in parallel", func() {
+					options.Parallel = 2
+
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(outBuffer.String()).To(ContainSubstring(`"cached":true`))
+				})
+
+				Context("when the cached file does not match the checksum", func() {
+					BeforeEach(func() {
+						fakeSHA256FileSummer.SumFileReturnsOnCall(0, "corrupt", nil)
+					})
+
+					It("removes it from the cache and downloads the file", func() {
+						_, err := client.Download(
+							productSlug,
+							releaseVersion,
+							globs,
+							productFileIDs,
+							downloadDir,
+							acceptEULA,
+							GinkgoWriter,
+							options,
+						)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+						Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+
+						contents, err := ioutil.ReadFile(store.Path(cacheSHA256))
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(contents)).NotTo(Equal(fileContents))
+					})
+				})
+			})
+
+			Context("when the product file has no SHA256", func() {
+				BeforeEach(func() {
+					productFileIDs = []int{productFiles[1].ID}
+				})
+
+				It("downloads the file without caching it", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(1))
+					Expect(options.CacheDir).NotTo(BeADirectory())
+				})
+			})
+		})
+
+		Context("when globs are provided", func() {
+			BeforeEach(func() {
+				globs = []string{"glob1", "glob2"}
+				productFileIDs = []int{}
+			})
+
+			It("downloads matching files", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(4))
+
+				for i, pf := range productFiles {
+					_, invokedProductSlug, invokedReleaseID, invokedProductFileID, w :=
+						fakePivnetClient.DownloadProductFileArgsForCall(i)
+
+					Expect(invokedProductSlug).To(Equal(productSlug))
+					Expect(invokedReleaseID).To(Equal(releaseID))
+					Expect(invokedProductFileID).To(Equal(pf.ID))
+					Expect(w).To(Equal(GinkgoWriter))
+				}
+			})
+
+			Context("when filter returns an error", func() {
+				BeforeEach(func() {
+					filterErr = errors.New("filter error")
+				})
+
+				It("invokes the error handler", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						productfile.DownloadOptions{},
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(filterErr))
+				})
+			})
+		})
+
+		Context("when neither globs nor ids are provided", func() {
+			BeforeEach(func() {
+				globs = []string{}
+				productFileIDs = []int{}
+			})
+
+			It("invokes the error handler", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when both globs and ids are provided", func() {
+			BeforeEach(func() {
+				globs = []string{"glob1", "glob2"}
+				productFileIDs = []int{1234, 2345}
+			})
+
+			It("invokes the error handler", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
 			})
+		})
 
-			Context("when filter returns an error", func() {
+		Context("when dry run is set", func() {
+			BeforeEach(func() {
+				productFiles[0].Size = 1024
+				productFiles[1].Size = 2048
+				productFileIDs = []int{productFiles[0].ID, productFiles[1].ID}
+				acceptEULA = true
+			})
+
+			It("prints the product files without downloading them", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{DryRun: true},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.AcceptEULACallCount()).To(Equal(0))
+				Expect(fakeDiskSpaceChecker.FreeCallCount()).To(Equal(0))
+
+				var plan []map[string]interface{}
+				err = json.Unmarshal(outBuffer.Bytes(), &plan)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan).To(HaveLen(2))
+				Expect(plan[0]).To(Equal(map[string]interface{}{
+					"product_file_id": float64(1234),
+					"name":            "Only SHA256",
+					"file_type":       "Software",
+					"size":            float64(1024),
+					"sha256":          "mysha256",
+					"local_path":      filepath.Join(downloadDir, "some-file"),
+				}))
+				Expect(plan[1]["size"]).To(Equal(float64(2048)))
+			})
+		})
+
+		Context("when the download directory does not have enough free space", func() {
+			BeforeEach(func() {
+				productFiles[0].Size = 1024
+				productFiles[1].Size = 2048
+				productFileIDs = []int{productFiles[0].ID, productFiles[1].ID}
+
+				fakeDiskSpaceChecker.FreeReturns(3000, nil)
+			})
+
+			It("invokes the error handler before downloading anything", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeDiskSpaceChecker.FreeArgsForCall(0)).To(Equal(downloadDir))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(fmt.Sprintf(
+					"Not enough free space in '%s': 3.0 KiB needed, 2.9 KiB available",
+					downloadDir,
+				)))
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+			})
+
+			Context("when some of the files are already downloaded", func() {
 				BeforeEach(func() {
-					filterErr = errors.New("filter error")
+					err := ioutil.WriteFile(filepath.Join(downloadDir, "some-other-file.partial"), make([]byte, 1024), 0644)
+					Expect(err).NotTo(HaveOccurred())
 				})
 
-				It("invokes the error handler", func() {
-					err := client.Download(
+				It("does not count the downloaded bytes when resuming", func() {
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -1016,23 +2396,25 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{Resume: true},
 					)
 					Expect(err).NotTo(HaveOccurred())
 
-					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
-					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(filterErr))
+					for i := 0; i < fakeErrorHandler.HandleErrorCallCount(); i++ {
+						Expect(fakeErrorHandler.HandleErrorArgsForCall(i).Error()).NotTo(ContainSubstring("free space"))
+					}
 				})
 			})
 		})
 
-		Context("when neither globs nor ids are provided", func() {
+		Context("when the free space cannot be determined", func() {
 			BeforeEach(func() {
-				globs = []string{}
-				productFileIDs = []int{}
+				productFiles[0].Size = 1024
+				fakeDiskSpaceChecker.FreeReturns(0, errors.New("free error"))
 			})
 
-			It("invokes the error handler", func() {
-				err := client.Download(
+			It("downloads the product files", func() {
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1040,21 +2422,45 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(4))
 			})
 		})
 
-		Context("when both globs and ids are provided", func() {
+		Context("when a product file is returned more than once", func() {
+			JustBeforeEach(func() {
+				fakePivnetClient.ProductFilesForReleaseReturns(append(productFiles, productFiles[1]), nil)
+			})
+
+			It("downloads it once", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					productfile.DownloadOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(4))
+			})
+		})
+
+		Context("when two files have the same file name", func() {
 			BeforeEach(func() {
-				globs = []string{"glob1", "glob2"}
-				productFileIDs = []int{1234, 2345}
+				productFiles[3].AWSObjectKey = "/remote/path/some-other-file"
 			})
 
-			It("invokes the error handler", func() {
-				err := client.Download(
+			It("invokes the error handler without downloading anything", func() {
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1062,10 +2468,292 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(fmt.Sprintf(
+					"product files 2345 (Only MD5) and 7890 (My Documentation) would both be downloaded to '%s'",
+					filepath.Join(downloadDir, "some-other-file"),
+				)))
+				Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+			})
+
+			Context("when downloading in parallel", func() {
+				It("invokes the error handler without downloading anything", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						productfile.DownloadOptions{Parallel: 4},
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a path template is provided", func() {
+			var (
+				options productfile.DownloadOptions
+			)
+
+			BeforeEach(func() {
+				productFileIDs = []int{productFiles[0].ID, productFiles[2].ID}
+				options = productfile.DownloadOptions{
+					PathTemplate: "{{.ProductSlug}}/{{.ReleaseVersion}}/{{.FileGroup}}/{{.FileName}}",
+				}
+
+				fakePivnetClient.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+					{
+						ID:           1,
+						Name:         "Some File Group",
+						ProductFiles: []pivnet.ProductFile{productFiles[0]},
+					},
+				}, nil)
+			})
+
+			It("downloads each file to the path it resolves to", func() {
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(filepath.Join(downloadDir, productSlug, releaseVersion, "Some File Group", "some-file")).To(BeAnExistingFile())
+				Expect(filepath.Join(downloadDir, productSlug, releaseVersion, "third-other-file")).To(BeAnExistingFile())
+			})
+
+			It("can use any field of the product file and release", func() {
+				options.PathTemplate = "{{.Release.ID}}/{{.ProductFile.FileType}}/{{.ProductFile.ID}}-{{.FileName}}"
+				options.DryRun = true
+
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				var plan []map[string]interface{}
+				err = json.Unmarshal(outBuffer.Bytes(), &plan)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan[0]["local_path"]).To(Equal(filepath.Join(downloadDir, strconv.Itoa(releaseID), "Software", "1234-some-file")))
+				Expect(filepath.Join(downloadDir, strconv.Itoa(releaseID))).NotTo(BeADirectory())
+			})
+
+			Context("when two files resolve to the same path", func() {
+				BeforeEach(func() {
+					productFileIDs = []int{productFiles[1].ID, productFiles[3].ID}
+					options.PathTemplate = "{{.ReleaseVersion}}/some-file"
+				})
+
+				It("invokes the error handler without downloading anything", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(fmt.Sprintf(
+						"product files 2345 (Only MD5) and 7890 (My Documentation) would both be downloaded to '%s'",
+						filepath.Join(downloadDir, releaseVersion, "some-file"),
+					)))
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the path template resolves outside the download directory", func() {
+				BeforeEach(func() {
+					options.PathTemplate = "../{{.FileName}}"
+				})
+
+				It("invokes the error handler", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("not within the download directory"))
+				})
+			})
+
+			Context("when the path template is invalid", func() {
+				BeforeEach(func() {
+					options.PathTemplate = "{{.NoSuchField}}"
+				})
+
+				It("invokes the error handler", func() {
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("invalid path template"))
+				})
+			})
+		})
+
+		Context("when filters are provided", func() {
+			var (
+				options productfile.DownloadOptions
+			)
+
+			BeforeEach(func() {
+				productFileIDs = []int{}
+				options = productfile.DownloadOptions{}
+
+				fakePivnetClient.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+					{
+						ID:           1,
+						Name:         "Some File Group",
+						ProductFiles: []pivnet.ProductFile{productFiles[0], productFiles[1], productFiles[3]},
+					},
+				}, nil)
+			})
+
+			JustBeforeEach(func() {
+				fakeFilter.ProductFilesStub = filter.NewFilter(l).ProductFiles
+			})
+
+			downloadedIDs := func() []int {
+				var ids []int
+				for i := 0; i < fakePivnetClient.DownloadProductFileCallCount(); i++ {
+					_, _, _, id, _ := fakePivnetClient.DownloadProductFileArgsForCall(i)
+					ids = append(ids, id)
+				}
+				return ids
+			}
+
+			It("downloads files matching every filter", func() {
+				options.FileGroups = []string{"some file group"}
+				options.FileTypes = []string{"Software"}
+
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(downloadedIDs()).To(Equal([]int{1234, 2345}))
+
+				invokedProductSlug, invokedReleaseID := fakePivnetClient.FileGroupsForReleaseArgsForCall(0)
+				Expect(invokedProductSlug).To(Equal(productSlug))
+				Expect(invokedReleaseID).To(Equal(releaseID))
+			})
+
+			It("does not download files matching an exclude glob", func() {
+				productFileIDs = []int{1234, 2345, 3456}
+				options.ExcludeGlobs = []string{"*other*"}
+
+				_, err := client.Download(
+					productSlug,
+					releaseVersion,
+					globs,
+					productFileIDs,
+					downloadDir,
+					acceptEULA,
+					GinkgoWriter,
+					options,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(downloadedIDs()).To(Equal([]int{1234}))
+				Expect(fakePivnetClient.FileGroupsForReleaseCallCount()).To(Equal(0))
+			})
+
+			Context("when the file group does not exist", func() {
+				It("invokes the error handler", func() {
+					options.FileGroups = []string{"missing"}
+
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError("file group not found: 'missing'"))
+					Expect(fakePivnetClient.DownloadProductFileCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when no product files match the filters", func() {
+				It("invokes the error handler", func() {
+					options.Platforms = []string{"Windows"}
+
+					_, err := client.Download(
+						productSlug,
+						releaseVersion,
+						globs,
+						productFileIDs,
+						downloadDir,
+						acceptEULA,
+						GinkgoWriter,
+						options,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("No product files found"))
+				})
 			})
 		})
 
@@ -1075,7 +2763,7 @@ var _ = Describe("productfile commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1083,6 +2771,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1097,7 +2786,7 @@ var _ = Describe("productfile commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1105,6 +2794,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1119,7 +2809,7 @@ var _ = Describe("productfile commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1127,6 +2817,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1142,11 +2833,11 @@ var _ = Describe("productfile commands", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("releases error")
-				fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1154,6 +2845,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1168,7 +2860,7 @@ var _ = Describe("productfile commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1176,6 +2868,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1192,7 +2885,7 @@ var _ = Describe("productfile commands", func() {
 				files,_ := ioutil.ReadDir(downloadDir)
 				numberOfFilesBeforeDownload := len(files)
 
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1200,6 +2893,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 
 				files,_ = ioutil.ReadDir(downloadDir)
@@ -1218,7 +2912,7 @@ var _ = Describe("productfile commands", func() {
 			})
 
 			It("accepts the EULA", func() {
-				err := client.Download(
+				_, err := client.Download(
 					productSlug,
 					releaseVersion,
 					globs,
@@ -1226,6 +2920,7 @@ var _ = Describe("productfile commands", func() {
 					downloadDir,
 					acceptEULA,
 					GinkgoWriter,
+					productfile.DownloadOptions{},
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1243,7 +2938,7 @@ var _ = Describe("productfile commands", func() {
 				})
 
 				It("invokes the error handler", func() {
-					err := client.Download(
+					_, err := client.Download(
 						productSlug,
 						releaseVersion,
 						globs,
@@ -1251,6 +2946,7 @@ var _ = Describe("productfile commands", func() {
 						downloadDir,
 						acceptEULA,
 						GinkgoWriter,
+						productfile.DownloadOptions{},
 					)
 					Expect(err).NotTo(HaveOccurred())
 
@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package productfilefakes
+
+import (
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+)
+
+type FakeDiskSpaceChecker struct {
+	FreeStub        func(string) (uint64, error)
+	freeMutex       sync.RWMutex
+	freeArgsForCall []struct {
+		arg1 string
+	}
+	freeReturns struct {
+		result1 uint64
+		result2 error
+	}
+	freeReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiskSpaceChecker) Free(arg1 string) (uint64, error) {
+	fake.freeMutex.Lock()
+	ret, specificReturn := fake.freeReturnsOnCall[len(fake.freeArgsForCall)]
+	fake.freeArgsForCall = append(fake.freeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FreeStub
+	fakeReturns := fake.freeReturns
+	fake.recordInvocation("Free", []interface{}{arg1})
+	fake.freeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskSpaceChecker) FreeCallCount() int {
+	fake.freeMutex.RLock()
+	defer fake.freeMutex.RUnlock()
+	return len(fake.freeArgsForCall)
+}
+
+func (fake *FakeDiskSpaceChecker) FreeCalls(stub func(string) (uint64, error)) {
+	fake.freeMutex.Lock()
+	defer fake.freeMutex.Unlock()
+	fake.FreeStub = stub
+}
+
+func (fake *FakeDiskSpaceChecker) FreeArgsForCall(i int) string {
+	fake.freeMutex.RLock()
+	defer fake.freeMutex.RUnlock()
+	argsForCall := fake.freeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDiskSpaceChecker) FreeReturns(result1 uint64, result2 error) {
+	fake.freeMutex.Lock()
+	defer fake.freeMutex.Unlock()
+	fake.FreeStub = nil
+	fake.freeReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskSpaceChecker) FreeReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.freeMutex.Lock()
+	defer fake.freeMutex.Unlock()
+	fake.FreeStub = nil
+	if fake.freeReturnsOnCall == nil {
+		fake.freeReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.freeReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskSpaceChecker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.freeMutex.RLock()
+	defer fake.freeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDiskSpaceChecker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ productfile.DiskSpaceChecker = new(FakeDiskSpaceChecker)
@@ -6,6 +6,7 @@ import (
 
 	pivnet "github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
 )
 
 type FakeFilter struct {
@@ -23,6 +24,20 @@ type FakeFilter struct {
 		result1 []pivnet.ProductFile
 		result2 error
 	}
+	ProductFilesStub        func([]pivnet.ProductFile, ...filter.ProductFilePredicate) ([]pivnet.ProductFile, error)
+	productFilesMutex       sync.RWMutex
+	productFilesArgsForCall []struct {
+		arg1 []pivnet.ProductFile
+		arg2 []filter.ProductFilePredicate
+	}
+	productFilesReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	productFilesReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -44,15 +59,16 @@ func (fake *FakeFilter) ProductFileKeysByGlobs(arg1 []pivnet.ProductFile, arg2 [
 		arg1 []pivnet.ProductFile
 		arg2 []string
 	}{arg1Copy, arg2Copy})
+	stub := fake.ProductFileKeysByGlobsStub
+	fakeReturns := fake.productFileKeysByGlobsReturns
 	fake.recordInvocation("ProductFileKeysByGlobs", []interface{}{arg1Copy, arg2Copy})
 	fake.productFileKeysByGlobsMutex.Unlock()
-	if fake.ProductFileKeysByGlobsStub != nil {
-		return fake.ProductFileKeysByGlobsStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.productFileKeysByGlobsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -101,11 +117,83 @@ func (fake *FakeFilter) ProductFileKeysByGlobsReturnsOnCall(i int, result1 []piv
 	}{result1, result2}
 }
 
+func (fake *FakeFilter) ProductFiles(arg1 []pivnet.ProductFile, arg2 ...filter.ProductFilePredicate) ([]pivnet.ProductFile, error) {
+	var arg1Copy []pivnet.ProductFile
+	if arg1 != nil {
+		arg1Copy = make([]pivnet.ProductFile, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.productFilesMutex.Lock()
+	ret, specificReturn := fake.productFilesReturnsOnCall[len(fake.productFilesArgsForCall)]
+	fake.productFilesArgsForCall = append(fake.productFilesArgsForCall, struct {
+		arg1 []pivnet.ProductFile
+		arg2 []filter.ProductFilePredicate
+	}{arg1Copy, arg2})
+	stub := fake.ProductFilesStub
+	fakeReturns := fake.productFilesReturns
+	fake.recordInvocation("ProductFiles", []interface{}{arg1Copy, arg2})
+	fake.productFilesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFilter) ProductFilesCallCount() int {
+	fake.productFilesMutex.RLock()
+	defer fake.productFilesMutex.RUnlock()
+	return len(fake.productFilesArgsForCall)
+}
+
+func (fake *FakeFilter) ProductFilesCalls(stub func([]pivnet.ProductFile, ...filter.ProductFilePredicate) ([]pivnet.ProductFile, error)) {
+	fake.productFilesMutex.Lock()
+	defer fake.productFilesMutex.Unlock()
+	fake.ProductFilesStub = stub
+}
+
+func (fake *FakeFilter) ProductFilesArgsForCall(i int) ([]pivnet.ProductFile, []filter.ProductFilePredicate) {
+	fake.productFilesMutex.RLock()
+	defer fake.productFilesMutex.RUnlock()
+	argsForCall := fake.productFilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFilter) ProductFilesReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesMutex.Lock()
+	defer fake.productFilesMutex.Unlock()
+	fake.ProductFilesStub = nil
+	fake.productFilesReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilter) ProductFilesReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesMutex.Lock()
+	defer fake.productFilesMutex.Unlock()
+	fake.ProductFilesStub = nil
+	if fake.productFilesReturnsOnCall == nil {
+		fake.productFilesReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.productFilesReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeFilter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.productFileKeysByGlobsMutex.RLock()
 	defer fake.productFileKeysByGlobsMutex.RUnlock()
+	fake.productFilesMutex.RLock()
+	defer fake.productFilesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -91,6 +91,20 @@ type FakePivnetClient struct {
 	downloadProductFileReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FileGroupsForReleaseStub        func(string, int) ([]pivnet.FileGroup, error)
+	fileGroupsForReleaseMutex       sync.RWMutex
+	fileGroupsForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	fileGroupsForReleaseReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	fileGroupsForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
 	ProductFileStub        func(string, int) (pivnet.ProductFile, error)
 	productFileMutex       sync.RWMutex
 	productFileArgsForCall []struct {
@@ -147,6 +161,34 @@ type FakePivnetClient struct {
 		result1 []pivnet.ProductFile
 		result2 error
 	}
+	ReleaseDependenciesStub        func(string, int) ([]pivnet.ReleaseDependency, error)
+	releaseDependenciesMutex       sync.RWMutex
+	releaseDependenciesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseDependenciesReturns struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	releaseDependenciesReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -161,6 +203,20 @@ type FakePivnetClient struct {
 		result1 pivnet.Release
 		result2 error
 	}
+	ReleaseUpgradePathsStub        func(string, int) ([]pivnet.ReleaseUpgradePath, error)
+	releaseUpgradePathsMutex       sync.RWMutex
+	releaseUpgradePathsArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseUpgradePathsReturns struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	releaseUpgradePathsReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
 	RemoveProductFileFromFileGroupStub        func(string, int, int) error
 	removeProductFileFromFileGroupMutex       sync.RWMutex
 	removeProductFileFromFileGroupArgsForCall []struct {
@@ -187,6 +243,23 @@ type FakePivnetClient struct {
 	removeProductFileFromReleaseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResumeProductFileDownloadStub        func(*download.FileInfo, string, int, int, int64, io.Writer, io.Writer) error
+	resumeProductFileDownloadMutex       sync.RWMutex
+	resumeProductFileDownloadArgsForCall []struct {
+		arg1 *download.FileInfo
+		arg2 string
+		arg3 int
+		arg4 int
+		arg5 int64
+		arg6 io.Writer
+		arg7 io.Writer
+	}
+	resumeProductFileDownloadReturns struct {
+		result1 error
+	}
+	resumeProductFileDownloadReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProductFileStub        func(string, pivnet.ProductFile) (pivnet.ProductFile, error)
 	updateProductFileMutex       sync.RWMutex
 	updateProductFileArgsForCall []struct {
@@ -212,15 +285,16 @@ func (fake *FakePivnetClient) AcceptEULA(arg1 string, arg2 int) error {
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.AcceptEULAStub
+	fakeReturns := fake.acceptEULAReturns
 	fake.recordInvocation("AcceptEULA", []interface{}{arg1, arg2})
 	fake.acceptEULAMutex.Unlock()
-	if fake.AcceptEULAStub != nil {
-		return fake.AcceptEULAStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.acceptEULAReturns
 	return fakeReturns.result1
 }
 
@@ -274,15 +348,16 @@ func (fake *FakePivnetClient) AddProductFileToFileGroup(arg1 string, arg2 int, a
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddProductFileToFileGroupStub
+	fakeReturns := fake.addProductFileToFileGroupReturns
 	fake.recordInvocation("AddProductFileToFileGroup", []interface{}{arg1, arg2, arg3})
 	fake.addProductFileToFileGroupMutex.Unlock()
-	if fake.AddProductFileToFileGroupStub != nil {
-		return fake.AddProductFileToFileGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addProductFileToFileGroupReturns
 	return fakeReturns.result1
 }
 
@@ -336,15 +411,16 @@ func (fake *FakePivnetClient) AddProductFileToRelease(arg1 string, arg2 int, arg
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddProductFileToReleaseStub
+	fakeReturns := fake.addProductFileToReleaseReturns
 	fake.recordInvocation("AddProductFileToRelease", []interface{}{arg1, arg2, arg3})
 	fake.addProductFileToReleaseMutex.Unlock()
-	if fake.AddProductFileToReleaseStub != nil {
-		return fake.AddProductFileToReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addProductFileToReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -396,15 +472,16 @@ func (fake *FakePivnetClient) CreateProductFile(arg1 pivnet.CreateProductFileCon
 	fake.createProductFileArgsForCall = append(fake.createProductFileArgsForCall, struct {
 		arg1 pivnet.CreateProductFileConfig
 	}{arg1})
+	stub := fake.CreateProductFileStub
+	fakeReturns := fake.createProductFileReturns
 	fake.recordInvocation("CreateProductFile", []interface{}{arg1})
 	fake.createProductFileMutex.Unlock()
-	if fake.CreateProductFileStub != nil {
-		return fake.CreateProductFileStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createProductFileReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -460,15 +537,16 @@ func (fake *FakePivnetClient) DeleteProductFile(arg1 string, arg2 int) (pivnet.P
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.DeleteProductFileStub
+	fakeReturns := fake.deleteProductFileReturns
 	fake.recordInvocation("DeleteProductFile", []interface{}{arg1, arg2})
 	fake.deleteProductFileMutex.Unlock()
-	if fake.DeleteProductFileStub != nil {
-		return fake.DeleteProductFileStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteProductFileReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -527,15 +605,16 @@ func (fake *FakePivnetClient) DownloadProductFile(arg1 *download.FileInfo, arg2
 		arg4 int
 		arg5 io.Writer
 	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DownloadProductFileStub
+	fakeReturns := fake.downloadProductFileReturns
 	fake.recordInvocation("DownloadProductFile", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.downloadProductFileMutex.Unlock()
-	if fake.DownloadProductFileStub != nil {
-		return fake.DownloadProductFileStub(arg1, arg2, arg3, arg4, arg5)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.downloadProductFileReturns
 	return fakeReturns.result1
 }
 
@@ -581,6 +660,71 @@ func (fake *FakePivnetClient) DownloadProductFileReturnsOnCall(i int, result1 er
 	}{result1}
 }
 
+func (fake *FakePivnetClient) FileGroupsForRelease(arg1 string, arg2 int) ([]pivnet.FileGroup, error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	ret, specificReturn := fake.fileGroupsForReleaseReturnsOnCall[len(fake.fileGroupsForReleaseArgsForCall)]
+	fake.fileGroupsForReleaseArgsForCall = append(fake.fileGroupsForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.FileGroupsForReleaseStub
+	fakeReturns := fake.fileGroupsForReleaseReturns
+	fake.recordInvocation("FileGroupsForRelease", []interface{}{arg1, arg2})
+	fake.fileGroupsForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseCallCount() int {
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	return len(fake.fileGroupsForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseCalls(stub func(string, int) ([]pivnet.FileGroup, error)) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseArgsForCall(i int) (string, int) {
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	argsForCall := fake.fileGroupsForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = nil
+	fake.fileGroupsForReleaseReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = nil
+	if fake.fileGroupsForReleaseReturnsOnCall == nil {
+		fake.fileGroupsForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.fileGroupsForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ProductFile(arg1 string, arg2 int) (pivnet.ProductFile, error) {
 	fake.productFileMutex.Lock()
 	ret, specificReturn := fake.productFileReturnsOnCall[len(fake.productFileArgsForCall)]
@@ -588,15 +732,16 @@ func (fake *FakePivnetClient) ProductFile(arg1 string, arg2 int) (pivnet.Product
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.ProductFileStub
+	fakeReturns := fake.productFileReturns
 	fake.recordInvocation("ProductFile", []interface{}{arg1, arg2})
 	fake.productFileMutex.Unlock()
-	if fake.ProductFileStub != nil {
-		return fake.ProductFileStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.productFileReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -653,15 +798,16 @@ func (fake *FakePivnetClient) ProductFileForRelease(arg1 string, arg2 int, arg3
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.ProductFileForReleaseStub
+	fakeReturns := fake.productFileForReleaseReturns
 	fake.recordInvocation("ProductFileForRelease", []interface{}{arg1, arg2, arg3})
 	fake.productFileForReleaseMutex.Unlock()
-	if fake.ProductFileForReleaseStub != nil {
-		return fake.ProductFileForReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.productFileForReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -716,15 +862,16 @@ func (fake *FakePivnetClient) ProductFiles(arg1 string) ([]pivnet.ProductFile, e
 	fake.productFilesArgsForCall = append(fake.productFilesArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.ProductFilesStub
+	fakeReturns := fake.productFilesReturns
 	fake.recordInvocation("ProductFiles", []interface{}{arg1})
 	fake.productFilesMutex.Unlock()
-	if fake.ProductFilesStub != nil {
-		return fake.ProductFilesStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.productFilesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -780,15 +927,16 @@ func (fake *FakePivnetClient) ProductFilesForRelease(arg1 string, arg2 int) ([]p
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.ProductFilesForReleaseStub
+	fakeReturns := fake.productFilesForReleaseReturns
 	fake.recordInvocation("ProductFilesForRelease", []interface{}{arg1, arg2})
 	fake.productFilesForReleaseMutex.Unlock()
-	if fake.ProductFilesForReleaseStub != nil {
-		return fake.ProductFilesForReleaseStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.productFilesForReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -837,6 +985,136 @@ func (fake *FakePivnetClient) ProductFilesForReleaseReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseDependencies(arg1 string, arg2 int) ([]pivnet.ReleaseDependency, error) {
+	fake.releaseDependenciesMutex.Lock()
+	ret, specificReturn := fake.releaseDependenciesReturnsOnCall[len(fake.releaseDependenciesArgsForCall)]
+	fake.releaseDependenciesArgsForCall = append(fake.releaseDependenciesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseDependenciesStub
+	fakeReturns := fake.releaseDependenciesReturns
+	fake.recordInvocation("ReleaseDependencies", []interface{}{arg1, arg2})
+	fake.releaseDependenciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesCallCount() int {
+	fake.releaseDependenciesMutex.RLock()
+	defer fake.releaseDependenciesMutex.RUnlock()
+	return len(fake.releaseDependenciesArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesCalls(stub func(string, int) ([]pivnet.ReleaseDependency, error)) {
+	fake.releaseDependenciesMutex.Lock()
+	defer fake.releaseDependenciesMutex.Unlock()
+	fake.ReleaseDependenciesStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesArgsForCall(i int) (string, int) {
+	fake.releaseDependenciesMutex.RLock()
+	defer fake.releaseDependenciesMutex.RUnlock()
+	argsForCall := fake.releaseDependenciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesReturns(result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.releaseDependenciesMutex.Lock()
+	defer fake.releaseDependenciesMutex.Unlock()
+	fake.ReleaseDependenciesStub = nil
+	fake.releaseDependenciesReturns = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseDependenciesReturnsOnCall(i int, result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.releaseDependenciesMutex.Lock()
+	defer fake.releaseDependenciesMutex.Unlock()
+	fake.ReleaseDependenciesStub = nil
+	if fake.releaseDependenciesReturnsOnCall == nil {
+		fake.releaseDependenciesReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseDependency
+			result2 error
+		})
+	}
+	fake.releaseDependenciesReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -844,15 +1122,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -901,6 +1180,71 @@ func (fake *FakePivnetClient) ReleaseForVersionReturnsOnCall(i int, result1 pivn
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseUpgradePaths(arg1 string, arg2 int) ([]pivnet.ReleaseUpgradePath, error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	ret, specificReturn := fake.releaseUpgradePathsReturnsOnCall[len(fake.releaseUpgradePathsArgsForCall)]
+	fake.releaseUpgradePathsArgsForCall = append(fake.releaseUpgradePathsArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseUpgradePathsStub
+	fakeReturns := fake.releaseUpgradePathsReturns
+	fake.recordInvocation("ReleaseUpgradePaths", []interface{}{arg1, arg2})
+	fake.releaseUpgradePathsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsCallCount() int {
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	return len(fake.releaseUpgradePathsArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsCalls(stub func(string, int) ([]pivnet.ReleaseUpgradePath, error)) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsArgsForCall(i int) (string, int) {
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	argsForCall := fake.releaseUpgradePathsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsReturns(result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = nil
+	fake.releaseUpgradePathsReturns = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsReturnsOnCall(i int, result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = nil
+	if fake.releaseUpgradePathsReturnsOnCall == nil {
+		fake.releaseUpgradePathsReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseUpgradePath
+			result2 error
+		})
+	}
+	fake.releaseUpgradePathsReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) RemoveProductFileFromFileGroup(arg1 string, arg2 int, arg3 int) error {
 	fake.removeProductFileFromFileGroupMutex.Lock()
 	ret, specificReturn := fake.removeProductFileFromFileGroupReturnsOnCall[len(fake.removeProductFileFromFileGroupArgsForCall)]
@@ -909,15 +1253,16 @@ func (fake *FakePivnetClient) RemoveProductFileFromFileGroup(arg1 string, arg2 i
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveProductFileFromFileGroupStub
+	fakeReturns := fake.removeProductFileFromFileGroupReturns
 	fake.recordInvocation("RemoveProductFileFromFileGroup", []interface{}{arg1, arg2, arg3})
 	fake.removeProductFileFromFileGroupMutex.Unlock()
-	if fake.RemoveProductFileFromFileGroupStub != nil {
-		return fake.RemoveProductFileFromFileGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeProductFileFromFileGroupReturns
 	return fakeReturns.result1
 }
 
@@ -971,15 +1316,16 @@ func (fake *FakePivnetClient) RemoveProductFileFromRelease(arg1 string, arg2 int
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveProductFileFromReleaseStub
+	fakeReturns := fake.removeProductFileFromReleaseReturns
 	fake.recordInvocation("RemoveProductFileFromRelease", []interface{}{arg1, arg2, arg3})
 	fake.removeProductFileFromReleaseMutex.Unlock()
-	if fake.RemoveProductFileFromReleaseStub != nil {
-		return fake.RemoveProductFileFromReleaseStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeProductFileFromReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -1025,6 +1371,73 @@ func (fake *FakePivnetClient) RemoveProductFileFromReleaseReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakePivnetClient) ResumeProductFileDownload(arg1 *download.FileInfo, arg2 string, arg3 int, arg4 int, arg5 int64, arg6 io.Writer, arg7 io.Writer) error {
+	fake.resumeProductFileDownloadMutex.Lock()
+	ret, specificReturn := fake.resumeProductFileDownloadReturnsOnCall[len(fake.resumeProductFileDownloadArgsForCall)]
+	fake.resumeProductFileDownloadArgsForCall = append(fake.resumeProductFileDownloadArgsForCall, struct {
+		arg1 *download.FileInfo
+		arg2 string
+		arg3 int
+		arg4 int
+		arg5 int64
+		arg6 io.Writer
+		arg7 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.ResumeProductFileDownloadStub
+	fakeReturns := fake.resumeProductFileDownloadReturns
+	fake.recordInvocation("ResumeProductFileDownload", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.resumeProductFileDownloadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) ResumeProductFileDownloadCallCount() int {
+	fake.resumeProductFileDownloadMutex.RLock()
+	defer fake.resumeProductFileDownloadMutex.RUnlock()
+	return len(fake.resumeProductFileDownloadArgsForCall)
+}
+
+func (fake *FakePivnetClient) ResumeProductFileDownloadCalls(stub func(*download.FileInfo, string, int, int, int64, io.Writer, io.Writer) error) {
+	fake.resumeProductFileDownloadMutex.Lock()
+	defer fake.resumeProductFileDownloadMutex.Unlock()
+	fake.ResumeProductFileDownloadStub = stub
+}
+
+func (fake *FakePivnetClient) ResumeProductFileDownloadArgsForCall(i int) (*download.FileInfo, string, int, int, int64, io.Writer, io.Writer) {
+	fake.resumeProductFileDownloadMutex.RLock()
+	defer fake.resumeProductFileDownloadMutex.RUnlock()
+	argsForCall := fake.resumeProductFileDownloadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakePivnetClient) ResumeProductFileDownloadReturns(result1 error) {
+	fake.resumeProductFileDownloadMutex.Lock()
+	defer fake.resumeProductFileDownloadMutex.Unlock()
+	fake.ResumeProductFileDownloadStub = nil
+	fake.resumeProductFileDownloadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) ResumeProductFileDownloadReturnsOnCall(i int, result1 error) {
+	fake.resumeProductFileDownloadMutex.Lock()
+	defer fake.resumeProductFileDownloadMutex.Unlock()
+	fake.ResumeProductFileDownloadStub = nil
+	if fake.resumeProductFileDownloadReturnsOnCall == nil {
+		fake.resumeProductFileDownloadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resumeProductFileDownloadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePivnetClient) UpdateProductFile(arg1 string, arg2 pivnet.ProductFile) (pivnet.ProductFile, error) {
 	fake.updateProductFileMutex.Lock()
 	ret, specificReturn := fake.updateProductFileReturnsOnCall[len(fake.updateProductFileArgsForCall)]
@@ -1032,15 +1445,16 @@ func (fake *FakePivnetClient) UpdateProductFile(arg1 string, arg2 pivnet.Product
 		arg1 string
 		arg2 pivnet.ProductFile
 	}{arg1, arg2})
+	stub := fake.UpdateProductFileStub
+	fakeReturns := fake.updateProductFileReturns
 	fake.recordInvocation("UpdateProductFile", []interface{}{arg1, arg2})
 	fake.updateProductFileMutex.Unlock()
-	if fake.UpdateProductFileStub != nil {
-		return fake.UpdateProductFileStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateProductFileReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1104,6 +1518,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.deleteProductFileMutex.RUnlock()
 	fake.downloadProductFileMutex.RLock()
 	defer fake.downloadProductFileMutex.RUnlock()
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
 	fake.productFileMutex.RLock()
 	defer fake.productFileMutex.RUnlock()
 	fake.productFileForReleaseMutex.RLock()
@@ -1112,12 +1528,20 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.productFilesMutex.RUnlock()
 	fake.productFilesForReleaseMutex.RLock()
 	defer fake.productFilesForReleaseMutex.RUnlock()
+	fake.releaseDependenciesMutex.RLock()
+	defer fake.releaseDependenciesMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
 	fake.removeProductFileFromFileGroupMutex.RLock()
 	defer fake.removeProductFileFromFileGroupMutex.RUnlock()
 	fake.removeProductFileFromReleaseMutex.RLock()
 	defer fake.removeProductFileFromReleaseMutex.RUnlock()
+	fake.resumeProductFileDownloadMutex.RLock()
+	defer fake.resumeProductFileDownloadMutex.RUnlock()
 	fake.updateProductFileMutex.RLock()
 	defer fake.updateProductFileMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
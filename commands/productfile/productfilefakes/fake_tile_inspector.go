@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package productfilefakes
+
+import (
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+)
+
+type FakeTileInspector struct {
+	InspectAllStub        func([]string) error
+	inspectAllMutex       sync.RWMutex
+	inspectAllArgsForCall []struct {
+		arg1 []string
+	}
+	inspectAllReturns struct {
+		result1 error
+	}
+	inspectAllReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTileInspector) InspectAll(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.inspectAllMutex.Lock()
+	ret, specificReturn := fake.inspectAllReturnsOnCall[len(fake.inspectAllArgsForCall)]
+	fake.inspectAllArgsForCall = append(fake.inspectAllArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.InspectAllStub
+	fakeReturns := fake.inspectAllReturns
+	fake.recordInvocation("InspectAll", []interface{}{arg1Copy})
+	fake.inspectAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTileInspector) InspectAllCallCount() int {
+	fake.inspectAllMutex.RLock()
+	defer fake.inspectAllMutex.RUnlock()
+	return len(fake.inspectAllArgsForCall)
+}
+
+func (fake *FakeTileInspector) InspectAllCalls(stub func([]string) error) {
+	fake.inspectAllMutex.Lock()
+	defer fake.inspectAllMutex.Unlock()
+	fake.InspectAllStub = stub
+}
+
+func (fake *FakeTileInspector) InspectAllArgsForCall(i int) []string {
+	fake.inspectAllMutex.RLock()
+	defer fake.inspectAllMutex.RUnlock()
+	argsForCall := fake.inspectAllArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTileInspector) InspectAllReturns(result1 error) {
+	fake.inspectAllMutex.Lock()
+	defer fake.inspectAllMutex.Unlock()
+	fake.InspectAllStub = nil
+	fake.inspectAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTileInspector) InspectAllReturnsOnCall(i int, result1 error) {
+	fake.inspectAllMutex.Lock()
+	defer fake.inspectAllMutex.Unlock()
+	fake.InspectAllStub = nil
+	if fake.inspectAllReturnsOnCall == nil {
+		fake.inspectAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.inspectAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTileInspector) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.inspectAllMutex.RLock()
+	defer fake.inspectAllMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTileInspector) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ productfile.TileInspector = new(FakeTileInspector)
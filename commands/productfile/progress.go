@@ -0,0 +1,255 @@
+package productfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+)
+
+// The ways the progress of a download can be reported.
+const (
+	ProgressBar  = "bar"
+	ProgressLog  = "log"
+	ProgressJSON = "json"
+	ProgressNone = "none"
+)
+
+// progressStep is the percentage of a file between progress reports when
+// they are logged or written as JSON.
+const progressStep = 10
+
+const (
+	eventStarted   = "started"
+	eventProgress  = "progress"
+	eventVerified  = "verified"
+	eventFailed    = "failed"
+	eventCompleted = "completed"
+)
+
+// progressEvent is written as a line of JSON for each step of downloading a
+// product file when the progress is reported as JSON.
+type progressEvent struct {
+	Event          string    `json:"event"`
+	Time           time.Time `json:"time"`
+	ProductFileID  int       `json:"product_file_id"`
+	Name           string    `json:"name"`
+	BytesDone      int64     `json:"bytes_done"`
+	BytesTotal     int64     `json:"bytes_total"`
+	BytesPerSecond int64     `json:"bytes_per_second"`
+	LocalPath      string    `json:"local_path,omitempty"`
+	Skipped        bool      `json:"skipped,omitempty"`
+	Cached         bool      `json:"cached,omitempty"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// fileProgress reports the progress of downloading one product file.
+type fileProgress interface {
+	// writer returns the progress writer to pass to the Pivnet client.
+	writer() io.Writer
+
+	verified()
+	failed(err error)
+	completed(localPath string, outcome downloadOutcome)
+}
+
+// newFileProgress returns the progress for pf in the given format, writing
+// to w. mutex keeps the lines of files downloading in parallel apart.
+func newFileProgress(
+	format string,
+	w io.Writer,
+	mutex *sync.Mutex,
+	pf pivnet.ProductFile,
+	parallel bool,
+) fileProgress {
+	switch format {
+	case ProgressLog, ProgressJSON, ProgressNone:
+		return newProgressReporter(format, w, mutex, pf)
+	}
+
+	if parallel {
+		return barProgress{w: newFileProgressWriter(w, mutex, fileNameFor(pf))}
+	}
+	return barProgress{w: w}
+}
+
+// barProgress passes the progress bar drawn by the Pivnet client through to
+// the writer. The steps after the transfer are already logged, so it does
+// not report them.
+type barProgress struct {
+	w io.Writer
+}
+
+func (b barProgress) writer() io.Writer {
+	return b.w
+}
+
+func (barProgress) verified() {}
+
+func (barProgress) failed(err error) {}
+
+func (barProgress) completed(localPath string, outcome downloadOutcome) {}
+
+// progressReporter is given the progress of a download as numbers by the
+// Pivnet client, rather than a progress bar to draw. It logs a line, or
+// writes a JSON event, each time another progressStep percent of the file
+// has been downloaded.
+type progressReporter struct {
+	format string
+	w      io.Writer
+	mutex  *sync.Mutex
+	pf     pivnet.ProductFile
+
+	state       sync.Mutex
+	startedAt   time.Time
+	startedDone int64
+	done        int64
+	total       int64
+	nextPercent int64
+}
+
+func newProgressReporter(format string, w io.Writer, mutex *sync.Mutex, pf pivnet.ProductFile) *progressReporter {
+	return &progressReporter{
+		format: format,
+		w:      w,
+		mutex:  mutex,
+		pf:     pf,
+		total:  int64(pf.Size),
+	}
+}
+
+func (r *progressReporter) writer() io.Writer {
+	return r
+}
+
+// Write discards the output of the Pivnet client, which reports to
+// StartProgress and Progress instead.
+func (r *progressReporter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (r *progressReporter) StartProgress(done int64, total int64) {
+	r.state.Lock()
+	defer r.state.Unlock()
+
+	r.startedAt = time.Now()
+	r.startedDone = done
+	r.done = done
+	r.total = total
+	r.nextPercent = r.percent()/progressStep*progressStep + progressStep
+
+	r.emit(progressEvent{Event: eventStarted})
+}
+
+func (r *progressReporter) Progress(done int64) {
+	r.state.Lock()
+	defer r.state.Unlock()
+
+	r.done = done
+	if r.total <= 0 {
+		return
+	}
+
+	percent := r.percent()
+	if percent < r.nextPercent {
+		return
+	}
+	r.nextPercent = percent/progressStep*progressStep + progressStep
+
+	r.emit(progressEvent{Event: eventProgress})
+}
+
+func (r *progressReporter) verified() {
+	r.state.Lock()
+	defer r.state.Unlock()
+
+	r.emit(progressEvent{Event: eventVerified})
+}
+
+func (r *progressReporter) failed(err error) {
+	r.state.Lock()
+	defer r.state.Unlock()
+
+	r.emit(progressEvent{Event: eventFailed, Error: err.Error()})
+}
+
+func (r *progressReporter) completed(localPath string, outcome downloadOutcome) {
+	r.state.Lock()
+	defer r.state.Unlock()
+
+	if outcome != outcomeDownloaded {
+		r.done = r.total
+	}
+
+	r.emit(progressEvent{
+		Event:     eventCompleted,
+		LocalPath: localPath,
+		Skipped:   outcome == outcomeSkipped,
+		Cached:    outcome == outcomeCached,
+	})
+}
+
+func (r *progressReporter) percent() int64 {
+	if r.total <= 0 {
+		return 0
+	}
+	return r.done * 100 / r.total
+}
+
+// bytesPerSecond is the average rate since the transfer started, not
+// counting bytes that were already on disk.
+func (r *progressReporter) bytesPerSecond() int64 {
+	if r.startedAt.IsZero() {
+		return 0
+	}
+
+	elapsed := time.Since(r.startedAt).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return int64(float64(r.done-r.startedDone) / elapsed)
+}
+
+// emit fills in event from the current state and writes it. Only progress
+// is logged in the log format; the other steps are logged already.
+func (r *progressReporter) emit(event progressEvent) {
+	event.Time = time.Now().UTC()
+	event.ProductFileID = r.pf.ID
+	event.Name = r.pf.Name
+	event.BytesDone = r.done
+	event.BytesTotal = r.total
+	event.BytesPerSecond = r.bytesPerSecond()
+
+	var line string
+	switch r.format {
+	case ProgressJSON:
+		b, err := json.Marshal(event)
+		if err != nil {
+			return
+		}
+		line = string(b)
+	case ProgressLog:
+		if event.Event != eventProgress {
+			return
+		}
+		line = fmt.Sprintf(
+			"%s: %d%% (%s of %s) at %s/s",
+			fileNameFor(r.pf),
+			r.percent(),
+			formatBytes(uint64(event.BytesDone)),
+			formatBytes(uint64(event.BytesTotal)),
+			formatBytes(uint64(event.BytesPerSecond)),
+		)
+	default:
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, _ = fmt.Fprintln(r.w, line)
+}
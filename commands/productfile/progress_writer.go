@@ -0,0 +1,42 @@
+package productfile
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// fileProgressWriter turns the carriage-return refreshes of a progress bar
+// into one line per refresh, prefixed with the file name. This keeps the
+// output readable when several files are downloading at the same time.
+type fileProgressWriter struct {
+	w      io.Writer
+	mutex  *sync.Mutex
+	prefix string
+}
+
+func newFileProgressWriter(w io.Writer, mutex *sync.Mutex, fileName string) *fileProgressWriter {
+	return &fileProgressWriter{
+		w:      w,
+		mutex:  mutex,
+		prefix: fileName,
+	}
+}
+
+func (f *fileProgressWriter) Write(p []byte) (int, error) {
+	line := strings.TrimSpace(strings.Trim(string(p), "\r\n"))
+	if line == "" {
+		return len(p), nil
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	_, err := fmt.Fprintf(f.w, "%s: %s\n", f.prefix, line)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
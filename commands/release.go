@@ -1,10 +1,15 @@
 package commands
 
-import "github.com/pivotal-cf/pivnet-cli/v3/commands/release"
+import (
+	"fmt"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/release"
+)
 
 type ReleasesCommand struct {
 	ProductSlug string `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
 	Limit       string `long:"limit" short:"l" description:"Limit the number of returned releases to the most recent"`
+	Sort        string `long:"sort" description:"Sort releases, e.g. by semver from the highest version" choice:"semver"`
 }
 
 type ReleaseCommand struct {
@@ -13,10 +18,23 @@ type ReleaseCommand struct {
 }
 
 type UpdateReleaseCommand struct {
-	ProductSlug    string  `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
-	ReleaseVersion string  `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1" required:"true"`
-	Availability   *string `long:"availability" description:"Release availability. Optional." choice:"admins" choice:"selected-user-groups" choice:"all"`
-	ReleaseType    *string `long:"release-type" description:"Release type. Optional." choice:"all-in-one" choice:"major" choice:"minor" choice:"service" choice:"maintenance" choice:"security" choice:"alpha" choice:"beta" choice:"edge"`
+	ProductSlug           string  `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
+	ReleaseVersion        string  `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1" required:"true"`
+	Availability          *string `long:"availability" description:"Release availability. Optional." choice:"admins" choice:"selected-user-groups" choice:"all"`
+	ReleaseType           *string `long:"release-type" description:"Release type. Optional." choice:"all-in-one" choice:"major" choice:"minor" choice:"service" choice:"maintenance" choice:"security" choice:"alpha" choice:"beta" choice:"edge"`
+	NewReleaseVersion     *string `long:"new-release-version" description:"New release version e.g. 0.1.2. Optional."`
+	EULASlug              *string `long:"eula-slug" short:"e" description:"EULA slug e.g. pivotal_software_eula. Optional."`
+	ReleaseDate           *string `long:"release-date" description:"Release date in YYYY-MM-DD format e.g. 2020-09-15. Optional."`
+	Description           *string `long:"description" description:"Release description. Optional."`
+	ReleaseNotesURL       *string `long:"release-notes-url" description:"Release notes URL e.g. https://docs.pivotal.io/p-mysql/release-notes.html. Optional."`
+	Controlled            bool    `long:"controlled" description:"Mark the release as subject to export controls. Optional."`
+	ECCN                  *string `long:"eccn" description:"Export Control Classification Number e.g. 5D002. Optional."`
+	LicenseException      *string `long:"license-exception" description:"Export license exception e.g. ENC. Optional."`
+	EndOfSupportDate      *string `long:"end-of-support-date" description:"End of support date in YYYY-MM-DD format e.g. 2021-09-30. Optional."`
+	EndOfGuidanceDate     *string `long:"end-of-guidance-date" description:"End of technical guidance date in YYYY-MM-DD format e.g. 2022-03-31. Optional."`
+	EndOfAvailabilityDate *string `long:"end-of-availability-date" description:"End of availability date in YYYY-MM-DD format e.g. 2022-09-30. Optional."`
+	Patch                 string  `long:"patch" description:"Path to a JSON merge patch to apply to the release before the other flags e.g. ./patch.json. Optional."`
+	Diff                  bool    `long:"diff" description:"Print the fields that would change without updating the release"`
 }
 
 type DeleteReleaseCommand struct {
@@ -25,19 +43,32 @@ type DeleteReleaseCommand struct {
 }
 
 type CreateReleaseCommand struct {
-	ProductSlug    string `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
-	ReleaseVersion string `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1" required:"true"`
-	ReleaseType    string `long:"release-type" short:"t" description:"Release type e.g. 'Minor Release'" required:"true"`
-	EULASlug       string `long:"eula-slug" short:"e" description:"EULA slug e.g. pivotal_software_eula" required:"true"`
+	ProductSlug           string `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql (Required unless set in --from-file)"`
+	ReleaseVersion        string `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1 (Required unless set in --from-file)"`
+	ReleaseType           string `long:"release-type" short:"t" description:"Release type e.g. 'Minor Release' (Required unless set in --from-file)"`
+	EULASlug              string `long:"eula-slug" short:"e" description:"EULA slug e.g. pivotal_software_eula (Required unless set in --from-file)"`
+	ReleaseDate           string `long:"release-date" description:"Release date in YYYY-MM-DD format (default: today) e.g. 2020-09-15"`
+	Description           string `long:"description" description:"Release description"`
+	ReleaseNotesURL       string `long:"release-notes-url" description:"Release notes URL e.g. https://docs.pivotal.io/p-mysql/release-notes.html"`
+	Availability          string `long:"availability" description:"Release availability (default: admins)" choice:"admins" choice:"selected-user-groups" choice:"all"`
+	Controlled            bool   `long:"controlled" description:"Mark the release as subject to export controls"`
+	ECCN                  string `long:"eccn" description:"Export Control Classification Number e.g. 5D002"`
+	LicenseException      string `long:"license-exception" description:"Export license exception e.g. ENC"`
+	EndOfSupportDate      string `long:"end-of-support-date" description:"End of support date in YYYY-MM-DD format e.g. 2021-09-30"`
+	EndOfGuidanceDate     string `long:"end-of-guidance-date" description:"End of technical guidance date in YYYY-MM-DD format e.g. 2022-03-31"`
+	EndOfAvailabilityDate string `long:"end-of-availability-date" description:"End of availability date in YYYY-MM-DD format e.g. 2022-09-30"`
+	CopyMetadata          bool   `long:"copy-metadata" description:"Copy the product files, file groups, dependencies and upgrade paths of the previous release"`
+	FromFile              string `long:"from-file" description:"Path to a JSON or YAML file with the attributes of the release, which flags override e.g. ./release.yml"`
 }
 
 //go:generate counterfeiter . ReleaseClient
 type ReleaseClient interface {
 	List(productSlug string) error
 	ListWithLimit(productSlug string, limit string) error
+	ListSortedBySemver(productSlug string, limit string) error
 	Get(productSlug string, releaseVersion string) error
-	Create(productSlug string, releaseVersion string, releaseType string, eulaSlug string) error
-	Update(productSlug string, releaseVersion string, availability *string, releaseType *string) error
+	Create(attributes release.Attributes) error
+	Update(productSlug string, releaseVersion string, changes release.Changes, patchPath string, diffOnly bool) error
 	Delete(productSlug string, releaseVersion string) error
 }
 
@@ -63,6 +94,10 @@ func (command *ReleasesCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.Sort == "semver" {
+		return NewReleaseClient(client).ListSortedBySemver(command.ProductSlug, command.Limit)
+	}
+
 	if command.Limit != "" {
 		return NewReleaseClient(client).ListWithLimit(command.ProductSlug, command.Limit)
 	}
@@ -85,7 +120,12 @@ func (command *ReleaseCommand) Execute([]string) error {
 }
 
 func (command *CreateReleaseCommand) Execute([]string) error {
-	err := Init(true)
+	attributes, err := command.attributes()
+	if err != nil {
+		return err
+	}
+
+	err = Init(true)
 	if err != nil {
 		return err
 	}
@@ -96,12 +136,63 @@ func (command *CreateReleaseCommand) Execute([]string) error {
 		return err
 	}
 
-	return NewReleaseClient(client).Create(
-		command.ProductSlug,
-		command.ReleaseVersion,
-		command.ReleaseType,
-		command.EULASlug,
-	)
+	return NewReleaseClient(client).Create(attributes)
+}
+
+// attributes reads --from-file, if it is provided, and sets the flags that
+// are provided over it.
+func (command *CreateReleaseCommand) attributes() (release.Attributes, error) {
+	var attributes release.Attributes
+
+	if command.FromFile != "" {
+		var err error
+		attributes, err = release.ReadAttributes(command.FromFile)
+		if err != nil {
+			return release.Attributes{}, err
+		}
+	}
+
+	flags := []struct {
+		value string
+		field *string
+	}{
+		{command.ProductSlug, &attributes.ProductSlug},
+		{command.ReleaseVersion, &attributes.Version},
+		{command.ReleaseType, &attributes.ReleaseType},
+		{command.EULASlug, &attributes.EULASlug},
+		{command.ReleaseDate, &attributes.ReleaseDate},
+		{command.Description, &attributes.Description},
+		{command.ReleaseNotesURL, &attributes.ReleaseNotesURL},
+		{command.Availability, &attributes.Availability},
+		{command.ECCN, &attributes.ECCN},
+		{command.LicenseException, &attributes.LicenseException},
+		{command.EndOfSupportDate, &attributes.EndOfSupportDate},
+		{command.EndOfGuidanceDate, &attributes.EndOfGuidanceDate},
+		{command.EndOfAvailabilityDate, &attributes.EndOfAvailabilityDate},
+	}
+
+	for _, f := range flags {
+		if f.value != "" {
+			*f.field = f.value
+		}
+	}
+
+	if command.Controlled {
+		attributes.Controlled = true
+	}
+
+	if command.CopyMetadata {
+		attributes.CopyMetadata = true
+	}
+
+	if attributes.ProductSlug == "" || attributes.Version == "" ||
+		attributes.ReleaseType == "" || attributes.EULASlug == "" {
+		return release.Attributes{}, fmt.Errorf(
+			"--product-slug, --release-version, --release-type and --eula-slug are required unless set in --from-file",
+		)
+	}
+
+	return attributes, nil
 }
 
 func (command *UpdateReleaseCommand) Execute([]string) error {
@@ -116,11 +207,31 @@ func (command *UpdateReleaseCommand) Execute([]string) error {
 		return err
 	}
 
+	changes := release.Changes{
+		Version:               command.NewReleaseVersion,
+		ReleaseType:           command.ReleaseType,
+		EULASlug:              command.EULASlug,
+		ReleaseDate:           command.ReleaseDate,
+		Description:           command.Description,
+		ReleaseNotesURL:       command.ReleaseNotesURL,
+		Availability:          command.Availability,
+		ECCN:                  command.ECCN,
+		LicenseException:      command.LicenseException,
+		EndOfSupportDate:      command.EndOfSupportDate,
+		EndOfGuidanceDate:     command.EndOfGuidanceDate,
+		EndOfAvailabilityDate: command.EndOfAvailabilityDate,
+	}
+
+	if command.Controlled {
+		changes.Controlled = &command.Controlled
+	}
+
 	return NewReleaseClient(client).Update(
 		command.ProductSlug,
 		command.ReleaseVersion,
-		command.Availability,
-		command.ReleaseType,
+		changes,
+		command.Patch,
+		command.Diff,
 	)
 }
 
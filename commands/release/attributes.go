@@ -0,0 +1,101 @@
+package release
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// dateFormat is the format of the dates of a release.
+const dateFormat = "2006-01-02"
+
+// Attributes are everything a release can be created with, e.g.
+//
+//	product_slug: p-mysql
+//	version: 2.10.3
+//	release_type: Minor Release
+//	eula_slug: vmware_eula
+//	release_date: 2020-09-15
+//	availability: All Users
+//	end_of_support_date: 2021-09-30
+//
+// Availability is one of the values Pivnet shows, e.g. 'All Users', or one of
+// admins, selected-user-groups and all.
+type Attributes struct {
+	ProductSlug           string `yaml:"product_slug"`
+	Version               string `yaml:"version"`
+	ReleaseType           string `yaml:"release_type"`
+	EULASlug              string `yaml:"eula_slug"`
+	ReleaseDate           string `yaml:"release_date"`
+	Description           string `yaml:"description"`
+	ReleaseNotesURL       string `yaml:"release_notes_url"`
+	Availability          string `yaml:"availability"`
+	Controlled            bool   `yaml:"controlled"`
+	ECCN                  string `yaml:"eccn"`
+	LicenseException      string `yaml:"license_exception"`
+	EndOfSupportDate      string `yaml:"end_of_support_date"`
+	EndOfGuidanceDate     string `yaml:"end_of_guidance_date"`
+	EndOfAvailabilityDate string `yaml:"end_of_availability_date"`
+	CopyMetadata          bool   `yaml:"copy_metadata"`
+}
+
+// ReadAttributes reads the attributes in the JSON or YAML file at path.
+// Unknown keys are an error so that a misspelt attribute is not silently
+// left unset.
+func ReadAttributes(path string) (Attributes, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Attributes{}, err
+	}
+
+	// JSON is YAML, so one parser reads both.
+	var attributes Attributes
+	err = yaml.UnmarshalStrict(b, &attributes)
+	if err != nil {
+		return Attributes{}, fmt.Errorf("could not parse %s: %s", path, err)
+	}
+
+	return attributes, nil
+}
+
+// validateDates checks that the dates that are set are in YYYY-MM-DD format,
+// so that a typo is caught before anything is created.
+func (a Attributes) validateDates() error {
+	dates := []struct {
+		name  string
+		value string
+	}{
+		{"release date", a.ReleaseDate},
+		{"end of support date", a.EndOfSupportDate},
+		{"end of guidance date", a.EndOfGuidanceDate},
+		{"end of availability date", a.EndOfAvailabilityDate},
+	}
+
+	for _, d := range dates {
+		if d.value == "" {
+			continue
+		}
+
+		err := validateDate(d.name, d.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateDate(name string, value string) error {
+	_, err := time.Parse(dateFormat, value)
+	if err != nil {
+		return fmt.Errorf(
+			"%s '%s' must be a date in YYYY-MM-DD format e.g. 2020-09-15",
+			name,
+			value,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package release_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/release"
+)
+
+var _ = Describe("ReadAttributes", func() {
+	var (
+		tempDir string
+		path    string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		path = filepath.Join(tempDir, "release.yml")
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reads YAML", func() {
+		err := ioutil.WriteFile(path, []byte(`---
+product_slug: p-mysql
+version: 2.10.3
+release_type: Minor Release
+eula_slug: vmware_eula
+availability: All Users
+controlled: true
+eccn: 5D002
+end_of_support_date: 2021-09-30
+`), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		attributes, err := release.ReadAttributes(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(attributes).To(Equal(release.Attributes{
+			ProductSlug:      "p-mysql",
+			Version:          "2.10.3",
+			ReleaseType:      "Minor Release",
+			EULASlug:         "vmware_eula",
+			Availability:     "All Users",
+			Controlled:       true,
+			ECCN:             "5D002",
+			EndOfSupportDate: "2021-09-30",
+		}))
+	})
+
+	It("reads JSON", func() {
+		err := ioutil.WriteFile(path, []byte(`{"product_slug": "p-mysql", "version": "2.10.3", "copy_metadata": true}`), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		attributes, err := release.ReadAttributes(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(attributes).To(Equal(release.Attributes{
+			ProductSlug:  "p-mysql",
+			Version:      "2.10.3",
+			CopyMetadata: true,
+		}))
+	})
+
+	Context("when the file contains an unknown key", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(path, []byte("end_of_suport_date: 2021-09-30\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error", func() {
+			_, err := release.ReadAttributes(path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("end_of_suport_date"))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		It("returns an error", func() {
+			_, err := release.ReadAttributes(filepath.Join(tempDir, "missing.yml"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
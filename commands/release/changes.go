@@ -0,0 +1,150 @@
+package release
+
+import (
+	"fmt"
+	"strconv"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+)
+
+// Changes are the attributes to change on a release. Attributes that are nil
+// are not changed. Availability and ReleaseType take the values of the
+// --availability and --release-type flags.
+type Changes struct {
+	Version               *string
+	ReleaseType           *string
+	EULASlug              *string
+	ReleaseDate           *string
+	Description           *string
+	ReleaseNotesURL       *string
+	Availability          *string
+	Controlled            *bool
+	ECCN                  *string
+	LicenseException      *string
+	EndOfSupportDate      *string
+	EndOfGuidanceDate     *string
+	EndOfAvailabilityDate *string
+}
+
+// FieldChange is a change to a single attribute of a release.
+type FieldChange struct {
+	Field   string `json:"field" yaml:"field"`
+	Current string `json:"current" yaml:"current"`
+	New     string `json:"new" yaml:"new"`
+}
+
+// releaseField is a writable attribute of a release, named as it is printed
+// with --format=json.
+type releaseField struct {
+	name   string
+	get    func(pivnet.Release) string
+	isDate bool
+	isBool bool
+}
+
+var releaseFields = []releaseField{
+	{name: "version", get: func(r pivnet.Release) string { return r.Version }},
+	{name: "release_type", get: func(r pivnet.Release) string { return string(r.ReleaseType) }},
+	{name: "eula_slug", get: eulaSlugOf},
+	{name: "release_date", get: func(r pivnet.Release) string { return r.ReleaseDate }, isDate: true},
+	{name: "description", get: func(r pivnet.Release) string { return r.Description }},
+	{name: "release_notes_url", get: func(r pivnet.Release) string { return r.ReleaseNotesURL }},
+	{name: "availability", get: func(r pivnet.Release) string { return r.Availability }},
+	{name: "controlled", get: func(r pivnet.Release) string { return strconv.FormatBool(r.Controlled) }, isBool: true},
+	{name: "eccn", get: func(r pivnet.Release) string { return r.ECCN }},
+	{name: "license_exception", get: func(r pivnet.Release) string { return r.LicenseException }},
+	{name: "end_of_support_date", get: func(r pivnet.Release) string { return r.EndOfSupportDate }, isDate: true},
+	{name: "end_of_guidance_date", get: func(r pivnet.Release) string { return r.EndOfGuidanceDate }, isDate: true},
+	{name: "end_of_availability_date", get: func(r pivnet.Release) string { return r.EndOfAvailabilityDate }, isDate: true},
+}
+
+// apply sets the attributes of changes on release.
+func (c Changes) apply(release pivnet.Release) (pivnet.Release, error) {
+	fields := []struct {
+		value *string
+		field *string
+	}{
+		{c.Version, &release.Version},
+		{c.ReleaseDate, &release.ReleaseDate},
+		{c.Description, &release.Description},
+		{c.ReleaseNotesURL, &release.ReleaseNotesURL},
+		{c.ECCN, &release.ECCN},
+		{c.LicenseException, &release.LicenseException},
+		{c.EndOfSupportDate, &release.EndOfSupportDate},
+		{c.EndOfGuidanceDate, &release.EndOfGuidanceDate},
+		{c.EndOfAvailabilityDate, &release.EndOfAvailabilityDate},
+	}
+
+	for _, f := range fields {
+		if f.value != nil {
+			*f.field = *f.value
+		}
+	}
+
+	if c.EULASlug != nil && *c.EULASlug != eulaSlugOf(release) {
+		release.EULA = &pivnet.EULA{Slug: *c.EULASlug}
+	}
+
+	if c.Availability != nil {
+		a, err := convertAvailability(*c.Availability)
+		if err != nil {
+			return pivnet.Release{}, err
+		}
+
+		release.Availability = a
+	}
+
+	if c.ReleaseType != nil {
+		rt, err := convertReleaseType(*c.ReleaseType)
+		if err != nil {
+			return pivnet.Release{}, err
+		}
+
+		release.ReleaseType = rt
+	}
+
+	if c.Controlled != nil {
+		release.Controlled = *c.Controlled
+	}
+
+	return release, nil
+}
+
+// diff returns the attributes that differ between current and updated. It
+// fails for changes that Pivnet would not make: it leaves out attributes
+// that are empty or false, so they cannot be cleared. Dates that change
+// must be in YYYY-MM-DD format.
+func diff(current pivnet.Release, updated pivnet.Release) ([]FieldChange, error) {
+	var changes []FieldChange
+
+	for _, f := range releaseFields {
+		currentValue, newValue := f.get(current), f.get(updated)
+		if currentValue == newValue {
+			continue
+		}
+
+		if newValue == "" || (f.isBool && newValue == "false") {
+			return nil, fmt.Errorf(
+				"%s cannot be changed from '%s' to '%s' because Pivnet does not accept empty values",
+				f.name,
+				currentValue,
+				newValue,
+			)
+		}
+
+		if f.isDate {
+			err := validateDate(f.name, newValue)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		changes = append(changes, FieldChange{
+			Field:   f.name,
+			Current: currentValue,
+			New:     newValue,
+		})
+	}
+
+	return changes, nil
+}
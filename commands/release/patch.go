@@ -0,0 +1,125 @@
+package release
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+)
+
+// writableKeys are the keys of a release, as it is printed with
+// --format=json, that a patch can change.
+var writableKeys = []string{
+	"availability",
+	"controlled",
+	"description",
+	"eccn",
+	"end_of_availability_date",
+	"end_of_guidance_date",
+	"end_of_support_date",
+	"eula",
+	"license_exception",
+	"release_date",
+	"release_notes_url",
+	"release_type",
+	"version",
+}
+
+// applyPatchFile applies the JSON merge patch (RFC 7386) in the file at path
+// to release. Keys that are not writable are an error rather than being
+// silently dropped.
+func applyPatchFile(release pivnet.Release, path string) (pivnet.Release, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return pivnet.Release{}, err
+	}
+
+	var patch map[string]interface{}
+	err = json.Unmarshal(b, &patch)
+	if err != nil {
+		return pivnet.Release{}, fmt.Errorf("%s is not a JSON merge patch of a release: %s", path, err)
+	}
+
+	var invalidKeys []string
+	for key := range patch {
+		if !stringsContains(writableKeys, key) {
+			invalidKeys = append(invalidKeys, key)
+		}
+	}
+
+	if len(invalidKeys) > 0 {
+		sort.Strings(invalidKeys)
+		return pivnet.Release{}, fmt.Errorf(
+			"%s cannot change %s (the keys that can be changed are: %s)",
+			path,
+			strings.Join(invalidKeys, ", "),
+			strings.Join(writableKeys, ", "),
+		)
+	}
+
+	b, err = json.Marshal(release)
+	if err != nil {
+		return pivnet.Release{}, err
+	}
+
+	var document interface{}
+	err = json.Unmarshal(b, &document)
+	if err != nil {
+		return pivnet.Release{}, err
+	}
+
+	b, err = json.Marshal(mergePatch(document, patch))
+	if err != nil {
+		return pivnet.Release{}, err
+	}
+
+	var patched pivnet.Release
+	err = json.Unmarshal(b, &patched)
+	if err != nil {
+		return pivnet.Release{}, fmt.Errorf("%s does not patch the release into a valid release: %s", path, err)
+	}
+
+	// Pivnet identifies the EULA by its ID when there is one, so a changed
+	// slug would otherwise be ignored.
+	if eulaSlugOf(patched) != eulaSlugOf(release) {
+		patched.EULA = &pivnet.EULA{Slug: eulaSlugOf(patched)}
+	}
+
+	return patched, nil
+}
+
+// mergePatch applies patch to target as RFC 7386 describes: objects are
+// merged recursively, null removes a key and anything else replaces it.
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
+
+func eulaSlugOf(release pivnet.Release) string {
+	if release.EULA == nil {
+		return ""
+	}
+
+	return release.EULA.Slug
+}
@@ -3,12 +3,14 @@ package release
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 
 	"github.com/olekukonko/tablewriter"
 	pivnet "github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
 	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+	"github.com/pivotal-cf/pivnet-cli/v3/semver"
 	"github.com/pivotal-cf/pivnet-cli/v3/ui"
 )
 
@@ -16,6 +18,7 @@ import (
 type PivnetClient interface {
 	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	CreateRelease(config pivnet.CreateReleaseConfig) (pivnet.Release, error)
 	UpdateRelease(productSlug string, release pivnet.Release) (pivnet.Release, error)
 	DeleteRelease(productSlug string, release pivnet.Release) error
@@ -65,6 +68,49 @@ func (c *ReleaseClient) ListWithLimit(productSlug string, limit string) error {
 	return c.printReleases(releases)
 }
 
+// ListSortedBySemver lists releases from the highest version to the lowest.
+// Releases whose version cannot be parsed are listed last. If limit is not
+// empty only that many releases are listed.
+func (c *ReleaseClient) ListSortedBySemver(productSlug string, limit string) error {
+	releases, err := c.pivnetClient.ReleasesForProductSlug(productSlug)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	sortReleasesBySemver(releases)
+
+	if limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			return c.eh.HandleError(fmt.Errorf("invalid limit: '%s'", limit))
+		}
+
+		if n < len(releases) {
+			releases = releases[:n]
+		}
+	}
+
+	return c.printReleases(releases)
+}
+
+func sortReleasesBySemver(releases []pivnet.Release) {
+	versions := make(map[int]*semver.Version, len(releases))
+	for _, r := range releases {
+		v, err := semver.ParseLenient(r.Version)
+		if err == nil {
+			versions[r.ID] = &v
+		}
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		vi, vj := versions[releases[i].ID], versions[releases[j].ID]
+		if vi == nil || vj == nil {
+			return vi != nil
+		}
+		return vi.Compare(*vj) > 0
+	})
+}
+
 func (c *ReleaseClient) printReleases(releases []pivnet.Release) error {
 	switch c.format {
 
@@ -101,7 +147,7 @@ func (c *ReleaseClient) Get(
 	productSlug string,
 	releaseVersion string,
 ) error {
-	release, err := c.pivnetClient.ReleaseForVersion(
+	release, err := c.pivnetClient.ReleaseForConstraint(
 		productSlug,
 		releaseVersion,
 	)
@@ -145,27 +191,48 @@ func (c *ReleaseClient) printRelease(release pivnet.Release) error {
 	return nil
 }
 
-func (c *ReleaseClient) Create(
-	productSlug string,
-	releaseVersion string,
-	releaseType string,
-	eulaSlug string,
-) error {
-	err := c.validateEULA(eulaSlug)
+// Create creates a release with attributes. Pivnet creates every release for
+// admins only, so any other availability is set by updating the release
+// once it is created.
+func (c *ReleaseClient) Create(attributes Attributes) error {
+	err := attributes.validateDates()
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	var availability string
+	if attributes.Availability != "" {
+		availability, err = normalizeAvailability(attributes.Availability)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+	}
+
+	err = c.validateEULA(attributes.EULASlug)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
 
-	err = c.validateReleaseType(releaseType)
+	err = c.validateReleaseType(attributes.ReleaseType)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
 
 	newReleaseConfig := pivnet.CreateReleaseConfig{
-		ProductSlug: productSlug,
-		Version:     releaseVersion,
-		ReleaseType: releaseType,
-		EULASlug:    eulaSlug,
+		ProductSlug:           attributes.ProductSlug,
+		Version:               attributes.Version,
+		ReleaseType:           attributes.ReleaseType,
+		EULASlug:              attributes.EULASlug,
+		ReleaseDate:           attributes.ReleaseDate,
+		Description:           attributes.Description,
+		ReleaseNotesURL:       attributes.ReleaseNotesURL,
+		Controlled:            attributes.Controlled,
+		ECCN:                  attributes.ECCN,
+		LicenseException:      attributes.LicenseException,
+		EndOfSupportDate:      attributes.EndOfSupportDate,
+		EndOfGuidanceDate:     attributes.EndOfGuidanceDate,
+		EndOfAvailabilityDate: attributes.EndOfAvailabilityDate,
+		CopyMetadata:          attributes.CopyMetadata,
 	}
 
 	release, err := c.pivnetClient.CreateRelease(newReleaseConfig)
@@ -173,14 +240,32 @@ func (c *ReleaseClient) Create(
 		return c.eh.HandleError(err)
 	}
 
+	if availability != "" && availability != release.Availability {
+		release.Availability = availability
+
+		release, err = c.pivnetClient.UpdateRelease(attributes.ProductSlug, release)
+		if err != nil {
+			err = fmt.Errorf(
+				"release %s was created but its availability could not be set: %s",
+				attributes.Version,
+				err,
+			)
+			return c.eh.HandleError(err)
+		}
+	}
+
 	return c.printRelease(release)
 }
 
+// Update changes a release by applying the JSON merge patch at patchPath, if
+// it is not empty, and then changes on top of it. With diffOnly the
+// attributes that would change are printed instead.
 func (c *ReleaseClient) Update(
 	productSlug string,
 	releaseVersion string,
-	availability *string,
-	releaseType *string,
+	changes Changes,
+	patchPath string,
+	diffOnly bool,
 ) error {
 	release, err := c.pivnetClient.ReleaseForVersion(
 		productSlug,
@@ -190,25 +275,50 @@ func (c *ReleaseClient) Update(
 		return c.eh.HandleError(err)
 	}
 
-	if availability != nil {
-		a, err := convertAvailability(*availability)
+	updated := release
+
+	if patchPath != "" {
+		updated, err = applyPatchFile(updated, patchPath)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+	}
+
+	updated, err = changes.apply(updated)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	if updated.Availability != "" && updated.Availability != release.Availability {
+		updated.Availability, err = normalizeAvailability(updated.Availability)
 		if err != nil {
 			return c.eh.HandleError(err)
 		}
+	}
 
-		release.Availability = a
+	fieldChanges, err := diff(release, updated)
+	if err != nil {
+		return c.eh.HandleError(err)
 	}
 
-	if releaseType != nil {
-		rt, err := convertReleaseType(*releaseType)
+	for _, fc := range fieldChanges {
+		switch fc.Field {
+		case "release_type":
+			err = c.validateReleaseType(fc.New)
+		case "eula_slug":
+			err = c.validateEULA(fc.New)
+		}
+
 		if err != nil {
 			return c.eh.HandleError(err)
 		}
+	}
 
-		release.ReleaseType = rt
+	if diffOnly {
+		return c.printDiff(productSlug, release, fieldChanges)
 	}
 
-	release, err = c.pivnetClient.UpdateRelease(productSlug, release)
+	release, err = c.pivnetClient.UpdateRelease(productSlug, updated)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -216,6 +326,56 @@ func (c *ReleaseClient) Update(
 	return c.printRelease(release)
 }
 
+func (c *ReleaseClient) printDiff(
+	productSlug string,
+	release pivnet.Release,
+	fieldChanges []FieldChange,
+) error {
+	if fieldChanges == nil {
+		fieldChanges = []FieldChange{}
+	}
+
+	switch c.format {
+
+	case printer.PrintAsTable:
+		if len(fieldChanges) == 0 {
+			message := fmt.Sprintf(
+				"No changes to release %s for %s",
+				release.Version,
+				productSlug,
+			)
+			coloredMessage := ui.SuccessColor.SprintFunc()(message)
+
+			_, err := fmt.Fprintln(c.outputWriter, coloredMessage)
+
+			return err
+		}
+
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"Field",
+			"Current",
+			"New",
+		})
+
+		for _, fc := range fieldChanges {
+			table.Append([]string{
+				fc.Field,
+				fc.Current,
+				fc.New,
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(fieldChanges)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(fieldChanges)
+	}
+
+	return nil
+}
+
 func (c *ReleaseClient) Delete(productSlug string, releaseVersion string) error {
 	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
 	if err != nil {
@@ -326,6 +486,17 @@ func convertAvailability(in string) (string, error) {
 	}
 }
 
+// normalizeAvailability accepts an availability as Pivnet shows it as well as
+// the values of --availability.
+func normalizeAvailability(in string) (string, error) {
+	switch in {
+	case "Admins Only", "Selected User Groups Only", "All Users":
+		return in, nil
+	}
+
+	return convertAvailability(in)
+}
+
 func convertReleaseType(in string) (pivnet.ReleaseType, error) {
 	switch in {
 	case "all-in-one":
@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -136,6 +140,88 @@ var _ = Describe("release commands", func() {
 		})
 	})
 
+	Describe("ListSortedBySemver", func() {
+		var (
+			productSlug string
+		)
+
+		BeforeEach(func() {
+			productSlug = "some-product-slug"
+
+			fakePivnetClient.ReleasesForProductSlugReturns([]pivnet.Release{
+				{ID: 1, Version: "1.2.0"},
+				{ID: 2, Version: "1.10.0"},
+				{ID: 3, Version: "not-a-version"},
+				{ID: 4, Version: "1.10.0-rc.2"},
+				{ID: 5, Version: "1.10.0-rc.10"},
+				{ID: 6, Version: "1.9.3"},
+			}, nil)
+		})
+
+		It("lists releases from the highest version to the lowest", func() {
+			err := client.ListSortedBySemver(productSlug, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			slug, params := fakePivnetClient.ReleasesForProductSlugArgsForCall(0)
+			Expect(slug).To(Equal(productSlug))
+			Expect(params).To(BeEmpty())
+
+			var returnedReleases []pivnet.Release
+			err = json.Unmarshal(outBuffer.Bytes(), &returnedReleases)
+			Expect(err).NotTo(HaveOccurred())
+
+			var ids []int
+			for _, r := range returnedReleases {
+				ids = append(ids, r.ID)
+			}
+			Expect(ids).To(Equal([]int{2, 5, 4, 6, 1, 3}))
+		})
+
+		Context("when a limit is provided", func() {
+			It("lists only the highest versions", func() {
+				err := client.ListSortedBySemver(productSlug, "2")
+				Expect(err).NotTo(HaveOccurred())
+
+				var returnedReleases []pivnet.Release
+				err = json.Unmarshal(outBuffer.Bytes(), &returnedReleases)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(returnedReleases).To(HaveLen(2))
+				Expect(returnedReleases[0].ID).To(Equal(2))
+				Expect(returnedReleases[1].ID).To(Equal(5))
+			})
+		})
+
+		Context("when the limit is not a number", func() {
+			It("invokes the error handler", func() {
+				err := client.ListSortedBySemver(productSlug, "some-limit")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("invalid limit"))
+			})
+		})
+
+		Context("when there is an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("releases error")
+				fakePivnetClient.ReleasesForProductSlugReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				err := client.ListSortedBySemver(productSlug, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+			})
+		})
+	})
+
 	Describe("Get", func() {
 		var (
 			productSlug    string
@@ -146,7 +232,7 @@ var _ = Describe("release commands", func() {
 			productSlug = "some-product-slug"
 			releaseVersion = "some-release-version"
 
-			fakePivnetClient.ReleaseForVersionReturns(releases[0], nil)
+			fakePivnetClient.ReleaseForConstraintReturns(releases[0], nil)
 		})
 
 		It("gets Release", func() {
@@ -167,7 +253,7 @@ var _ = Describe("release commands", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("release error")
-				fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{}, expectedErr)
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
 			})
 
 			It("invokes the error handler", func() {
@@ -187,6 +273,8 @@ var _ = Describe("release commands", func() {
 			releaseType    string
 			eulaSlug       string
 
+			attributes release.Attributes
+
 			validEULAs        []pivnet.EULA
 			validReleaseTypes []pivnet.ReleaseType
 		)
@@ -215,16 +303,150 @@ var _ = Describe("release commands", func() {
 			fakePivnetClient.CreateReleaseReturns(releases[0], nil)
 			fakePivnetClient.EULAsReturns(validEULAs, nil)
 			fakePivnetClient.ReleaseTypesReturns(validReleaseTypes, nil)
+
+			attributes = release.Attributes{
+				ProductSlug: productSlug,
+				Version:     releaseVersion,
+				ReleaseType: releaseType,
+				EULASlug:    eulaSlug,
+			}
 		})
 
 		It("creates Release", func() {
-			err := client.Create(
-				productSlug,
-				releaseVersion,
-				releaseType,
-				eulaSlug,
-			)
+			err := client.Create(attributes)
 			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(1))
+			Expect(fakePivnetClient.CreateReleaseArgsForCall(0)).To(Equal(pivnet.CreateReleaseConfig{
+				ProductSlug: productSlug,
+				Version:     releaseVersion,
+				ReleaseType: releaseType,
+				EULASlug:    eulaSlug,
+			}))
+
+			Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+		})
+
+		Context("when every attribute is set", func() {
+			BeforeEach(func() {
+				attributes.ReleaseDate = "2020-09-15"
+				attributes.Description = "some-description"
+				attributes.ReleaseNotesURL = "some-release-notes-url"
+				attributes.Controlled = true
+				attributes.ECCN = "5D002"
+				attributes.LicenseException = "ENC"
+				attributes.EndOfSupportDate = "2021-09-30"
+				attributes.EndOfGuidanceDate = "2022-03-31"
+				attributes.EndOfAvailabilityDate = "2022-09-30"
+				attributes.CopyMetadata = true
+			})
+
+			It("creates Release with all of them", func() {
+				err := client.Create(attributes)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.CreateReleaseArgsForCall(0)).To(Equal(pivnet.CreateReleaseConfig{
+					ProductSlug:           productSlug,
+					Version:               releaseVersion,
+					ReleaseType:           releaseType,
+					EULASlug:              eulaSlug,
+					ReleaseDate:           "2020-09-15",
+					Description:           "some-description",
+					ReleaseNotesURL:       "some-release-notes-url",
+					Controlled:            true,
+					ECCN:                  "5D002",
+					LicenseException:      "ENC",
+					EndOfSupportDate:      "2021-09-30",
+					EndOfGuidanceDate:     "2022-03-31",
+					EndOfAvailabilityDate: "2022-09-30",
+					CopyMetadata:          true,
+				}))
+			})
+		})
+
+		Context("when a date is not in YYYY-MM-DD format", func() {
+			BeforeEach(func() {
+				attributes.EndOfSupportDate = "09/30/2021"
+			})
+
+			It("invokes the error handler without creating Release", func() {
+				err := client.Create(attributes)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.EULAsCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(Equal(
+					"end of support date '09/30/2021' must be a date in YYYY-MM-DD format e.g. 2020-09-15",
+				))
+			})
+		})
+
+		Context("when availability is set", func() {
+			BeforeEach(func() {
+				attributes.Availability = "all"
+
+				created := releases[0]
+				created.Availability = "Admins Only"
+				fakePivnetClient.CreateReleaseReturns(created, nil)
+				fakePivnetClient.UpdateReleaseStub = func(productSlug string, release pivnet.Release) (pivnet.Release, error) {
+					return release, nil
+				}
+			})
+
+			It("updates the availability of the created Release", func() {
+				err := client.Create(attributes)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(1))
+				invokedProductSlug, invokedRelease := fakePivnetClient.UpdateReleaseArgsForCall(0)
+				Expect(invokedProductSlug).To(Equal(productSlug))
+				Expect(invokedRelease.ID).To(Equal(releases[0].ID))
+				Expect(invokedRelease.Availability).To(Equal("All Users"))
+			})
+
+			Context("when it is the availability Pivnet shows", func() {
+				BeforeEach(func() {
+					attributes.Availability = "Selected User Groups Only"
+				})
+
+				It("updates the availability of the created Release", func() {
+					err := client.Create(attributes)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, invokedRelease := fakePivnetClient.UpdateReleaseArgsForCall(0)
+					Expect(invokedRelease.Availability).To(Equal("Selected User Groups Only"))
+				})
+			})
+
+			Context("when it is not valid", func() {
+				BeforeEach(func() {
+					attributes.Availability = "everyone"
+				})
+
+				It("invokes the error handler without creating Release", func() {
+					err := client.Create(attributes)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when updating the availability returns an error", func() {
+				BeforeEach(func() {
+					fakePivnetClient.UpdateReleaseReturns(pivnet.Release{}, errors.New("update error"))
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Create(attributes)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("was created but its availability could not be set: update error"))
+				})
+			})
 		})
 
 		Context("when there is an error", func() {
@@ -238,12 +460,7 @@ var _ = Describe("release commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Create(
-					productSlug,
-					releaseVersion,
-					releaseType,
-					eulaSlug,
-				)
+				err := client.Create(attributes)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
@@ -262,12 +479,7 @@ var _ = Describe("release commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Create(
-					productSlug,
-					releaseVersion,
-					releaseType,
-					eulaSlug,
-				)
+				err := client.Create(attributes)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
@@ -286,12 +498,7 @@ var _ = Describe("release commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Create(
-					productSlug,
-					releaseVersion,
-					releaseType,
-					eulaSlug,
-				)
+				err := client.Create(attributes)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
@@ -314,12 +521,7 @@ var _ = Describe("release commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Create(
-					productSlug,
-					releaseVersion,
-					releaseType,
-					eulaSlug,
-				)
+				err := client.Create(attributes)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
@@ -341,12 +543,7 @@ var _ = Describe("release commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Create(
-					productSlug,
-					releaseVersion,
-					releaseType,
-					eulaSlug,
-				)
+				err := client.Create(attributes)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
@@ -365,12 +562,7 @@ var _ = Describe("release commands", func() {
 			})
 
 			It("invokes the error handler", func() {
-				err := client.Create(
-					productSlug,
-					releaseVersion,
-					releaseType,
-					eulaSlug,
-				)
+				err := client.Create(attributes)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
@@ -389,6 +581,8 @@ var _ = Describe("release commands", func() {
 			releaseVersion string
 			availability   *string
 			releaseType    *string
+			patchPath      string
+			diffOnly       bool
 		)
 
 		BeforeEach(func() {
@@ -396,17 +590,32 @@ var _ = Describe("release commands", func() {
 			releaseVersion = releases[0].Version
 			availability = nil
 			releaseType = nil
+			patchPath = ""
+			diffOnly = false
 
 			fakePivnetClient.ReleaseForVersionReturns(releases[0], nil)
 			fakePivnetClient.UpdateReleaseReturns(releases[0], nil)
+			fakePivnetClient.EULAsReturns([]pivnet.EULA{{Slug: "some-eula-slug"}}, nil)
+			fakePivnetClient.ReleaseTypesReturns([]pivnet.ReleaseType{
+				"All-In-One",
+				"Major Release",
+				"Minor Release",
+				"Service Release",
+				"Maintenance Release",
+				"Security Release",
+				"Alpha Release",
+				"Beta Release",
+				"Edge Release",
+			}, nil)
 		})
 
 		It("updates Release", func() {
 			err := client.Update(
 				productSlug,
 				releaseVersion,
-				availability,
-				releaseType,
+				release.Changes{Availability: availability, ReleaseType: releaseType},
+				patchPath,
+				diffOnly,
 			)
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -425,8 +634,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -449,8 +659,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -473,8 +684,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -490,8 +702,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -507,8 +720,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -527,8 +741,9 @@ var _ = Describe("release commands", func() {
 					err := client.Update(
 						productSlug,
 						releaseVersion,
-						availability,
-						releaseType,
+						release.Changes{Availability: availability, ReleaseType: releaseType},
+						patchPath,
+						diffOnly,
 					)
 					Expect(err).NotTo(HaveOccurred())
 
@@ -552,8 +767,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -569,8 +785,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -586,8 +803,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -603,8 +821,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -620,8 +839,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -637,8 +857,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -654,8 +875,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -671,8 +893,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -688,8 +911,9 @@ var _ = Describe("release commands", func() {
 				err := client.Update(
 					productSlug,
 					releaseVersion,
-					availability,
-					releaseType,
+					release.Changes{Availability: availability, ReleaseType: releaseType},
+					patchPath,
+					diffOnly,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -708,8 +932,9 @@ var _ = Describe("release commands", func() {
 					err := client.Update(
 						productSlug,
 						releaseVersion,
-						availability,
-						releaseType,
+						release.Changes{Availability: availability, ReleaseType: releaseType},
+						patchPath,
+						diffOnly,
 					)
 					Expect(err).NotTo(HaveOccurred())
 
@@ -718,6 +943,270 @@ var _ = Describe("release commands", func() {
 				})
 			})
 		})
+
+		Context("when other attributes are provided", func() {
+			var (
+				changes release.Changes
+			)
+
+			BeforeEach(func() {
+				fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{
+					ID:          1234,
+					Version:     "1.2.3",
+					EULA:        &pivnet.EULA{ID: 5, Slug: "some-other-eula-slug"},
+					Description: "some-description",
+				}, nil)
+
+				newVersion := "1.2.4"
+				eulaSlug := "some-eula-slug"
+				description := "some-new-description"
+				endOfSupportDate := "2021-09-30"
+				controlled := true
+
+				changes = release.Changes{
+					Version:          &newVersion,
+					EULASlug:         &eulaSlug,
+					Description:      &description,
+					EndOfSupportDate: &endOfSupportDate,
+					Controlled:       &controlled,
+				}
+			})
+
+			It("sets them on release", func() {
+				err := client.Update(productSlug, releaseVersion, changes, patchPath, diffOnly)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(1))
+				_, providedRelease := fakePivnetClient.UpdateReleaseArgsForCall(0)
+				Expect(providedRelease).To(Equal(pivnet.Release{
+					ID:               1234,
+					Version:          "1.2.4",
+					EULA:             &pivnet.EULA{Slug: "some-eula-slug"},
+					Description:      "some-new-description",
+					EndOfSupportDate: "2021-09-30",
+					Controlled:       true,
+				}))
+			})
+
+			Context("when the EULA is not valid", func() {
+				BeforeEach(func() {
+					eulaSlug := "a-different-eula-slug"
+					changes.EULASlug = &eulaSlug
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, changes, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("a-different-eula-slug"))
+				})
+			})
+
+			Context("when a date is not in YYYY-MM-DD format", func() {
+				BeforeEach(func() {
+					endOfSupportDate := "next year"
+					changes.EndOfSupportDate = &endOfSupportDate
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, changes, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(Equal(
+						"end_of_support_date 'next year' must be a date in YYYY-MM-DD format e.g. 2020-09-15",
+					))
+				})
+			})
+
+			Context("when an attribute would be cleared", func() {
+				BeforeEach(func() {
+					description := ""
+					changes.Description = &description
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, changes, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("description cannot be changed"))
+				})
+			})
+
+			Context("when diff is requested", func() {
+				BeforeEach(func() {
+					diffOnly = true
+				})
+
+				It("prints the fields that would change without updating release", func() {
+					err := client.Update(productSlug, releaseVersion, changes, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+
+					var fieldChanges []release.FieldChange
+					err = json.Unmarshal(outBuffer.Bytes(), &fieldChanges)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fieldChanges).To(Equal([]release.FieldChange{
+						{Field: "version", Current: "1.2.3", New: "1.2.4"},
+						{Field: "eula_slug", Current: "some-other-eula-slug", New: "some-eula-slug"},
+						{Field: "description", Current: "some-description", New: "some-new-description"},
+						{Field: "controlled", Current: "false", New: "true"},
+						{Field: "end_of_support_date", Current: "", New: "2021-09-30"},
+					}))
+				})
+
+				Context("when nothing would change", func() {
+					BeforeEach(func() {
+						changes = release.Changes{}
+					})
+
+					It("prints no changes", func() {
+						err := client.Update(productSlug, releaseVersion, changes, patchPath, diffOnly)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(outBuffer.String()).To(MatchJSON("[]"))
+					})
+				})
+			})
+		})
+
+		Context("when a patch is provided", func() {
+			var (
+				tempDir string
+				patch   string
+			)
+
+			BeforeEach(func() {
+				var err error
+				tempDir, err = ioutil.TempDir("", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				patchPath = filepath.Join(tempDir, "patch.json")
+
+				fakePivnetClient.ReleaseForVersionReturns(pivnet.Release{
+					ID:           1234,
+					Version:      "1.2.3",
+					Availability: "Admins Only",
+					Description:  "some-description",
+					EULA:         &pivnet.EULA{ID: 5, Slug: "some-other-eula-slug"},
+				}, nil)
+
+				patch = `{
+  "description": "some-patched-description",
+  "availability": "All Users",
+  "release_notes_url": "some-release-notes-url",
+  "eula": {"slug": "some-eula-slug"}
+}`
+			})
+
+			JustBeforeEach(func() {
+				err := ioutil.WriteFile(patchPath, []byte(patch), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := os.RemoveAll(tempDir)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("applies it to the release before updating it", func() {
+				err := client.Update(productSlug, releaseVersion, release.Changes{}, patchPath, diffOnly)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(1))
+				_, providedRelease := fakePivnetClient.UpdateReleaseArgsForCall(0)
+				Expect(providedRelease).To(Equal(pivnet.Release{
+					ID:              1234,
+					Version:         "1.2.3",
+					Availability:    "All Users",
+					Description:     "some-patched-description",
+					ReleaseNotesURL: "some-release-notes-url",
+					EULA:            &pivnet.EULA{Slug: "some-eula-slug"},
+				}))
+			})
+
+			It("is overridden by other attributes", func() {
+				description := "some-flag-description"
+
+				err := client.Update(productSlug, releaseVersion, release.Changes{Description: &description}, patchPath, diffOnly)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, providedRelease := fakePivnetClient.UpdateReleaseArgsForCall(0)
+				Expect(providedRelease.Description).To(Equal("some-flag-description"))
+				Expect(providedRelease.ReleaseNotesURL).To(Equal("some-release-notes-url"))
+			})
+
+			Context("when the patch removes an attribute", func() {
+				BeforeEach(func() {
+					patch = `{"description": null}`
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, release.Changes{}, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("description cannot be changed from 'some-description' to ''"))
+				})
+			})
+
+			Context("when the patch changes a key that is not writable", func() {
+				BeforeEach(func() {
+					patch = `{"id": 5678, "descripton": "typo"}`
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, release.Changes{}, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("cannot change descripton, id"))
+				})
+			})
+
+			Context("when the patch sets an unknown availability", func() {
+				BeforeEach(func() {
+					patch = `{"availability": "Everyone"}`
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, release.Changes{}, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("Everyone"))
+				})
+			})
+
+			Context("when the patch is not valid JSON", func() {
+				BeforeEach(func() {
+					patch = `description: some-description`
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Update(productSlug, releaseVersion, release.Changes{}, patchPath, diffOnly)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("is not a JSON merge patch"))
+				})
+			})
+		})
 	})
 
 	Describe("Delete", func() {
@@ -776,5 +1265,30 @@ var _ = Describe("release commands", func() {
 				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
 			})
 		})
+
+		Context("when the release version is a constraint", func() {
+			BeforeEach(func() {
+				releaseVersion = "~2.10"
+
+				fakePivnetClient.ReleaseForConstraintReturns(releases[0], nil)
+				fakePivnetClient.ReleaseForVersionReturns(
+					pivnet.Release{},
+					fmt.Errorf("release not found for version: '%s'", releaseVersion),
+				)
+			})
+
+			It("does not resolve the constraint and does not delete a release", func() {
+				err := client.Delete(productSlug, releaseVersion)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.ReleaseForConstraintCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.ReleaseForVersionCallCount()).To(Equal(1))
+				Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(0))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"release not found for version: '~2.10'"))
+			})
+		})
 	})
 })
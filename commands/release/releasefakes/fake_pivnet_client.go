@@ -46,6 +46,20 @@ type FakePivnetClient struct {
 		result1 []pivnet.EULA
 		result2 error
 	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -110,15 +124,16 @@ func (fake *FakePivnetClient) CreateRelease(arg1 pivnet.CreateReleaseConfig) (pi
 	fake.createReleaseArgsForCall = append(fake.createReleaseArgsForCall, struct {
 		arg1 pivnet.CreateReleaseConfig
 	}{arg1})
+	stub := fake.CreateReleaseStub
+	fakeReturns := fake.createReleaseReturns
 	fake.recordInvocation("CreateRelease", []interface{}{arg1})
 	fake.createReleaseMutex.Unlock()
-	if fake.CreateReleaseStub != nil {
-		return fake.CreateReleaseStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -174,15 +189,16 @@ func (fake *FakePivnetClient) DeleteRelease(arg1 string, arg2 pivnet.Release) er
 		arg1 string
 		arg2 pivnet.Release
 	}{arg1, arg2})
+	stub := fake.DeleteReleaseStub
+	fakeReturns := fake.deleteReleaseReturns
 	fake.recordInvocation("DeleteRelease", []interface{}{arg1, arg2})
 	fake.deleteReleaseMutex.Unlock()
-	if fake.DeleteReleaseStub != nil {
-		return fake.DeleteReleaseStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteReleaseReturns
 	return fakeReturns.result1
 }
 
@@ -233,15 +249,16 @@ func (fake *FakePivnetClient) EULAs() ([]pivnet.EULA, error) {
 	ret, specificReturn := fake.eULAsReturnsOnCall[len(fake.eULAsArgsForCall)]
 	fake.eULAsArgsForCall = append(fake.eULAsArgsForCall, struct {
 	}{})
+	stub := fake.EULAsStub
+	fakeReturns := fake.eULAsReturns
 	fake.recordInvocation("EULAs", []interface{}{})
 	fake.eULAsMutex.Unlock()
-	if fake.EULAsStub != nil {
-		return fake.EULAsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.eULAsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -283,6 +300,71 @@ func (fake *FakePivnetClient) EULAsReturnsOnCall(i int, result1 []pivnet.EULA, r
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -290,15 +372,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -352,15 +435,16 @@ func (fake *FakePivnetClient) ReleaseTypes() ([]pivnet.ReleaseType, error) {
 	ret, specificReturn := fake.releaseTypesReturnsOnCall[len(fake.releaseTypesArgsForCall)]
 	fake.releaseTypesArgsForCall = append(fake.releaseTypesArgsForCall, struct {
 	}{})
+	stub := fake.ReleaseTypesStub
+	fakeReturns := fake.releaseTypesReturns
 	fake.recordInvocation("ReleaseTypes", []interface{}{})
 	fake.releaseTypesMutex.Unlock()
-	if fake.ReleaseTypesStub != nil {
-		return fake.ReleaseTypesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseTypesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -409,15 +493,16 @@ func (fake *FakePivnetClient) ReleasesForProductSlug(arg1 string, arg2 ...pivnet
 		arg1 string
 		arg2 []pivnet.QueryParameter
 	}{arg1, arg2})
+	stub := fake.ReleasesForProductSlugStub
+	fakeReturns := fake.releasesForProductSlugReturns
 	fake.recordInvocation("ReleasesForProductSlug", []interface{}{arg1, arg2})
 	fake.releasesForProductSlugMutex.Unlock()
-	if fake.ReleasesForProductSlugStub != nil {
-		return fake.ReleasesForProductSlugStub(arg1, arg2...)
+	if stub != nil {
+		return stub(arg1, arg2...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releasesForProductSlugReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -473,15 +558,16 @@ func (fake *FakePivnetClient) UpdateRelease(arg1 string, arg2 pivnet.Release) (p
 		arg1 string
 		arg2 pivnet.Release
 	}{arg1, arg2})
+	stub := fake.UpdateReleaseStub
+	fakeReturns := fake.updateReleaseReturns
 	fake.recordInvocation("UpdateRelease", []interface{}{arg1, arg2})
 	fake.updateReleaseMutex.Unlock()
-	if fake.UpdateReleaseStub != nil {
-		return fake.UpdateReleaseStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -539,6 +625,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.deleteReleaseMutex.RUnlock()
 	fake.eULAsMutex.RLock()
 	defer fake.eULAsMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
 	fake.releaseTypesMutex.RLock()
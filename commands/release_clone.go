@@ -0,0 +1,53 @@
+package commands
+
+import "github.com/pivotal-cf/pivnet-cli/v3/commands/releaseclone"
+
+type CloneReleaseCommand struct {
+	ProductSlug           string   `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
+	From                  string   `long:"from" description:"Version of the release to clone, or a constraint e.g. 0.1.2 or ~0.1" required:"true"`
+	To                    string   `long:"to" description:"Version of the new release e.g. 0.1.3" required:"true"`
+	AddUpgradePath        bool     `long:"add-upgrade-path" description:"Add the cloned release as an upgrade path of the new release"`
+	ExcludeProductFileIDs []int    `long:"exclude-product-file-id" description:"Product file ID to leave out of the new release e.g. 1234"`
+	ExcludeGlobs          []string `long:"exclude-glob" description:"Glob to match product files to leave out of the new release e.g. *.pivotal"`
+}
+
+//go:generate counterfeiter . ReleaseCloneClient
+type ReleaseCloneClient interface {
+	Clone(productSlug string, fromVersion string, toVersion string, options releaseclone.CloneOptions) error
+}
+
+var NewReleaseCloneClient = func(client releaseclone.PivnetClient) ReleaseCloneClient {
+	return releaseclone.NewReleaseCloneClient(
+		client,
+		Filter,
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+		Pivnet.Logger,
+	)
+}
+
+func (command *CloneReleaseCommand) Execute([]string) error {
+	err := Init(true)
+	if err != nil {
+		return err
+	}
+
+	client := NewPivnetClient()
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	return NewReleaseCloneClient(client).Clone(
+		command.ProductSlug,
+		command.From,
+		command.To,
+		releaseclone.CloneOptions{
+			AddUpgradePath:        command.AddUpgradePath,
+			ExcludeProductFileIDs: command.ExcludeProductFileIDs,
+			ExcludeGlobs:          command.ExcludeGlobs,
+		},
+	)
+}
@@ -0,0 +1,193 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseclone"
+)
+
+var _ = Describe("release clone commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeReleaseCloneClient *commandsfakes.FakeReleaseCloneClient
+	)
+
+	BeforeEach(func() {
+		fakeReleaseCloneClient = &commandsfakes.FakeReleaseCloneClient{}
+
+		commands.NewReleaseCloneClient = func(releaseclone.PivnetClient) commands.ReleaseCloneClient {
+			return fakeReleaseCloneClient
+		}
+	})
+
+	Describe("CloneReleaseCommand", func() {
+		var (
+			cmd *commands.CloneReleaseCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.CloneReleaseCommand{
+				ProductSlug:           "some-product-slug",
+				From:                  "1.2.3",
+				To:                    "1.2.4",
+				AddUpgradePath:        true,
+				ExcludeProductFileIDs: []int{1234},
+				ExcludeGlobs:          []string{"*.pivotal"},
+			}
+		})
+
+		It("invokes the release clone client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeReleaseCloneClient.CloneCallCount()).To(Equal(1))
+
+			productSlug, fromVersion, toVersion, options := fakeReleaseCloneClient.CloneArgsForCall(0)
+			Expect(productSlug).To(Equal("some-product-slug"))
+			Expect(fromVersion).To(Equal("1.2.3"))
+			Expect(toVersion).To(Equal("1.2.4"))
+			Expect(options).To(Equal(releaseclone.CloneOptions{
+				AddUpgradePath:        true,
+				ExcludeProductFileIDs: []int{1234},
+				ExcludeGlobs:          []string{"*.pivotal"},
+			}))
+		})
+
+		Context("when the release clone client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeReleaseCloneClient.CloneReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+			})
+		})
+
+		Describe("ProductSlug flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CloneReleaseCommand{}, "ProductSlug")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("product-slug"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("p"))
+			})
+		})
+
+		Describe("From flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CloneReleaseCommand{}, "From")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("from"))
+			})
+		})
+
+		Describe("To flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CloneReleaseCommand{}, "To")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("to"))
+			})
+		})
+
+		Describe("AddUpgradePath flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CloneReleaseCommand{}, "AddUpgradePath")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("add-upgrade-path"))
+			})
+		})
+
+		Describe("ExcludeProductFileIDs flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CloneReleaseCommand{}, "ExcludeProductFileIDs")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("exclude-product-file-id"))
+			})
+		})
+
+		Describe("ExcludeGlobs flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CloneReleaseCommand{}, "ExcludeGlobs")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("exclude-glob"))
+			})
+		})
+	})
+})
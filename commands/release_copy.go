@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releasecopy"
+)
+
+type CopyReleaseCommand struct {
+	ProductSlug    string `long:"product-slug" short:"p" description:"Product slug e.g. p-mysql" required:"true"`
+	ReleaseVersion string `long:"release-version" short:"r" description:"Release version e.g. 0.1.2-rc1" required:"true"`
+	FromProfile    string `long:"from-profile" description:"Name of the profile of the host to copy the release from e.g. staging" required:"true"`
+	ToProfile      string `long:"to-profile" description:"Name of the profile of the host to copy the release to e.g. default" required:"true"`
+}
+
+//go:generate counterfeiter . ReleaseCopyClient
+type ReleaseCopyClient interface {
+	Copy(productSlug string, releaseVersion string) error
+}
+
+var NewReleaseCopyClient = func(source releasecopy.PivnetClient, target releasecopy.PivnetClient) ReleaseCopyClient {
+	return releasecopy.NewReleaseCopyClient(
+		source,
+		target,
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+		Pivnet.Logger,
+	)
+}
+
+func (command *CopyReleaseCommand) Execute([]string) error {
+	err := Init(false)
+	if err != nil {
+		return err
+	}
+
+	if command.FromProfile == command.ToProfile {
+		err := fmt.Errorf("--from-profile and --to-profile must be different profiles")
+		return ErrorHandler.HandleError(err)
+	}
+
+	source, err := newPivnetClientForProfile(command.FromProfile)
+	if err != nil {
+		return ErrorHandler.HandleError(err)
+	}
+
+	err = Auth.AuthenticateClient(source)
+	if err != nil {
+		return err
+	}
+
+	target, err := newPivnetClientForProfile(command.ToProfile)
+	if err != nil {
+		return ErrorHandler.HandleError(err)
+	}
+
+	err = Auth.AuthenticateClient(target)
+	if err != nil {
+		return err
+	}
+
+	return NewReleaseCopyClient(source, target).Copy(
+		command.ProductSlug,
+		command.ReleaseVersion,
+	)
+}
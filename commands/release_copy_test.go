@@ -0,0 +1,327 @@
+package commands_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releasecopy"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/rc"
+)
+
+var _ = Describe("release copy commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeReleaseCopyClient *commandsfakes.FakeReleaseCopyClient
+		fakeRCHandler         *commandsfakes.FakeRCHandler
+		fakeErrorHandler      *errorhandlerfakes.FakeErrorHandler
+
+		origRC           commands.RCHandler
+		origErrorHandler errorhandler.ErrorHandler
+
+		profiles map[string]*rc.PivnetProfile
+	)
+
+	BeforeEach(func() {
+		fakeReleaseCopyClient = &commandsfakes.FakeReleaseCopyClient{}
+		fakeRCHandler = &commandsfakes.FakeRCHandler{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		origRC = commands.RC
+		origErrorHandler = commands.ErrorHandler
+
+		commands.RC = fakeRCHandler
+		commands.ErrorHandler = fakeErrorHandler
+
+		profiles = map[string]*rc.PivnetProfile{
+			"staging": {
+				Name:     "staging",
+				APIToken: "some-staging-api-token",
+				Host:     "https://pivnet-staging.example.com",
+			},
+			"prod": {
+				Name:     "prod",
+				APIToken: "some-prod-api-token",
+				Host:     "https://network.tanzu.vmware.com",
+			},
+		}
+
+		fakeRCHandler.ProfileForNameStub = func(profileName string) (*rc.PivnetProfile, error) {
+			return profiles[profileName], nil
+		}
+
+		commands.NewReleaseCopyClient = func(source releasecopy.PivnetClient, target releasecopy.PivnetClient) commands.ReleaseCopyClient {
+			return fakeReleaseCopyClient
+		}
+	})
+
+	AfterEach(func() {
+		commands.RC = origRC
+		commands.ErrorHandler = origErrorHandler
+	})
+
+	Describe("CopyReleaseCommand", func() {
+		var (
+			cmd *commands.CopyReleaseCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.CopyReleaseCommand{
+				ProductSlug:    "some-product-slug",
+				ReleaseVersion: "1.2.3",
+				FromProfile:    "staging",
+				ToProfile:      "prod",
+			}
+		})
+
+		It("invokes the release copy client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(initInvocationArg).To(BeFalse())
+
+			Expect(fakeRCHandler.ProfileForNameCallCount()).To(Equal(2))
+			Expect(fakeRCHandler.ProfileForNameArgsForCall(0)).To(Equal("staging"))
+			Expect(fakeRCHandler.ProfileForNameArgsForCall(1)).To(Equal("prod"))
+
+			Expect(fakeAuthenticator.AuthenticateClientCallCount()).To(Equal(2))
+
+			Expect(fakeReleaseCopyClient.CopyCallCount()).To(Equal(1))
+
+			productSlug, releaseVersion := fakeReleaseCopyClient.CopyArgsForCall(0)
+			Expect(productSlug).To(Equal("some-product-slug"))
+			Expect(releaseVersion).To(Equal("1.2.3"))
+		})
+
+		Context("when the output is verbose", func() {
+			var (
+				logBuffer bytes.Buffer
+
+				origLogWriter io.Writer
+			)
+
+			BeforeEach(func() {
+				origLogWriter = commands.LogWriter
+
+				logBuffer = bytes.Buffer{}
+				commands.LogWriter = &logBuffer
+				commands.Pivnet.Verbose = true
+				commands.Pivnet.Logger = logshim.NewLogShim(
+					log.New(commands.LogWriter, "", 0),
+					log.New(commands.LogWriter, "", 0),
+					true,
+				)
+
+				fakeReleaseCopyClient.CopyStub = func(string, string) error {
+					commands.Pivnet.Logger.Debug("Using api tokens some-staging-api-token and some-prod-api-token")
+					return nil
+				}
+			})
+
+			AfterEach(func() {
+				commands.LogWriter = origLogWriter
+				commands.Pivnet.Verbose = false
+				commands.Pivnet.Logger = nil
+			})
+
+			It("redacts the api tokens of both profiles from the log", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logBuffer.String()).To(ContainSubstring("*** redacted api token ***"))
+				Expect(logBuffer.String()).NotTo(ContainSubstring("some-staging-api-token"))
+				Expect(logBuffer.String()).NotTo(ContainSubstring("some-prod-api-token"))
+			})
+		})
+
+		Context("when the release copy client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeReleaseCopyClient.CopyReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when the profiles are the same", func() {
+			BeforeEach(func() {
+				cmd.ToProfile = "staging"
+			})
+
+			It("invokes the error handler", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"--from-profile and --to-profile must be different profiles"))
+
+				Expect(fakeReleaseCopyClient.CopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a profile does not exist", func() {
+			BeforeEach(func() {
+				cmd.ToProfile = "other"
+			})
+
+			It("invokes the error handler", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"Please login with --profile other first"))
+
+				Expect(fakeReleaseCopyClient.CopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a profile is invalid", func() {
+			BeforeEach(func() {
+				profiles["staging"].APIToken = ""
+			})
+
+			It("invokes the error handler", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring(
+					"Saved profile staging is invalid"))
+
+				Expect(fakeReleaseCopyClient.CopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting a profile returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("profile error")
+				fakeRCHandler.ProfileForNameStub = nil
+				fakeRCHandler.ProfileForNameReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+
+				Expect(fakeReleaseCopyClient.CopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Describe("ProductSlug flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CopyReleaseCommand{}, "ProductSlug")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("product-slug"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("p"))
+			})
+		})
+
+		Describe("ReleaseVersion flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CopyReleaseCommand{}, "ReleaseVersion")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("release-version"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("r"))
+			})
+		})
+
+		Describe("FromProfile flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CopyReleaseCommand{}, "FromProfile")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("from-profile"))
+			})
+		})
+
+		Describe("ToProfile flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.CopyReleaseCommand{}, "ToProfile")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("to-profile"))
+			})
+		})
+	})
+})
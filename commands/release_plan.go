@@ -0,0 +1,58 @@
+package commands
+
+import "github.com/pivotal-cf/pivnet-cli/v3/commands/releaseplan"
+
+type PlanReleaseCommand struct {
+	File string `long:"file" short:"f" description:"Path to a YAML file describing the desired state of the release e.g. ./release.yml" required:"true"`
+}
+
+type ApplyReleaseCommand struct {
+	File string `long:"file" short:"f" description:"Path to a YAML file describing the desired state of the release e.g. ./release.yml" required:"true"`
+}
+
+//go:generate counterfeiter . ReleasePlanClient
+type ReleasePlanClient interface {
+	Plan(specPath string) error
+	Apply(specPath string) error
+}
+
+var NewReleasePlanClient = func(client releaseplan.PivnetClient) ReleasePlanClient {
+	return releaseplan.NewReleasePlanClient(
+		client,
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+		Pivnet.Logger,
+	)
+}
+
+func (command *PlanReleaseCommand) Execute([]string) error {
+	err := Init(true)
+	if err != nil {
+		return err
+	}
+
+	client := NewPivnetClient()
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	return NewReleasePlanClient(client).Plan(command.File)
+}
+
+func (command *ApplyReleaseCommand) Execute([]string) error {
+	err := Init(true)
+	if err != nil {
+		return err
+	}
+
+	client := NewPivnetClient()
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	return NewReleasePlanClient(client).Apply(command.File)
+}
@@ -0,0 +1,189 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseplan"
+)
+
+var _ = Describe("release plan commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeReleasePlanClient *commandsfakes.FakeReleasePlanClient
+	)
+
+	BeforeEach(func() {
+		fakeReleasePlanClient = &commandsfakes.FakeReleasePlanClient{}
+
+		commands.NewReleasePlanClient = func(releaseplan.PivnetClient) commands.ReleasePlanClient {
+			return fakeReleasePlanClient
+		}
+	})
+
+	Describe("PlanReleaseCommand", func() {
+		var (
+			cmd *commands.PlanReleaseCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.PlanReleaseCommand{
+				File: "some/release.yml",
+			}
+		})
+
+		It("invokes the release plan client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeReleasePlanClient.PlanCallCount()).To(Equal(1))
+			Expect(fakeReleasePlanClient.PlanArgsForCall(0)).To(Equal("some/release.yml"))
+		})
+
+		Context("when the release plan client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeReleasePlanClient.PlanReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+			})
+		})
+
+		Describe("File flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.PlanReleaseCommand{}, "File")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("file"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("f"))
+			})
+		})
+	})
+
+	Describe("ApplyReleaseCommand", func() {
+		var (
+			cmd *commands.ApplyReleaseCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.ApplyReleaseCommand{
+				File: "some/release.yml",
+			}
+		})
+
+		It("invokes the release plan client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeReleasePlanClient.ApplyCallCount()).To(Equal(1))
+			Expect(fakeReleasePlanClient.ApplyArgsForCall(0)).To(Equal("some/release.yml"))
+		})
+
+		Context("when the release plan client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeReleasePlanClient.ApplyReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+			})
+		})
+
+		Describe("File flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.ApplyReleaseCommand{}, "File")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("file"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("f"))
+			})
+		})
+	})
+})
@@ -3,6 +3,9 @@ package commands_test
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 
 	. "github.com/onsi/ginkgo"
@@ -53,7 +56,7 @@ var _ = Describe("release commands", func() {
 			BeforeEach(func() {
 				slug = "product-slug"
 				limit = "5"
-				cmd = commands.ReleasesCommand{slug, limit}
+				cmd = commands.ReleasesCommand{ProductSlug: slug, Limit: limit}
 			})
 
 			It("invokes the Release client for the method ListWithLimit", func() {
@@ -84,6 +87,43 @@ var _ = Describe("release commands", func() {
 			})
 		})
 
+		Context("when sorting by semver", func() {
+			BeforeEach(func() {
+				cmd = commands.ReleasesCommand{
+					ProductSlug: "product-slug",
+					Limit:       "5",
+					Sort:        "semver",
+				}
+			})
+
+			It("invokes the Release client for the method ListSortedBySemver", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeReleaseClient.ListSortedBySemverCallCount()).To(Equal(1))
+				Expect(fakeReleaseClient.ListWithLimitCallCount()).To(Equal(0))
+
+				slug, limit := fakeReleaseClient.ListSortedBySemverArgsForCall(0)
+				Expect(slug).To(Equal("product-slug"))
+				Expect(limit).To(Equal("5"))
+			})
+
+			Context("when method ListSortedBySemver returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("expected error")
+					fakeReleaseClient.ListSortedBySemverReturns(expectedErr)
+				})
+
+				It("forwards the error", func() {
+					err := cmd.Execute(nil)
+
+					Expect(err).To(Equal(expectedErr))
+				})
+			})
+		})
+
 		Context("when the Release client returns an error", func() {
 			var (
 				expectedErr error
@@ -160,6 +200,24 @@ var _ = Describe("release commands", func() {
 				Expect(longTag(field)).To(Equal("limit"))
 			})
 		})
+
+		Describe("sort flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.ReleasesCommand{}, "Sort")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("sort"))
+			})
+
+			It("only allows semver", func() {
+				Expect(field.Tag.Get("choice")).To(Equal("semver"))
+			})
+		})
 	})
 
 	Describe("ReleaseCommand", func() {
@@ -263,7 +321,16 @@ var _ = Describe("release commands", func() {
 		)
 
 		BeforeEach(func() {
-			cmd = cmd
+			cmd = commands.CreateReleaseCommand{
+				ProductSlug:    "some-product-slug",
+				ReleaseVersion: "1.2.3",
+				ReleaseType:    "Minor Release",
+				EULASlug:       "some-eula-slug",
+				ReleaseDate:    "2020-09-15",
+				Availability:   "all",
+				Controlled:     true,
+				ECCN:           "5D002",
+			}
 		})
 
 		It("invokes the Release client", func() {
@@ -272,6 +339,93 @@ var _ = Describe("release commands", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(fakeReleaseClient.CreateCallCount()).To(Equal(1))
+			Expect(fakeReleaseClient.CreateArgsForCall(0)).To(Equal(release.Attributes{
+				ProductSlug:  "some-product-slug",
+				Version:      "1.2.3",
+				ReleaseType:  "Minor Release",
+				EULASlug:     "some-eula-slug",
+				ReleaseDate:  "2020-09-15",
+				Availability: "all",
+				Controlled:   true,
+				ECCN:         "5D002",
+			}))
+		})
+
+		Context("when a required attribute is missing", func() {
+			BeforeEach(func() {
+				cmd.EULASlug = ""
+			})
+
+			It("returns an error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("required unless set in --from-file"))
+
+				Expect(fakeReleaseClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --from-file is provided", func() {
+			var (
+				tempDir string
+			)
+
+			BeforeEach(func() {
+				var err error
+				tempDir, err = ioutil.TempDir("", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd = commands.CreateReleaseCommand{
+					ReleaseVersion: "1.2.4",
+					FromFile:       filepath.Join(tempDir, "release.json"),
+				}
+
+				err = ioutil.WriteFile(cmd.FromFile, []byte(`{
+  "product_slug": "some-product-slug",
+  "version": "1.2.3",
+  "release_type": "Minor Release",
+  "eula_slug": "some-eula-slug",
+  "end_of_support_date": "2021-09-30",
+  "copy_metadata": true
+}`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := os.RemoveAll(tempDir)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("invokes the Release client with the attributes of the file, overridden by flags", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeReleaseClient.CreateCallCount()).To(Equal(1))
+				Expect(fakeReleaseClient.CreateArgsForCall(0)).To(Equal(release.Attributes{
+					ProductSlug:      "some-product-slug",
+					Version:          "1.2.4",
+					ReleaseType:      "Minor Release",
+					EULASlug:         "some-eula-slug",
+					EndOfSupportDate: "2021-09-30",
+					CopyMetadata:     true,
+				}))
+			})
+
+			Context("when the file contains an unknown key", func() {
+				BeforeEach(func() {
+					err := ioutil.WriteFile(cmd.FromFile, []byte(`{"product_slug": "some-product-slug", "eula": "some-eula-slug"}`), 0644)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns an error", func() {
+					err := cmd.Execute(nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(fakeReleaseClient.CreateCallCount()).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when the Release client returns an error", func() {
@@ -320,8 +474,8 @@ var _ = Describe("release commands", func() {
 				field = fieldFor(cmd, "ProductSlug")
 			})
 
-			It("is required", func() {
-				Expect(isRequired(field)).To(BeTrue())
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
 			})
 
 			It("contains short name", func() {
@@ -338,8 +492,8 @@ var _ = Describe("release commands", func() {
 				field = fieldFor(cmd, "ReleaseVersion")
 			})
 
-			It("is required", func() {
-				Expect(isRequired(field)).To(BeTrue())
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
 			})
 
 			It("contains short name", func() {
@@ -356,8 +510,8 @@ var _ = Describe("release commands", func() {
 				field = fieldFor(cmd, "ReleaseType")
 			})
 
-			It("is required", func() {
-				Expect(isRequired(field)).To(BeTrue())
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
 			})
 
 			It("contains short name", func() {
@@ -374,8 +528,8 @@ var _ = Describe("release commands", func() {
 				field = fieldFor(cmd, "EULASlug")
 			})
 
-			It("is required", func() {
-				Expect(isRequired(field)).To(BeTrue())
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
 			})
 
 			It("contains short name", func() {
@@ -386,6 +540,44 @@ var _ = Describe("release commands", func() {
 				Expect(longTag(field)).To(Equal("eula-slug"))
 			})
 		})
+
+		Describe("Availability flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "Availability")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("availability"))
+			})
+		})
+
+		Describe("ReleaseDate flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "ReleaseDate")
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("release-date"))
+			})
+		})
+
+		Describe("FromFile flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "FromFile")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("from-file"))
+			})
+		})
 	})
 
 	Describe("UpdateReleaseCommand", func() {
@@ -405,6 +597,42 @@ var _ = Describe("release commands", func() {
 			Expect(fakeReleaseClient.UpdateCallCount()).To(Equal(1))
 		})
 
+		Context("when attributes, a patch and diff are provided", func() {
+			var (
+				description string
+			)
+
+			BeforeEach(func() {
+				description = "some-description"
+
+				cmd = commands.UpdateReleaseCommand{
+					ProductSlug:    "some-product-slug",
+					ReleaseVersion: "1.2.3",
+					Description:    &description,
+					Controlled:     true,
+					Patch:          "some/patch.json",
+					Diff:           true,
+				}
+			})
+
+			It("invokes the Release client with them", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeReleaseClient.UpdateCallCount()).To(Equal(1))
+
+				productSlug, releaseVersion, changes, patchPath, diffOnly := fakeReleaseClient.UpdateArgsForCall(0)
+				Expect(productSlug).To(Equal("some-product-slug"))
+				Expect(releaseVersion).To(Equal("1.2.3"))
+				Expect(*changes.Description).To(Equal("some-description"))
+				Expect(*changes.Controlled).To(BeTrue())
+				Expect(changes.Version).To(BeNil())
+				Expect(patchPath).To(Equal("some/patch.json"))
+				Expect(diffOnly).To(BeTrue())
+			})
+		})
+
 		Context("when the Release client returns an error", func() {
 			var (
 				expectedErr error
@@ -509,6 +737,62 @@ var _ = Describe("release commands", func() {
 				Expect(longTag(field)).To(Equal("release-type"))
 			})
 		})
+
+		Describe("NewReleaseVersion flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "NewReleaseVersion")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("new-release-version"))
+			})
+		})
+
+		Describe("EULASlug flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "EULASlug")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("e"))
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("eula-slug"))
+			})
+		})
+
+		Describe("Patch flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "Patch")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("patch"))
+			})
+		})
+
+		Describe("Diff flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(cmd, "Diff")
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("diff"))
+			})
+		})
 	})
 
 	Describe("DeleteReleaseCommand", func() {
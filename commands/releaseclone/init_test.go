@@ -0,0 +1,13 @@
+package releaseclone_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReleaseClone(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReleaseClone Suite")
+}
@@ -0,0 +1,383 @@
+package releaseclone
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
+	CreateRelease(config pivnet.CreateReleaseConfig) (pivnet.Release, error)
+	UpdateRelease(productSlug string, release pivnet.Release) (pivnet.Release, error)
+	DeleteRelease(productSlug string, release pivnet.Release) error
+	ProductFilesAddedToRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error)
+	AddProductFileToRelease(productSlug string, releaseID int, productFileID int) error
+	FileGroupsForRelease(productSlug string, releaseID int) ([]pivnet.FileGroup, error)
+	AddFileGroupToRelease(productSlug string, fileGroupID int, releaseID int) error
+	ArtifactReferencesForRelease(productSlug string, releaseID int) ([]pivnet.ArtifactReference, error)
+	AddArtifactReferenceToRelease(productSlug string, artifactReferenceID int, releaseID int) error
+	UserGroupsForRelease(productSlug string, releaseID int) ([]pivnet.UserGroup, error)
+	AddUserGroup(productSlug string, releaseID int, userGroupID int) error
+	DependencySpecifiers(productSlug string, releaseID int) ([]pivnet.DependencySpecifier, error)
+	CreateDependencySpecifier(productSlug string, releaseID int, dependentProductSlug string, specifier string) (pivnet.DependencySpecifier, error)
+	ReleaseUpgradePaths(productSlug string, releaseID int) ([]pivnet.ReleaseUpgradePath, error)
+	AddReleaseUpgradePath(productSlug string, releaseID int, previousReleaseID int) error
+}
+
+//go:generate counterfeiter . Filter
+type Filter interface {
+	ProductFileKeysByGlobs(productFiles []pivnet.ProductFile, globs []string) ([]pivnet.ProductFile, error)
+}
+
+// CloneOptions control what is cloned besides the attributes of the release.
+type CloneOptions struct {
+	// AddUpgradePath adds the source release as an upgrade path of the clone.
+	AddUpgradePath bool
+
+	// ExcludeProductFileIDs and ExcludeGlobs are the product files of the
+	// source release that are not added to the clone, e.g. because newer
+	// files are added to it afterwards.
+	ExcludeProductFileIDs []int
+	ExcludeGlobs          []string
+}
+
+type ReleaseCloneClient struct {
+	pivnetClient PivnetClient
+	filter       Filter
+	eh           errorhandler.ErrorHandler
+	format       string
+	outputWriter io.Writer
+	printer      printer.Printer
+	l            logger.Logger
+}
+
+func NewReleaseCloneClient(
+	pivnetClient PivnetClient,
+	filter Filter,
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+	l logger.Logger,
+) *ReleaseCloneClient {
+	return &ReleaseCloneClient{
+		pivnetClient: pivnetClient,
+		filter:       filter,
+		eh:           eh,
+		format:       format,
+		outputWriter: outputWriter,
+		printer:      printer,
+		l:            l,
+	}
+}
+
+// Clone creates a release of productSlug with version toVersion and the
+// attributes of the release fromVersion, and adds the same product files,
+// file groups, artifact references, user groups, dependency specifiers and
+// upgrade paths to it. Everything about the source release is read before
+// the clone is created, and if adding to the clone fails it is deleted, so
+// that no partial clone is left behind.
+func (c *ReleaseCloneClient) Clone(
+	productSlug string,
+	fromVersion string,
+	toVersion string,
+	options CloneOptions,
+) error {
+	releases, err := c.pivnetClient.ReleasesForProductSlug(productSlug)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	for _, r := range releases {
+		if r.Version == toVersion {
+			err := fmt.Errorf("release %s already exists for %s", toVersion, productSlug)
+			return c.eh.HandleError(err)
+		}
+	}
+
+	source, err := c.pivnetClient.ReleaseForConstraint(productSlug, fromVersion)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	steps, err := c.cloneSteps(productSlug, source, options)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	config := pivnet.CreateReleaseConfig{
+		ProductSlug:           productSlug,
+		Version:               toVersion,
+		ReleaseType:           string(source.ReleaseType),
+		Description:           source.Description,
+		ReleaseNotesURL:       source.ReleaseNotesURL,
+		Controlled:            source.Controlled,
+		ECCN:                  source.ECCN,
+		LicenseException:      source.LicenseException,
+		EndOfSupportDate:      source.EndOfSupportDate,
+		EndOfGuidanceDate:     source.EndOfGuidanceDate,
+		EndOfAvailabilityDate: source.EndOfAvailabilityDate,
+	}
+	if source.EULA != nil {
+		config.EULASlug = source.EULA.Slug
+	}
+
+	clone, err := c.pivnetClient.CreateRelease(config)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	c.l.Info(fmt.Sprintf("Created release %s (%d) from %s", clone.Version, clone.ID, source.Version))
+
+	builder := releasebuilder.NewBuilder(c.pivnetClient, c.l)
+
+	err = builder.Build(productSlug, &clone, source.Availability, steps)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	return c.printRelease(clone)
+}
+
+// cloneSteps reads the associations of source and returns the changes that
+// add them to a clone.
+func (c *ReleaseCloneClient) cloneSteps(
+	productSlug string,
+	source pivnet.Release,
+	options CloneOptions,
+) ([]releasebuilder.Step, error) {
+	var steps []releasebuilder.Step
+
+	// The product files of the file groups are cloned with the file groups,
+	// so only the product files added to the release itself are added.
+	productFiles, err := c.pivnetClient.ProductFilesAddedToRelease(productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileGroups, err := c.pivnetClient.FileGroupsForRelease(productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded, err := c.excludedProductFiles(productFiles, fileGroups, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pf := range productFiles {
+		if excluded[pf.ID] {
+			c.l.Info(fmt.Sprintf("Leaving out product file %d (%s)", pf.ID, pf.Name))
+			continue
+		}
+
+		id := pf.ID
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add product file %d (%s)", pf.ID, pf.Name),
+			Apply: func(release *pivnet.Release) error {
+				return c.pivnetClient.AddProductFileToRelease(productSlug, release.ID, id)
+			},
+		})
+	}
+
+	for _, fg := range fileGroups {
+		id := fg.ID
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add file group %d (%s)", fg.ID, fg.Name),
+			Apply: func(release *pivnet.Release) error {
+				return c.pivnetClient.AddFileGroupToRelease(productSlug, id, release.ID)
+			},
+		})
+	}
+
+	artifactReferences, err := c.pivnetClient.ArtifactReferencesForRelease(productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ar := range artifactReferences {
+		id := ar.ID
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add artifact reference %d (%s)", ar.ID, ar.Name),
+			Apply: func(release *pivnet.Release) error {
+				return c.pivnetClient.AddArtifactReferenceToRelease(productSlug, id, release.ID)
+			},
+		})
+	}
+
+	userGroups, err := c.pivnetClient.UserGroupsForRelease(productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ug := range userGroups {
+		id := ug.ID
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add user group %d (%s)", ug.ID, ug.Name),
+			Apply: func(release *pivnet.Release) error {
+				return c.pivnetClient.AddUserGroup(productSlug, release.ID, id)
+			},
+		})
+	}
+
+	dependencySpecifiers, err := c.pivnetClient.DependencySpecifiers(productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ds := range dependencySpecifiers {
+		dependentProductSlug, specifier := ds.Product.Slug, ds.Specifier
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add dependency specifier %s %s", dependentProductSlug, specifier),
+			Apply: func(release *pivnet.Release) error {
+				_, err := c.pivnetClient.CreateDependencySpecifier(productSlug, release.ID, dependentProductSlug, specifier)
+				return err
+			},
+		})
+	}
+
+	upgradePaths, err := c.pivnetClient.ReleaseUpgradePaths(productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousReleases := make([]pivnet.UpgradePathRelease, len(upgradePaths))
+	for i, u := range upgradePaths {
+		previousReleases[i] = u.Release
+	}
+
+	if options.AddUpgradePath {
+		previousReleases = append(previousReleases, pivnet.UpgradePathRelease{
+			ID:      source.ID,
+			Version: source.Version,
+		})
+	}
+
+	for _, previous := range previousReleases {
+		id := previous.ID
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add upgrade path from %s", previous.Version),
+			Apply: func(release *pivnet.Release) error {
+				return c.pivnetClient.AddReleaseUpgradePath(productSlug, release.ID, id)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+// excludedProductFiles returns the IDs of the product files that are left
+// out of the clone. An ID that is not a product file of the source release
+// is an error, as it is most likely a typo. So is excluding a product file of
+// one of the file groups by ID, as it is cloned with the file group
+// regardless. Globs only match the product files added to the release itself.
+func (c *ReleaseCloneClient) excludedProductFiles(
+	productFiles []pivnet.ProductFile,
+	fileGroups []pivnet.FileGroup,
+	options CloneOptions,
+) (map[int]bool, error) {
+	excluded := make(map[int]bool)
+
+	for _, id := range options.ExcludeProductFileIDs {
+		err := inFileGroup(id, fileGroups)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, pf := range productFiles {
+			if pf.ID == id {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("product file %d is not a product file of the source release", id)
+		}
+
+		excluded[id] = true
+	}
+
+	if len(options.ExcludeGlobs) > 0 {
+		matches, err := c.filter.ProductFileKeysByGlobs(productFiles, options.ExcludeGlobs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pf := range matches {
+			excluded[pf.ID] = true
+		}
+
+		err = c.logFileGroupMatches(fileGroups, options.ExcludeGlobs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return excluded, nil
+}
+
+// logFileGroupMatches logs the product files of the file groups that match
+// globs. They are cloned with their file group, so a glob that also matches
+// them is not an error, unlike an explicit ID.
+func (c *ReleaseCloneClient) logFileGroupMatches(fileGroups []pivnet.FileGroup, globs []string) error {
+	for _, fg := range fileGroups {
+		matches, err := c.filter.ProductFileKeysByGlobs(fg.ProductFiles, globs)
+		if err != nil {
+			return err
+		}
+
+		for _, pf := range matches {
+			c.l.Info(fmt.Sprintf(
+				"Not leaving out product file %d (%s), it is cloned with file group %d (%s)",
+				pf.ID,
+				pf.Name,
+				fg.ID,
+				fg.Name,
+			))
+		}
+	}
+
+	return nil
+}
+
+// inFileGroup returns an error if the product file is in one of fileGroups.
+func inFileGroup(productFileID int, fileGroups []pivnet.FileGroup) error {
+	for _, fg := range fileGroups {
+		for _, pf := range fg.ProductFiles {
+			if pf.ID == productFileID {
+				return fmt.Errorf(
+					"product file %d (%s) is in file group %d (%s) of the source release, so it cannot be excluded from the clone",
+					pf.ID,
+					pf.Name,
+					fg.ID,
+					fg.Name,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *ReleaseCloneClient) printRelease(release pivnet.Release) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		releasebuilder.PrintReleaseTable(c.outputWriter, release)
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(release)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(release)
+	}
+
+	return nil
+}
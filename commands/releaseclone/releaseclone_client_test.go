@@ -0,0 +1,492 @@
+package releaseclone_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseclone"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseclone/releaseclonefakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+var _ = Describe("releaseclone commands", func() {
+	var (
+		fakePivnetClient *releaseclonefakes.FakePivnetClient
+		fakeFilter       *releaseclonefakes.FakeFilter
+		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+		logBuffer bytes.Buffer
+
+		options releaseclone.CloneOptions
+
+		client *releaseclone.ReleaseCloneClient
+	)
+
+	BeforeEach(func() {
+		fakePivnetClient = &releaseclonefakes.FakePivnetClient{}
+		fakeFilter = &releaseclonefakes.FakeFilter{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		outBuffer = bytes.Buffer{}
+		logBuffer = bytes.Buffer{}
+
+		options = releaseclone.CloneOptions{}
+
+		fakePivnetClient.ReleasesForProductSlugReturns([]pivnet.Release{
+			{ID: 10, Version: "2.10.1"},
+			{ID: 30, Version: "2.10.3"},
+		}, nil)
+
+		fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{
+			ID:                    30,
+			Version:               "2.10.3",
+			ReleaseType:           "Minor Release",
+			EULA:                  &pivnet.EULA{Slug: "vmware_eula"},
+			Description:           "some description",
+			ReleaseNotesURL:       "https://example.com/notes",
+			Availability:          "All Users",
+			Controlled:            true,
+			ECCN:                  "5D002",
+			LicenseException:      "TSU",
+			EndOfSupportDate:      "2021-09-30",
+			EndOfGuidanceDate:     "2021-10-30",
+			EndOfAvailabilityDate: "2021-11-30",
+		}, nil)
+
+		fakePivnetClient.ProductFilesAddedToReleaseReturns([]pivnet.ProductFile{
+			{ID: 1, Name: "mysql tile"},
+			{ID: 2, Name: "mysql docs"},
+		}, nil)
+		fakePivnetClient.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+			{
+				ID:           7,
+				Name:         "Stemcells",
+				ProductFiles: []pivnet.ProductFile{{ID: 3, Name: "stemcell"}},
+			},
+		}, nil)
+		fakePivnetClient.ArtifactReferencesForReleaseReturns([]pivnet.ArtifactReference{
+			{ID: 6, Name: "mysql image"},
+		}, nil)
+		fakePivnetClient.UserGroupsForReleaseReturns([]pivnet.UserGroup{
+			{ID: 8, Name: "partners"},
+		}, nil)
+		fakePivnetClient.DependencySpecifiersReturns([]pivnet.DependencySpecifier{
+			{ID: 40, Product: pivnet.Product{Slug: "stemcells-ubuntu-xenial"}, Specifier: "456.*"},
+		}, nil)
+		fakePivnetClient.ReleaseUpgradePathsReturns([]pivnet.ReleaseUpgradePath{
+			{Release: pivnet.UpgradePathRelease{ID: 10, Version: "2.10.1"}},
+		}, nil)
+
+		fakePivnetClient.CreateReleaseReturns(pivnet.Release{
+			ID:           99,
+			Version:      "2.10.4",
+			Availability: "Admins Only",
+		}, nil)
+		fakePivnetClient.UpdateReleaseStub = func(productSlug string, release pivnet.Release) (pivnet.Release, error) {
+			return release, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		logWriter := io.MultiWriter(GinkgoWriter, &logBuffer)
+		l := logshim.NewLogShim(log.New(logWriter, "", 0), log.New(logWriter, "", 0), true)
+
+		client = releaseclone.NewReleaseCloneClient(
+			fakePivnetClient,
+			fakeFilter,
+			fakeErrorHandler,
+			printer.PrintAsJSON,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+			l,
+		)
+	})
+
+	Describe("Clone", func() {
+		It("creates the release with the attributes of the source release", func() {
+			err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePivnetClient.ReleaseForConstraintCallCount()).To(Equal(1))
+			productSlug, version := fakePivnetClient.ReleaseForConstraintArgsForCall(0)
+			Expect(productSlug).To(Equal("p-mysql"))
+			Expect(version).To(Equal("2.10.3"))
+
+			Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(1))
+			Expect(fakePivnetClient.CreateReleaseArgsForCall(0)).To(Equal(pivnet.CreateReleaseConfig{
+				ProductSlug:           "p-mysql",
+				Version:               "2.10.4",
+				ReleaseType:           "Minor Release",
+				EULASlug:              "vmware_eula",
+				Description:           "some description",
+				ReleaseNotesURL:       "https://example.com/notes",
+				Controlled:            true,
+				ECCN:                  "5D002",
+				LicenseException:      "TSU",
+				EndOfSupportDate:      "2021-09-30",
+				EndOfGuidanceDate:     "2021-10-30",
+				EndOfAvailabilityDate: "2021-11-30",
+			}))
+		})
+
+		It("adds the associations of the source release to the clone", func() {
+			err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(2))
+			productSlug, releaseID, productFileID := fakePivnetClient.AddProductFileToReleaseArgsForCall(0)
+			Expect(productSlug).To(Equal("p-mysql"))
+			Expect(releaseID).To(Equal(99))
+			Expect(productFileID).To(Equal(1))
+			_, releaseID, productFileID = fakePivnetClient.AddProductFileToReleaseArgsForCall(1)
+			Expect(releaseID).To(Equal(99))
+			Expect(productFileID).To(Equal(2))
+
+			Expect(fakePivnetClient.AddFileGroupToReleaseCallCount()).To(Equal(1))
+			_, fileGroupID, releaseID := fakePivnetClient.AddFileGroupToReleaseArgsForCall(0)
+			Expect(fileGroupID).To(Equal(7))
+			Expect(releaseID).To(Equal(99))
+
+			Expect(fakePivnetClient.AddArtifactReferenceToReleaseCallCount()).To(Equal(1))
+			_, artifactReferenceID, releaseID := fakePivnetClient.AddArtifactReferenceToReleaseArgsForCall(0)
+			Expect(artifactReferenceID).To(Equal(6))
+			Expect(releaseID).To(Equal(99))
+
+			Expect(fakePivnetClient.AddUserGroupCallCount()).To(Equal(1))
+			_, releaseID, userGroupID := fakePivnetClient.AddUserGroupArgsForCall(0)
+			Expect(releaseID).To(Equal(99))
+			Expect(userGroupID).To(Equal(8))
+
+			Expect(fakePivnetClient.CreateDependencySpecifierCallCount()).To(Equal(1))
+			_, releaseID, dependentProductSlug, specifier := fakePivnetClient.CreateDependencySpecifierArgsForCall(0)
+			Expect(releaseID).To(Equal(99))
+			Expect(dependentProductSlug).To(Equal("stemcells-ubuntu-xenial"))
+			Expect(specifier).To(Equal("456.*"))
+
+			Expect(fakePivnetClient.AddReleaseUpgradePathCallCount()).To(Equal(1))
+			_, releaseID, previousReleaseID := fakePivnetClient.AddReleaseUpgradePathArgsForCall(0)
+			Expect(releaseID).To(Equal(99))
+			Expect(previousReleaseID).To(Equal(10))
+
+			Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(0))
+		})
+
+		It("sets the availability of the clone last", func() {
+			err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(1))
+			_, release := fakePivnetClient.UpdateReleaseArgsForCall(0)
+			Expect(release.ID).To(Equal(99))
+			Expect(release.Availability).To(Equal("All Users"))
+
+			var printed pivnet.Release
+			err = json.Unmarshal(outBuffer.Bytes(), &printed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(printed.ID).To(Equal(99))
+			Expect(printed.Availability).To(Equal("All Users"))
+		})
+
+		Context("when the source release is admins only", func() {
+			BeforeEach(func() {
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{
+					ID:           30,
+					Version:      "2.10.3",
+					Availability: "Admins Only",
+				}, nil)
+			})
+
+			It("does not update the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the source release is added as an upgrade path", func() {
+			BeforeEach(func() {
+				options.AddUpgradePath = true
+			})
+
+			It("adds the source release after its own upgrade paths", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.AddReleaseUpgradePathCallCount()).To(Equal(2))
+				_, _, previousReleaseID := fakePivnetClient.AddReleaseUpgradePathArgsForCall(0)
+				Expect(previousReleaseID).To(Equal(10))
+				_, releaseID, previousReleaseID := fakePivnetClient.AddReleaseUpgradePathArgsForCall(1)
+				Expect(releaseID).To(Equal(99))
+				Expect(previousReleaseID).To(Equal(30))
+			})
+		})
+
+		Context("when product files are excluded by id", func() {
+			BeforeEach(func() {
+				options.ExcludeProductFileIDs = []int{1}
+			})
+
+			It("leaves them out of the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(1))
+				_, _, productFileID := fakePivnetClient.AddProductFileToReleaseArgsForCall(0)
+				Expect(productFileID).To(Equal(2))
+			})
+
+			Context("when the id is not a product file of the source release", func() {
+				BeforeEach(func() {
+					options.ExcludeProductFileIDs = []int{1234}
+				})
+
+				It("invokes the error handler without creating the clone", func() {
+					err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+						"product file 1234 is not a product file of the source release"))
+
+					Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the id is a product file of a file group", func() {
+				BeforeEach(func() {
+					options.ExcludeProductFileIDs = []int{3}
+				})
+
+				It("invokes the error handler without creating the clone", func() {
+					err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+						"product file 3 (stemcell) is in file group 7 (Stemcells) of the source release, so it cannot be excluded from the clone"))
+
+					Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when product files are excluded by glob", func() {
+			BeforeEach(func() {
+				options.ExcludeGlobs = []string{"*.pdf"}
+				fakeFilter.ProductFileKeysByGlobsReturnsOnCall(0, []pivnet.ProductFile{
+					{ID: 2, Name: "mysql docs"},
+				}, nil)
+				fakeFilter.ProductFileKeysByGlobsReturnsOnCall(1, []pivnet.ProductFile{}, nil)
+			})
+
+			It("leaves the matching product files out of the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeFilter.ProductFileKeysByGlobsCallCount()).To(Equal(2))
+				productFiles, globs := fakeFilter.ProductFileKeysByGlobsArgsForCall(0)
+				Expect(productFiles).To(Equal([]pivnet.ProductFile{
+					{ID: 1, Name: "mysql tile"},
+					{ID: 2, Name: "mysql docs"},
+				}))
+				Expect(globs).To(Equal([]string{"*.pdf"}))
+
+				productFiles, _ = fakeFilter.ProductFileKeysByGlobsArgsForCall(1)
+				Expect(productFiles).To(Equal([]pivnet.ProductFile{{ID: 3, Name: "stemcell"}}))
+
+				Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(1))
+				_, _, productFileID := fakePivnetClient.AddProductFileToReleaseArgsForCall(0)
+				Expect(productFileID).To(Equal(1))
+			})
+
+			Context("when a glob matches a product file of a file group", func() {
+				BeforeEach(func() {
+					fakeFilter.ProductFileKeysByGlobsReturnsOnCall(0, []pivnet.ProductFile{}, nil)
+					fakeFilter.ProductFileKeysByGlobsReturnsOnCall(1, []pivnet.ProductFile{
+						{ID: 3, Name: "stemcell"},
+					}, nil)
+				})
+
+				It("logs it and clones the file group", func() {
+					err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+					Expect(logBuffer.String()).To(ContainSubstring(
+						"Not leaving out product file 3 (stemcell), it is cloned with file group 7 (Stemcells)"))
+
+					Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(2))
+					Expect(fakePivnetClient.AddFileGroupToReleaseCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when filtering returns an error", func() {
+				var (
+					expectedErr error
+				)
+
+				BeforeEach(func() {
+					expectedErr = errors.New("glob error")
+					fakeFilter.ProductFileKeysByGlobsReturnsOnCall(0, nil, expectedErr)
+				})
+
+				It("invokes the error handler without creating the clone", func() {
+					err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+					Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the target release already exists", func() {
+			It("invokes the error handler without creating the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.1", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"release 2.10.1 already exists for p-mysql"))
+
+				Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the source release returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("release error")
+				fakePivnetClient.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
+			})
+
+			It("invokes the error handler without creating the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+				Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when reading the associations of the source release returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upgrade paths error")
+				fakePivnetClient.ReleaseUpgradePathsReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler without creating the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+				Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the clone returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create error")
+				fakePivnetClient.CreateReleaseReturns(pivnet.Release{}, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+				Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when adding to the clone fails partway", func() {
+			BeforeEach(func() {
+				fakePivnetClient.AddFileGroupToReleaseReturns(errors.New("file group error"))
+			})
+
+			It("deletes the clone and invokes the error handler", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(2))
+				Expect(fakePivnetClient.AddUserGroupCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(1))
+				productSlug, release := fakePivnetClient.DeleteReleaseArgsForCall(0)
+				Expect(productSlug).To(Equal("p-mysql"))
+				Expect(release.ID).To(Equal(99))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"failed to add file group 7 (Stemcells): file group error (the partial release 2.10.4 was deleted)"))
+
+				Expect(outBuffer.String()).To(BeEmpty())
+			})
+
+			Context("when deleting the clone also fails", func() {
+				BeforeEach(func() {
+					fakePivnetClient.DeleteReleaseReturns(errors.New("delete error"))
+				})
+
+				It("reports that the partial release is left behind", func() {
+					err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+						"failed to add file group 7 (Stemcells): file group error (deleting the partial release 2.10.4 also failed: delete error, delete it with delete-release)"))
+				})
+			})
+		})
+
+		Context("when setting the availability of the clone fails", func() {
+			BeforeEach(func() {
+				fakePivnetClient.UpdateReleaseStub = nil
+				fakePivnetClient.UpdateReleaseReturns(pivnet.Release{}, errors.New("update error"))
+			})
+
+			It("deletes the clone", func() {
+				err := client.Clone("p-mysql", "2.10.3", "2.10.4", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(1))
+				_, release := fakePivnetClient.DeleteReleaseArgsForCall(0)
+				Expect(release.ID).To(Equal(99))
+
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"failed to set availability to 'All Users': update error (the partial release 2.10.4 was deleted)"))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package releasecopy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReleaseCopy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReleaseCopy Suite")
+}
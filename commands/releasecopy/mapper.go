@@ -0,0 +1,297 @@
+package releasecopy
+
+import (
+	"fmt"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder"
+)
+
+// mapper maps the associations of a release on the source host to their
+// counterparts on the target host, and records what it could not map.
+type mapper struct {
+	productSlug    string
+	source         PivnetClient
+	target         PivnetClient
+	targetReleases []pivnet.Release
+
+	unmapped []Unmapped
+}
+
+// copySteps reads the associations of source, and everything on the target
+// they are mapped to, and returns the changes that add them to the release on
+// the target.
+func (m *mapper) copySteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	var steps []releasebuilder.Step
+
+	for _, plan := range []func(pivnet.Release) ([]releasebuilder.Step, error){
+		m.productFileSteps,
+		m.fileGroupSteps,
+		m.artifactReferenceSteps,
+		m.userGroupSteps,
+		m.dependencySpecifierSteps,
+		m.upgradePathSteps,
+	} {
+		s, err := plan(source)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, s...)
+	}
+
+	return steps, nil
+}
+
+// productFileSteps adds the product files that were added to the source
+// release itself. The product files of its file groups come with the file
+// groups.
+func (m *mapper) productFileSteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	productFiles, err := m.source.ProductFilesAddedToRelease(m.productSlug, source.ID)
+	if err != nil || len(productFiles) == 0 {
+		return nil, err
+	}
+
+	targetProductFiles, err := m.target.ProductFiles(m.productSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []releasebuilder.Step
+	for _, pf := range productFiles {
+		if pf.AWSObjectKey == "" {
+			m.skip("product file", pf.ID, pf.Name, "it has no object key")
+			continue
+		}
+
+		var matches []int
+		for _, t := range targetProductFiles {
+			if t.AWSObjectKey == pf.AWSObjectKey {
+				matches = append(matches, t.ID)
+			}
+		}
+
+		if len(matches) != 1 {
+			m.skip("product file", pf.ID, pf.Name, notFoundReason(len(matches), "product file", "object key"))
+			continue
+		}
+
+		id := matches[0]
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add product file %d (%s)", id, pf.Name),
+			Apply: func(release *pivnet.Release) error {
+				return m.target.AddProductFileToRelease(m.productSlug, release.ID, id)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+func (m *mapper) fileGroupSteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	fileGroups, err := m.source.FileGroupsForRelease(m.productSlug, source.ID)
+	if err != nil || len(fileGroups) == 0 {
+		return nil, err
+	}
+
+	targetFileGroups, err := m.target.FileGroups(m.productSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []releasebuilder.Step
+	for _, fg := range fileGroups {
+		var matches []int
+		for _, t := range targetFileGroups {
+			if t.Name == fg.Name {
+				matches = append(matches, t.ID)
+			}
+		}
+
+		if len(matches) != 1 {
+			m.skip("file group", fg.ID, fg.Name, notFoundReason(len(matches), "file group", "name"))
+			continue
+		}
+
+		id := matches[0]
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add file group %d (%s)", id, fg.Name),
+			Apply: func(release *pivnet.Release) error {
+				return m.target.AddFileGroupToRelease(m.productSlug, id, release.ID)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+func (m *mapper) artifactReferenceSteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	artifactReferences, err := m.source.ArtifactReferencesForRelease(m.productSlug, source.ID)
+	if err != nil || len(artifactReferences) == 0 {
+		return nil, err
+	}
+
+	targetArtifactReferences, err := m.target.ArtifactReferences(m.productSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []releasebuilder.Step
+	for _, ar := range artifactReferences {
+		var matches []int
+		for _, t := range targetArtifactReferences {
+			if t.Name == ar.Name && t.ArtifactPath == ar.ArtifactPath && t.Digest == ar.Digest {
+				matches = append(matches, t.ID)
+			}
+		}
+
+		if len(matches) != 1 {
+			m.skip("artifact reference", ar.ID, ar.Name, notFoundReason(len(matches), "artifact reference", "name, path and digest"))
+			continue
+		}
+
+		id := matches[0]
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add artifact reference %d (%s)", id, ar.Name),
+			Apply: func(release *pivnet.Release) error {
+				return m.target.AddArtifactReferenceToRelease(m.productSlug, id, release.ID)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+func (m *mapper) userGroupSteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	userGroups, err := m.source.UserGroupsForRelease(m.productSlug, source.ID)
+	if err != nil || len(userGroups) == 0 {
+		return nil, err
+	}
+
+	targetUserGroups, err := m.target.UserGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []releasebuilder.Step
+	for _, ug := range userGroups {
+		var matches []int
+		for _, t := range targetUserGroups {
+			if t.Name == ug.Name {
+				matches = append(matches, t.ID)
+			}
+		}
+
+		if len(matches) != 1 {
+			m.skip("user group", ug.ID, ug.Name, notFoundReason(len(matches), "user group", "name"))
+			continue
+		}
+
+		id := matches[0]
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add user group %d (%s)", id, ug.Name),
+			Apply: func(release *pivnet.Release) error {
+				return m.target.AddUserGroup(m.productSlug, release.ID, id)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+func (m *mapper) dependencySpecifierSteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	dependencySpecifiers, err := m.source.DependencySpecifiers(m.productSlug, source.ID)
+	if err != nil || len(dependencySpecifiers) == 0 {
+		return nil, err
+	}
+
+	targetProducts, err := m.target.Products()
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []releasebuilder.Step
+	for _, ds := range dependencySpecifiers {
+		dependentProductSlug, specifier := ds.Product.Slug, ds.Specifier
+
+		found := false
+		for _, p := range targetProducts {
+			if p.Slug == dependentProductSlug {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			m.skip(
+				"dependency specifier",
+				ds.ID,
+				fmt.Sprintf("%s %s", dependentProductSlug, specifier),
+				notFoundReason(0, "product", "slug"),
+			)
+			continue
+		}
+
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add dependency specifier %s %s", dependentProductSlug, specifier),
+			Apply: func(release *pivnet.Release) error {
+				_, err := m.target.CreateDependencySpecifier(m.productSlug, release.ID, dependentProductSlug, specifier)
+				return err
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+func (m *mapper) upgradePathSteps(source pivnet.Release) ([]releasebuilder.Step, error) {
+	upgradePaths, err := m.source.ReleaseUpgradePaths(m.productSlug, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []releasebuilder.Step
+	for _, u := range upgradePaths {
+		previous := u.Release
+
+		var matches []int
+		for _, t := range m.targetReleases {
+			if t.Version == previous.Version {
+				matches = append(matches, t.ID)
+			}
+		}
+
+		if len(matches) != 1 {
+			m.skip("upgrade path", previous.ID, previous.Version, notFoundReason(len(matches), "release", "version"))
+			continue
+		}
+
+		id := matches[0]
+		steps = append(steps, releasebuilder.Step{
+			Description: fmt.Sprintf("add upgrade path from %s", previous.Version),
+			Apply: func(release *pivnet.Release) error {
+				return m.target.AddReleaseUpgradePath(m.productSlug, release.ID, id)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+func (m *mapper) skip(kind string, id int, name string, reason string) {
+	m.unmapped = append(m.unmapped, Unmapped{
+		Type:   kind,
+		ID:     id,
+		Name:   name,
+		Reason: reason,
+	})
+}
+
+// notFoundReason explains why something matched count things on the target
+// rather than exactly one.
+func notFoundReason(count int, kind string, by string) string {
+	if count == 0 {
+		return fmt.Sprintf("no %s with the same %s on the target", kind, by)
+	}
+
+	return fmt.Sprintf("%d %ss with the same %s on the target", count, kind, by)
+}
@@ -0,0 +1,233 @@
+package releasecopy
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
+	CreateRelease(config pivnet.CreateReleaseConfig) (pivnet.Release, error)
+	UpdateRelease(productSlug string, release pivnet.Release) (pivnet.Release, error)
+	DeleteRelease(productSlug string, release pivnet.Release) error
+	EULAs() ([]pivnet.EULA, error)
+	Products() ([]pivnet.Product, error)
+	ProductFiles(productSlug string) ([]pivnet.ProductFile, error)
+	ProductFilesAddedToRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error)
+	AddProductFileToRelease(productSlug string, releaseID int, productFileID int) error
+	FileGroups(productSlug string) ([]pivnet.FileGroup, error)
+	FileGroupsForRelease(productSlug string, releaseID int) ([]pivnet.FileGroup, error)
+	AddFileGroupToRelease(productSlug string, fileGroupID int, releaseID int) error
+	ArtifactReferences(productSlug string) ([]pivnet.ArtifactReference, error)
+	ArtifactReferencesForRelease(productSlug string, releaseID int) ([]pivnet.ArtifactReference, error)
+	AddArtifactReferenceToRelease(productSlug string, artifactReferenceID int, releaseID int) error
+	UserGroups() ([]pivnet.UserGroup, error)
+	UserGroupsForRelease(productSlug string, releaseID int) ([]pivnet.UserGroup, error)
+	AddUserGroup(productSlug string, releaseID int, userGroupID int) error
+	DependencySpecifiers(productSlug string, releaseID int) ([]pivnet.DependencySpecifier, error)
+	CreateDependencySpecifier(productSlug string, releaseID int, dependentProductSlug string, specifier string) (pivnet.DependencySpecifier, error)
+	ReleaseUpgradePaths(productSlug string, releaseID int) ([]pivnet.ReleaseUpgradePath, error)
+	AddReleaseUpgradePath(productSlug string, releaseID int, previousReleaseID int) error
+}
+
+// Unmapped is something about the source release that has no counterpart on
+// the target host, and so was not copied.
+type Unmapped struct {
+	Type   string `json:"type" yaml:"type"`
+	ID     int    `json:"id" yaml:"id"`
+	Name   string `json:"name" yaml:"name"`
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// Report is what is printed after a release is copied.
+type Report struct {
+	Release  pivnet.Release `json:"release" yaml:"release"`
+	Unmapped []Unmapped     `json:"unmapped" yaml:"unmapped"`
+}
+
+type ReleaseCopyClient struct {
+	source       PivnetClient
+	target       PivnetClient
+	eh           errorhandler.ErrorHandler
+	format       string
+	outputWriter io.Writer
+	printer      printer.Printer
+	l            logger.Logger
+}
+
+func NewReleaseCopyClient(
+	source PivnetClient,
+	target PivnetClient,
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+	l logger.Logger,
+) *ReleaseCopyClient {
+	return &ReleaseCopyClient{
+		source:       source,
+		target:       target,
+		eh:           eh,
+		format:       format,
+		outputWriter: outputWriter,
+		printer:      printer,
+		l:            l,
+	}
+}
+
+// Copy reads the release releaseVersion of productSlug and its associations
+// from the source host and creates the same release on the target host.
+//
+// IDs differ between hosts, so the associations are mapped to what is
+// already on the target: the EULA by slug or name, product files by object
+// key, file groups, user groups and artifact references by name, upgrade
+// paths by version and dependency specifiers by product slug. Anything that
+// cannot be mapped is left out and reported. As with clone-release, the new
+// release is deleted if adding to it fails.
+func (c *ReleaseCopyClient) Copy(productSlug string, releaseVersion string) error {
+	source, err := c.source.ReleaseForConstraint(productSlug, releaseVersion)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	targetReleases, err := c.target.ReleasesForProductSlug(productSlug)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	for _, r := range targetReleases {
+		if r.Version == source.Version {
+			err := fmt.Errorf("release %s already exists for %s on the target", source.Version, productSlug)
+			return c.eh.HandleError(err)
+		}
+	}
+
+	eulaSlug, err := c.mapEULA(source)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	m := &mapper{
+		productSlug:    productSlug,
+		source:         c.source,
+		target:         c.target,
+		targetReleases: targetReleases,
+	}
+
+	steps, err := m.copySteps(source)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	config := pivnet.CreateReleaseConfig{
+		ProductSlug:           productSlug,
+		Version:               source.Version,
+		ReleaseType:           string(source.ReleaseType),
+		EULASlug:              eulaSlug,
+		ReleaseDate:           source.ReleaseDate,
+		Description:           source.Description,
+		ReleaseNotesURL:       source.ReleaseNotesURL,
+		Controlled:            source.Controlled,
+		ECCN:                  source.ECCN,
+		LicenseException:      source.LicenseException,
+		EndOfSupportDate:      source.EndOfSupportDate,
+		EndOfGuidanceDate:     source.EndOfGuidanceDate,
+		EndOfAvailabilityDate: source.EndOfAvailabilityDate,
+	}
+
+	release, err := c.target.CreateRelease(config)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	c.l.Info(fmt.Sprintf("Created release %s (%d) on the target", release.Version, release.ID))
+
+	builder := releasebuilder.NewBuilder(c.target, c.l)
+
+	err = builder.Build(productSlug, &release, source.Availability, steps)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	return c.printReport(Report{
+		Release:  release,
+		Unmapped: m.unmapped,
+	})
+}
+
+// mapEULA returns the slug of the EULA on the target that is the EULA of
+// source. A release cannot be created without a EULA, so a EULA that cannot
+// be mapped is an error.
+func (c *ReleaseCopyClient) mapEULA(source pivnet.Release) (string, error) {
+	if source.EULA == nil {
+		return "", fmt.Errorf("release %s has no EULA", source.Version)
+	}
+
+	eulas, err := c.target.EULAs()
+	if err != nil {
+		return "", err
+	}
+
+	for _, e := range eulas {
+		if e.Slug == source.EULA.Slug {
+			return e.Slug, nil
+		}
+	}
+
+	var matches []pivnet.EULA
+	for _, e := range eulas {
+		if source.EULA.Name != "" && e.Name == source.EULA.Name {
+			matches = append(matches, e)
+		}
+	}
+
+	if len(matches) != 1 {
+		return "", fmt.Errorf(
+			"EULA %s could not be mapped: %s",
+			source.EULA.Slug,
+			notFoundReason(len(matches), "EULA", "slug or name"),
+		)
+	}
+
+	return matches[0].Slug, nil
+}
+
+func (c *ReleaseCopyClient) printReport(report Report) error {
+	switch c.format {
+	case printer.PrintAsTable:
+		releasebuilder.PrintReleaseTable(c.outputWriter, report.Release)
+
+		if len(report.Unmapped) == 0 {
+			return nil
+		}
+
+		fmt.Fprintln(c.outputWriter, "\nCould not be mapped to the target:")
+
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{"Type", "ID", "Name", "Reason"})
+		for _, u := range report.Unmapped {
+			table.Append([]string{u.Type, strconv.Itoa(u.ID), u.Name, u.Reason})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		if report.Unmapped == nil {
+			report.Unmapped = []Unmapped{}
+		}
+		return c.printer.PrintJSON(report)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(report)
+	}
+
+	return nil
+}
@@ -0,0 +1,445 @@
+package releasecopy_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releasecopy"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releasecopy/releasecopyfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+var _ = Describe("releasecopy commands", func() {
+	var (
+		fakeSource       *releasecopyfakes.FakePivnetClient
+		fakeTarget       *releasecopyfakes.FakePivnetClient
+		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+		format    string
+
+		client *releasecopy.ReleaseCopyClient
+	)
+
+	printedReport := func() releasecopy.Report {
+		var report releasecopy.Report
+		err := json.Unmarshal(outBuffer.Bytes(), &report)
+		Expect(err).NotTo(HaveOccurred())
+		return report
+	}
+
+	BeforeEach(func() {
+		fakeSource = &releasecopyfakes.FakePivnetClient{}
+		fakeTarget = &releasecopyfakes.FakePivnetClient{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		outBuffer = bytes.Buffer{}
+		format = printer.PrintAsJSON
+
+		fakeSource.ReleaseForConstraintReturns(pivnet.Release{
+			ID:                    30,
+			Version:               "2.10.3",
+			ReleaseType:           "Minor Release",
+			EULA:                  &pivnet.EULA{Slug: "staging_eula", Name: "VMware EULA"},
+			ReleaseDate:           "2020-09-15",
+			Description:           "some description",
+			ReleaseNotesURL:       "https://example.com/notes",
+			Availability:          "All Users",
+			Controlled:            true,
+			ECCN:                  "5D002",
+			LicenseException:      "TSU",
+			EndOfSupportDate:      "2021-09-30",
+			EndOfGuidanceDate:     "2021-10-30",
+			EndOfAvailabilityDate: "2021-11-30",
+		}, nil)
+
+		fakeSource.ProductFilesAddedToReleaseReturns([]pivnet.ProductFile{
+			{ID: 1, Name: "mysql tile", AWSObjectKey: "product-files/p-mysql/p-mysql-2.10.3.pivotal"},
+			{ID: 2, Name: "mysql docs", AWSObjectKey: "product-files/p-mysql/docs.pdf"},
+		}, nil)
+		fakeSource.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+			{ID: 3, Name: "Stemcells"},
+		}, nil)
+		fakeSource.ArtifactReferencesForReleaseReturns([]pivnet.ArtifactReference{
+			{ID: 4, Name: "mysql image", ArtifactPath: "mysql/mysql", Digest: "sha256:abc"},
+		}, nil)
+		fakeSource.UserGroupsForReleaseReturns([]pivnet.UserGroup{
+			{ID: 5, Name: "partners"},
+		}, nil)
+		fakeSource.DependencySpecifiersReturns([]pivnet.DependencySpecifier{
+			{ID: 6, Product: pivnet.Product{Slug: "stemcells-ubuntu-xenial"}, Specifier: "456.*"},
+		}, nil)
+		fakeSource.ReleaseUpgradePathsReturns([]pivnet.ReleaseUpgradePath{
+			{Release: pivnet.UpgradePathRelease{ID: 10, Version: "2.10.1"}},
+		}, nil)
+
+		fakeTarget.ReleasesForProductSlugReturns([]pivnet.Release{
+			{ID: 110, Version: "2.10.1"},
+		}, nil)
+		fakeTarget.EULAsReturns([]pivnet.EULA{
+			{Slug: "vmware_eula", Name: "VMware EULA"},
+		}, nil)
+		fakeTarget.ProductFilesReturns([]pivnet.ProductFile{
+			{ID: 101, Name: "mysql tile", AWSObjectKey: "product-files/p-mysql/p-mysql-2.10.3.pivotal"},
+			{ID: 102, Name: "mysql docs", AWSObjectKey: "product-files/p-mysql/docs.pdf"},
+		}, nil)
+		fakeTarget.FileGroupsReturns([]pivnet.FileGroup{
+			{ID: 103, Name: "Stemcells"},
+		}, nil)
+		fakeTarget.ArtifactReferencesReturns([]pivnet.ArtifactReference{
+			{ID: 104, Name: "mysql image", ArtifactPath: "mysql/mysql", Digest: "sha256:abc"},
+		}, nil)
+		fakeTarget.UserGroupsReturns([]pivnet.UserGroup{
+			{ID: 105, Name: "partners"},
+		}, nil)
+		fakeTarget.ProductsReturns([]pivnet.Product{
+			{ID: 106, Slug: "stemcells-ubuntu-xenial"},
+		}, nil)
+
+		fakeTarget.CreateReleaseReturns(pivnet.Release{
+			ID:           199,
+			Version:      "2.10.3",
+			Availability: "Admins Only",
+		}, nil)
+		fakeTarget.UpdateReleaseStub = func(productSlug string, release pivnet.Release) (pivnet.Release, error) {
+			return release, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = releasecopy.NewReleaseCopyClient(
+			fakeSource,
+			fakeTarget,
+			fakeErrorHandler,
+			format,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+			l,
+		)
+	})
+
+	Describe("Copy", func() {
+		It("reads the release from the source and creates it on the target", func() {
+			err := client.Copy("p-mysql", "2.10.3")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSource.ReleaseForConstraintCallCount()).To(Equal(1))
+			productSlug, version := fakeSource.ReleaseForConstraintArgsForCall(0)
+			Expect(productSlug).To(Equal("p-mysql"))
+			Expect(version).To(Equal("2.10.3"))
+
+			Expect(fakeSource.CreateReleaseCallCount()).To(Equal(0))
+
+			Expect(fakeTarget.CreateReleaseCallCount()).To(Equal(1))
+			Expect(fakeTarget.CreateReleaseArgsForCall(0)).To(Equal(pivnet.CreateReleaseConfig{
+				ProductSlug:           "p-mysql",
+				Version:               "2.10.3",
+				ReleaseType:           "Minor Release",
+				EULASlug:              "vmware_eula",
+				ReleaseDate:           "2020-09-15",
+				Description:           "some description",
+				ReleaseNotesURL:       "https://example.com/notes",
+				Controlled:            true,
+				ECCN:                  "5D002",
+				LicenseException:      "TSU",
+				EndOfSupportDate:      "2021-09-30",
+				EndOfGuidanceDate:     "2021-10-30",
+				EndOfAvailabilityDate: "2021-11-30",
+			}))
+		})
+
+		It("adds the mapped associations to the release on the target", func() {
+			err := client.Copy("p-mysql", "2.10.3")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeTarget.AddProductFileToReleaseCallCount()).To(Equal(2))
+			_, releaseID, productFileID := fakeTarget.AddProductFileToReleaseArgsForCall(0)
+			Expect(releaseID).To(Equal(199))
+			Expect(productFileID).To(Equal(101))
+			_, _, productFileID = fakeTarget.AddProductFileToReleaseArgsForCall(1)
+			Expect(productFileID).To(Equal(102))
+
+			Expect(fakeTarget.AddFileGroupToReleaseCallCount()).To(Equal(1))
+			_, fileGroupID, releaseID := fakeTarget.AddFileGroupToReleaseArgsForCall(0)
+			Expect(fileGroupID).To(Equal(103))
+			Expect(releaseID).To(Equal(199))
+
+			Expect(fakeTarget.AddArtifactReferenceToReleaseCallCount()).To(Equal(1))
+			_, artifactReferenceID, releaseID := fakeTarget.AddArtifactReferenceToReleaseArgsForCall(0)
+			Expect(artifactReferenceID).To(Equal(104))
+			Expect(releaseID).To(Equal(199))
+
+			Expect(fakeTarget.AddUserGroupCallCount()).To(Equal(1))
+			_, releaseID, userGroupID := fakeTarget.AddUserGroupArgsForCall(0)
+			Expect(releaseID).To(Equal(199))
+			Expect(userGroupID).To(Equal(105))
+
+			Expect(fakeTarget.CreateDependencySpecifierCallCount()).To(Equal(1))
+			_, releaseID, dependentProductSlug, specifier := fakeTarget.CreateDependencySpecifierArgsForCall(0)
+			Expect(releaseID).To(Equal(199))
+			Expect(dependentProductSlug).To(Equal("stemcells-ubuntu-xenial"))
+			Expect(specifier).To(Equal("456.*"))
+
+			Expect(fakeTarget.AddReleaseUpgradePathCallCount()).To(Equal(1))
+			_, releaseID, previousReleaseID := fakeTarget.AddReleaseUpgradePathArgsForCall(0)
+			Expect(releaseID).To(Equal(199))
+			Expect(previousReleaseID).To(Equal(110))
+
+			Expect(fakeTarget.UpdateReleaseCallCount()).To(Equal(1))
+			_, release := fakeTarget.UpdateReleaseArgsForCall(0)
+			Expect(release.Availability).To(Equal("All Users"))
+
+			Expect(fakeTarget.DeleteReleaseCallCount()).To(Equal(0))
+		})
+
+		It("prints the release and an empty report", func() {
+			err := client.Copy("p-mysql", "2.10.3")
+			Expect(err).NotTo(HaveOccurred())
+
+			report := printedReport()
+			Expect(report.Release.ID).To(Equal(199))
+			Expect(report.Release.Availability).To(Equal("All Users"))
+			Expect(report.Unmapped).To(BeEmpty())
+			Expect(outBuffer.String()).To(ContainSubstring(`"unmapped":[]`))
+		})
+
+		Context("when associations cannot be mapped", func() {
+			BeforeEach(func() {
+				fakeTarget.ProductFilesReturns([]pivnet.ProductFile{
+					{ID: 101, Name: "mysql tile", AWSObjectKey: "product-files/p-mysql/p-mysql-2.10.3.pivotal"},
+				}, nil)
+				fakeTarget.FileGroupsReturns([]pivnet.FileGroup{
+					{ID: 103, Name: "Stemcells"},
+					{ID: 113, Name: "Stemcells"},
+				}, nil)
+				fakeTarget.ArtifactReferencesReturns([]pivnet.ArtifactReference{
+					{ID: 104, Name: "mysql image", ArtifactPath: "mysql/mysql", Digest: "sha256:def"},
+				}, nil)
+				fakeTarget.UserGroupsReturns(nil, nil)
+				fakeTarget.ProductsReturns(nil, nil)
+				fakeTarget.ReleasesForProductSlugReturns(nil, nil)
+			})
+
+			It("copies the rest and reports what could not be mapped", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTarget.AddProductFileToReleaseCallCount()).To(Equal(1))
+				Expect(fakeTarget.AddFileGroupToReleaseCallCount()).To(Equal(0))
+				Expect(fakeTarget.AddArtifactReferenceToReleaseCallCount()).To(Equal(0))
+				Expect(fakeTarget.AddUserGroupCallCount()).To(Equal(0))
+				Expect(fakeTarget.CreateDependencySpecifierCallCount()).To(Equal(0))
+				Expect(fakeTarget.AddReleaseUpgradePathCallCount()).To(Equal(0))
+
+				Expect(printedReport().Unmapped).To(Equal([]releasecopy.Unmapped{
+					{Type: "product file", ID: 2, Name: "mysql docs", Reason: "no product file with the same object key on the target"},
+					{Type: "file group", ID: 3, Name: "Stemcells", Reason: "2 file groups with the same name on the target"},
+					{Type: "artifact reference", ID: 4, Name: "mysql image", Reason: "no artifact reference with the same name, path and digest on the target"},
+					{Type: "user group", ID: 5, Name: "partners", Reason: "no user group with the same name on the target"},
+					{Type: "dependency specifier", ID: 6, Name: "stemcells-ubuntu-xenial 456.*", Reason: "no product with the same slug on the target"},
+					{Type: "upgrade path", ID: 10, Name: "2.10.1", Reason: "no release with the same version on the target"},
+				}))
+			})
+
+			Context("when the format is table", func() {
+				BeforeEach(func() {
+					format = printer.PrintAsTable
+				})
+
+				It("prints the report after the release", func() {
+					err := client.Copy("p-mysql", "2.10.3")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(outBuffer.String()).To(ContainSubstring("Could not be mapped to the target:"))
+					Expect(outBuffer.String()).To(ContainSubstring("partners"))
+				})
+			})
+		})
+
+		Context("when a file group of the source release has product files", func() {
+			BeforeEach(func() {
+				fakeSource.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+					{
+						ID:   3,
+						Name: "Stemcells",
+						ProductFiles: []pivnet.ProductFile{
+							{ID: 7, Name: "stemcell", AWSObjectKey: "product-files/stemcells/stemcell.tgz"},
+						},
+					},
+				}, nil)
+				fakeTarget.ProductFilesReturns([]pivnet.ProductFile{
+					{ID: 101, Name: "mysql tile", AWSObjectKey: "product-files/p-mysql/p-mysql-2.10.3.pivotal"},
+					{ID: 102, Name: "mysql docs", AWSObjectKey: "product-files/p-mysql/docs.pdf"},
+					{ID: 107, Name: "stemcell", AWSObjectKey: "product-files/stemcells/stemcell.tgz"},
+				}, nil)
+			})
+
+			It("adds them only with the file group", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTarget.AddProductFileToReleaseCallCount()).To(Equal(2))
+				for i := 0; i < fakeTarget.AddProductFileToReleaseCallCount(); i++ {
+					_, _, productFileID := fakeTarget.AddProductFileToReleaseArgsForCall(i)
+					Expect(productFileID).NotTo(Equal(107))
+				}
+
+				Expect(fakeTarget.AddFileGroupToReleaseCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a product file of the source release has no object key", func() {
+			BeforeEach(func() {
+				fakeSource.ProductFilesAddedToReleaseReturns([]pivnet.ProductFile{
+					{ID: 1, Name: "mysql tile"},
+				}, nil)
+			})
+
+			It("reports it", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTarget.AddProductFileToReleaseCallCount()).To(Equal(0))
+				Expect(printedReport().Unmapped).To(ConsistOf(releasecopy.Unmapped{
+					Type: "product file", ID: 1, Name: "mysql tile", Reason: "it has no object key",
+				}))
+			})
+		})
+
+		Context("when the EULA slug differs on the target", func() {
+			It("maps the EULA by name", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTarget.CreateReleaseArgsForCall(0).EULASlug).To(Equal("vmware_eula"))
+			})
+		})
+
+		Context("when the EULA cannot be mapped", func() {
+			BeforeEach(func() {
+				fakeTarget.EULAsReturns([]pivnet.EULA{
+					{Slug: "other_eula", Name: "Other EULA"},
+				}, nil)
+			})
+
+			It("invokes the error handler without creating the release", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"EULA staging_eula could not be mapped: no EULA with the same slug or name on the target"))
+
+				Expect(fakeTarget.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the release already exists on the target", func() {
+			BeforeEach(func() {
+				fakeTarget.ReleasesForProductSlugReturns([]pivnet.Release{
+					{ID: 130, Version: "2.10.3"},
+				}, nil)
+			})
+
+			It("invokes the error handler without creating the release", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"release 2.10.3 already exists for p-mysql on the target"))
+
+				Expect(fakeTarget.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the release from the source returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("release error")
+				fakeSource.ReleaseForConstraintReturns(pivnet.Release{}, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+				Expect(fakeTarget.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when reading from the target returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("user groups error")
+				fakeTarget.UserGroupsReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler without creating the release", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+				Expect(fakeTarget.CreateReleaseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when adding to the release on the target fails partway", func() {
+			BeforeEach(func() {
+				fakeTarget.AddUserGroupReturns(errors.New("user group error"))
+			})
+
+			It("deletes the release on the target and invokes the error handler", func() {
+				err := client.Copy("p-mysql", "2.10.3")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTarget.DeleteReleaseCallCount()).To(Equal(1))
+				productSlug, release := fakeTarget.DeleteReleaseArgsForCall(0)
+				Expect(productSlug).To(Equal("p-mysql"))
+				Expect(release.ID).To(Equal(199))
+
+				Expect(fakeSource.DeleteReleaseCallCount()).To(Equal(0))
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+					"failed to add user group 105 (partners): user group error (the partial release 2.10.3 was deleted)"))
+
+				Expect(outBuffer.String()).To(BeEmpty())
+			})
+
+			Context("when deleting the release also fails", func() {
+				BeforeEach(func() {
+					fakeTarget.DeleteReleaseReturns(errors.New("delete error"))
+				})
+
+				It("reports that the partial release is left behind", func() {
+					err := client.Copy("p-mysql", "2.10.3")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(MatchError(
+						"failed to add user group 105 (partners): user group error (deleting the partial release 2.10.3 also failed: delete error, delete it with delete-release)"))
+				})
+			})
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 //go:generate counterfeiter . PivnetClient
 type PivnetClient interface {
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	ReleaseDependencies(productSlug string, releaseID int) ([]pivnet.ReleaseDependency, error)
 	AddReleaseDependency(productSlug string, releaseID int, dependentReleaseID int) error
 	RemoveReleaseDependency(productSlug string, releaseID int, dependentReleaseID int) error
@@ -45,7 +46,7 @@ func NewReleaseDependencyClient(
 }
 
 func (c *ReleaseDependencyClient) List(productSlug string, releaseVersion string) error {
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
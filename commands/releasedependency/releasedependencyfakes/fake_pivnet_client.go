@@ -36,6 +36,20 @@ type FakePivnetClient struct {
 		result1 []pivnet.ReleaseDependency
 		result2 error
 	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -75,15 +89,16 @@ func (fake *FakePivnetClient) AddReleaseDependency(arg1 string, arg2 int, arg3 i
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddReleaseDependencyStub
+	fakeReturns := fake.addReleaseDependencyReturns
 	fake.recordInvocation("AddReleaseDependency", []interface{}{arg1, arg2, arg3})
 	fake.addReleaseDependencyMutex.Unlock()
-	if fake.AddReleaseDependencyStub != nil {
-		return fake.AddReleaseDependencyStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addReleaseDependencyReturns
 	return fakeReturns.result1
 }
 
@@ -136,15 +151,16 @@ func (fake *FakePivnetClient) ReleaseDependencies(arg1 string, arg2 int) ([]pivn
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.ReleaseDependenciesStub
+	fakeReturns := fake.releaseDependenciesReturns
 	fake.recordInvocation("ReleaseDependencies", []interface{}{arg1, arg2})
 	fake.releaseDependenciesMutex.Unlock()
-	if fake.ReleaseDependenciesStub != nil {
-		return fake.ReleaseDependenciesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseDependenciesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -193,6 +209,71 @@ func (fake *FakePivnetClient) ReleaseDependenciesReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -200,15 +281,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -265,15 +347,16 @@ func (fake *FakePivnetClient) RemoveReleaseDependency(arg1 string, arg2 int, arg
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveReleaseDependencyStub
+	fakeReturns := fake.removeReleaseDependencyReturns
 	fake.recordInvocation("RemoveReleaseDependency", []interface{}{arg1, arg2, arg3})
 	fake.removeReleaseDependencyMutex.Unlock()
-	if fake.RemoveReleaseDependencyStub != nil {
-		return fake.RemoveReleaseDependencyStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeReleaseDependencyReturns
 	return fakeReturns.result1
 }
 
@@ -326,6 +409,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.addReleaseDependencyMutex.RUnlock()
 	fake.releaseDependenciesMutex.RLock()
 	defer fake.releaseDependenciesMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
 	fake.removeReleaseDependencyMutex.RLock()
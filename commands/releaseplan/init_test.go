@@ -0,0 +1,13 @@
+package releaseplan_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReleasePlan(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReleasePlan Suite")
+}
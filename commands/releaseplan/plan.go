@@ -0,0 +1,710 @@
+package releaseplan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder"
+)
+
+// The actions a change can take.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionAdd    = "add"
+	ActionRemove = "remove"
+)
+
+// The resources a change can be made to.
+const (
+	ResourceRelease             = "release"
+	ResourceProductFile         = "product_file"
+	ResourceFileGroup           = "file_group"
+	ResourceArtifactReference   = "artifact_reference"
+	ResourceUserGroup           = "user_group"
+	ResourceUpgradePath         = "upgrade_path"
+	ResourceDependencySpecifier = "dependency_specifier"
+)
+
+// defaultAvailability is the availability that Pivnet gives new releases.
+const defaultAvailability = "Admins Only"
+
+// Plan is the changes that make a release match a Spec, in the order they
+// are applied.
+type Plan struct {
+	ProductSlug string   `json:"product_slug" yaml:"product_slug"`
+	Version     string   `json:"version" yaml:"version"`
+	ReleaseID   int      `json:"release_id,omitempty" yaml:"release_id,omitempty"`
+	Changes     []Change `json:"changes" yaml:"changes"`
+}
+
+type Change struct {
+	Action   string `json:"action" yaml:"action"`
+	Resource string `json:"resource" yaml:"resource"`
+	ID       int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name     string `json:"name" yaml:"name"`
+	Detail   string `json:"detail,omitempty" yaml:"detail,omitempty"`
+
+	// apply makes the change to release. The change that creates the
+	// release sets its ID for the changes after it.
+	apply func(release *pivnet.Release) error
+}
+
+// named is a product file, file group, artifact reference or user group.
+type named struct {
+	ID   int
+	Name string
+}
+
+// planner works out the changes to a single release. release is nil when the
+// release does not exist yet, and availability is the change that sets the
+// availability of a release that is created.
+type planner struct {
+	client       PivnetClient
+	spec         Spec
+	slug         string
+	release      *pivnet.Release
+	changes      []Change
+	availability *Change
+}
+
+func newPlan(client PivnetClient, spec Spec) (Plan, error) {
+	p := &planner{
+		client: client,
+		spec:   spec,
+		slug:   spec.ProductSlug,
+	}
+
+	releases, err := client.ReleasesForProductSlug(p.slug)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	for _, r := range releases {
+		if r.Version == spec.Release.Version {
+			release, err := client.Release(p.slug, r.ID)
+			if err != nil {
+				return Plan{}, err
+			}
+			p.release = &release
+			break
+		}
+	}
+
+	steps := []func() error{
+		p.planRelease,
+		p.planProductFiles,
+		p.planFileGroups,
+		p.planArtifactReferences,
+		p.planUserGroups,
+		func() error { return p.planUpgradePaths(releases) },
+		p.planDependencySpecifiers,
+	}
+
+	for _, step := range steps {
+		err := step()
+		if err != nil {
+			return Plan{}, err
+		}
+	}
+
+	if p.availability != nil {
+		p.add(*p.availability)
+	}
+
+	plan := Plan{
+		ProductSlug: p.slug,
+		Version:     spec.Release.Version,
+		Changes:     p.changes,
+	}
+
+	if p.release != nil {
+		plan.ReleaseID = p.release.ID
+	}
+
+	return plan, nil
+}
+
+func (p *planner) add(change Change) {
+	p.changes = append(p.changes, change)
+}
+
+func (p *planner) planRelease() error {
+	desired := p.spec.Release
+
+	if p.release == nil {
+		if desired.ReleaseType == "" || desired.EULASlug == "" {
+			return fmt.Errorf(
+				"release %s %s does not exist, release.release_type and release.eula_slug are required to create it",
+				p.slug,
+				desired.Version,
+			)
+		}
+
+		err := p.validateReleaseType(desired.ReleaseType)
+		if err != nil {
+			return err
+		}
+
+		err = p.validateEULA(desired.EULASlug)
+		if err != nil {
+			return err
+		}
+
+		config := pivnet.CreateReleaseConfig{
+			ProductSlug:           p.slug,
+			Version:               desired.Version,
+			ReleaseType:           desired.ReleaseType,
+			EULASlug:              desired.EULASlug,
+			ReleaseDate:           desired.ReleaseDate,
+			Description:           desired.Description,
+			ReleaseNotesURL:       desired.ReleaseNotesURL,
+			ECCN:                  desired.ECCN,
+			LicenseException:      desired.LicenseException,
+			EndOfSupportDate:      desired.EndOfSupportDate,
+			EndOfGuidanceDate:     desired.EndOfGuidanceDate,
+			EndOfAvailabilityDate: desired.EndOfAvailabilityDate,
+		}
+		if desired.Controlled != nil {
+			config.Controlled = *desired.Controlled
+		}
+
+		p.add(Change{
+			Action:   ActionCreate,
+			Resource: ResourceRelease,
+			Name:     desired.Version,
+			Detail:   fmt.Sprintf("release_type: '%s', eula_slug: '%s'", desired.ReleaseType, desired.EULASlug),
+			apply: func(release *pivnet.Release) error {
+				created, err := p.client.CreateRelease(config)
+				if err != nil {
+					return err
+				}
+				*release = created
+				return nil
+			},
+		})
+
+		// The availability is set after the other changes, so that the
+		// release is not visible to users before it is complete.
+		if desired.Availability != "" && desired.Availability != defaultAvailability {
+			p.availability = &Change{
+				Action:   ActionUpdate,
+				Resource: ResourceRelease,
+				Name:     desired.Version,
+				Detail:   fmt.Sprintf("availability: '%s' -> '%s'", defaultAvailability, desired.Availability),
+				apply:    releasebuilder.SetAvailability(p.client, p.slug, desired.Availability).Apply,
+			}
+		}
+
+		return nil
+	}
+
+	updated := *p.release
+	var details []string
+
+	setString := func(field string, current *string, value string) {
+		if value == "" || *current == value {
+			return
+		}
+		details = append(details, fmt.Sprintf("%s: '%s' -> '%s'", field, *current, value))
+		*current = value
+	}
+
+	releaseType := string(updated.ReleaseType)
+	setString("release_type", &releaseType, desired.ReleaseType)
+	updated.ReleaseType = pivnet.ReleaseType(releaseType)
+
+	var eulaSlug string
+	if updated.EULA != nil {
+		eulaSlug = updated.EULA.Slug
+	}
+	setString("eula_slug", &eulaSlug, desired.EULASlug)
+	if eulaSlug != "" {
+		updated.EULA = &pivnet.EULA{Slug: eulaSlug}
+	}
+
+	setString("release_date", &updated.ReleaseDate, desired.ReleaseDate)
+	setString("description", &updated.Description, desired.Description)
+	setString("release_notes_url", &updated.ReleaseNotesURL, desired.ReleaseNotesURL)
+	setString("availability", &updated.Availability, desired.Availability)
+	setString("eccn", &updated.ECCN, desired.ECCN)
+	setString("license_exception", &updated.LicenseException, desired.LicenseException)
+	setString("end_of_support_date", &updated.EndOfSupportDate, desired.EndOfSupportDate)
+	setString("end_of_guidance_date", &updated.EndOfGuidanceDate, desired.EndOfGuidanceDate)
+	setString("end_of_availability_date", &updated.EndOfAvailabilityDate, desired.EndOfAvailabilityDate)
+
+	if desired.Controlled != nil && *desired.Controlled != updated.Controlled {
+		// Pivnet leaves out fields that are false, so an update cannot
+		// unset controlled.
+		if !*desired.Controlled {
+			return fmt.Errorf(
+				"release %s %s is controlled, and that cannot be changed to false",
+				p.slug,
+				desired.Version,
+			)
+		}
+		details = append(details, "controlled: false -> true")
+		updated.Controlled = true
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	if desired.ReleaseType != "" && updated.ReleaseType != p.release.ReleaseType {
+		err := p.validateReleaseType(desired.ReleaseType)
+		if err != nil {
+			return err
+		}
+	}
+
+	if desired.EULASlug != "" && (p.release.EULA == nil || p.release.EULA.Slug != desired.EULASlug) {
+		err := p.validateEULA(desired.EULASlug)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.add(Change{
+		Action:   ActionUpdate,
+		Resource: ResourceRelease,
+		ID:       p.release.ID,
+		Name:     desired.Version,
+		Detail:   strings.Join(details, ", "),
+		apply: func(release *pivnet.Release) error {
+			result, err := p.client.UpdateRelease(p.slug, updated)
+			if err != nil {
+				return err
+			}
+			*release = result
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (p *planner) validateReleaseType(releaseType string) error {
+	releaseTypes, err := p.client.ReleaseTypes()
+	if err != nil {
+		return err
+	}
+
+	var valid []string
+	for _, t := range releaseTypes {
+		if string(t) == releaseType {
+			return nil
+		}
+		valid = append(valid, fmt.Sprintf("'%s'", t))
+	}
+
+	return fmt.Errorf("release_type '%s' must be one of: %s", releaseType, strings.Join(valid, ", "))
+}
+
+func (p *planner) validateEULA(eulaSlug string) error {
+	eulas, err := p.client.EULAs()
+	if err != nil {
+		return err
+	}
+
+	var valid []string
+	for _, e := range eulas {
+		if e.Slug == eulaSlug {
+			return nil
+		}
+		valid = append(valid, fmt.Sprintf("'%s'", e.Slug))
+	}
+
+	return fmt.Errorf("eula_slug '%s' must be one of: %s", eulaSlug, strings.Join(valid, ", "))
+}
+
+func (p *planner) planProductFiles() error {
+	if p.spec.ProductFiles == nil {
+		return nil
+	}
+
+	productFiles, err := p.client.ProductFiles(p.slug)
+	if err != nil {
+		return err
+	}
+
+	var current []pivnet.ProductFile
+	if p.release != nil {
+		current, err = p.client.ProductFilesAddedToRelease(p.slug, p.release.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	all := make([]named, len(productFiles))
+	for i, pf := range productFiles {
+		all[i] = named{ID: pf.ID, Name: pf.Name}
+	}
+
+	existing := make([]named, len(current))
+	for i, pf := range current {
+		existing[i] = named{ID: pf.ID, Name: pf.Name}
+	}
+
+	return p.planRefs(
+		ResourceProductFile,
+		*p.spec.ProductFiles,
+		all,
+		existing,
+		func(releaseID int, id int) error {
+			return p.client.AddProductFileToRelease(p.slug, releaseID, id)
+		},
+		func(releaseID int, id int) error {
+			return p.client.RemoveProductFileFromRelease(p.slug, releaseID, id)
+		},
+	)
+}
+
+func (p *planner) planFileGroups() error {
+	if p.spec.FileGroups == nil {
+		return nil
+	}
+
+	fileGroups, err := p.client.FileGroups(p.slug)
+	if err != nil {
+		return err
+	}
+
+	var current []pivnet.FileGroup
+	if p.release != nil {
+		current, err = p.client.FileGroupsForRelease(p.slug, p.release.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	all := make([]named, len(fileGroups))
+	for i, fg := range fileGroups {
+		all[i] = named{ID: fg.ID, Name: fg.Name}
+	}
+
+	existing := make([]named, len(current))
+	for i, fg := range current {
+		existing[i] = named{ID: fg.ID, Name: fg.Name}
+	}
+
+	return p.planRefs(
+		ResourceFileGroup,
+		*p.spec.FileGroups,
+		all,
+		existing,
+		func(releaseID int, id int) error {
+			return p.client.AddFileGroupToRelease(p.slug, id, releaseID)
+		},
+		func(releaseID int, id int) error {
+			return p.client.RemoveFileGroupFromRelease(p.slug, id, releaseID)
+		},
+	)
+}
+
+func (p *planner) planArtifactReferences() error {
+	if p.spec.ArtifactReferences == nil {
+		return nil
+	}
+
+	artifactReferences, err := p.client.ArtifactReferences(p.slug)
+	if err != nil {
+		return err
+	}
+
+	var current []pivnet.ArtifactReference
+	if p.release != nil {
+		current, err = p.client.ArtifactReferencesForRelease(p.slug, p.release.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	all := make([]named, len(artifactReferences))
+	for i, ar := range artifactReferences {
+		all[i] = named{ID: ar.ID, Name: ar.Name}
+	}
+
+	existing := make([]named, len(current))
+	for i, ar := range current {
+		existing[i] = named{ID: ar.ID, Name: ar.Name}
+	}
+
+	return p.planRefs(
+		ResourceArtifactReference,
+		*p.spec.ArtifactReferences,
+		all,
+		existing,
+		func(releaseID int, id int) error {
+			return p.client.AddArtifactReferenceToRelease(p.slug, id, releaseID)
+		},
+		func(releaseID int, id int) error {
+			return p.client.RemoveArtifactReferenceFromRelease(p.slug, id, releaseID)
+		},
+	)
+}
+
+func (p *planner) planUserGroups() error {
+	if p.spec.UserGroups == nil {
+		return nil
+	}
+
+	userGroups, err := p.client.UserGroups()
+	if err != nil {
+		return err
+	}
+
+	var current []pivnet.UserGroup
+	if p.release != nil {
+		current, err = p.client.UserGroupsForRelease(p.slug, p.release.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	all := make([]named, len(userGroups))
+	for i, ug := range userGroups {
+		all[i] = named{ID: ug.ID, Name: ug.Name}
+	}
+
+	existing := make([]named, len(current))
+	for i, ug := range current {
+		existing[i] = named{ID: ug.ID, Name: ug.Name}
+	}
+
+	return p.planRefs(
+		ResourceUserGroup,
+		*p.spec.UserGroups,
+		all,
+		existing,
+		func(releaseID int, id int) error {
+			return p.client.AddUserGroup(p.slug, releaseID, id)
+		},
+		func(releaseID int, id int) error {
+			return p.client.RemoveUserGroup(p.slug, releaseID, id)
+		},
+	)
+}
+
+// planRefs adds the items that refs resolve to, among all, that the release
+// does not have yet, then removes the existing items that refs do not
+// resolve to.
+func (p *planner) planRefs(
+	resource string,
+	refs []Ref,
+	all []named,
+	existing []named,
+	addFunc func(releaseID int, id int) error,
+	removeFunc func(releaseID int, id int) error,
+) error {
+	desired := make(map[int]bool)
+	var toAdd []named
+
+	for _, ref := range refs {
+		item, err := resolve(resource, ref, all)
+		if err != nil {
+			return err
+		}
+
+		if desired[item.ID] {
+			continue
+		}
+		desired[item.ID] = true
+
+		if !containsID(existing, item.ID) {
+			toAdd = append(toAdd, item)
+		}
+	}
+
+	for _, item := range toAdd {
+		id := item.ID
+		p.add(Change{
+			Action:   ActionAdd,
+			Resource: resource,
+			ID:       id,
+			Name:     item.Name,
+			apply: func(release *pivnet.Release) error {
+				return addFunc(release.ID, id)
+			},
+		})
+	}
+
+	for _, item := range existing {
+		if desired[item.ID] {
+			continue
+		}
+
+		id := item.ID
+		p.add(Change{
+			Action:   ActionRemove,
+			Resource: resource,
+			ID:       id,
+			Name:     item.Name,
+			apply: func(release *pivnet.Release) error {
+				return removeFunc(release.ID, id)
+			},
+		})
+	}
+
+	return nil
+}
+
+// planUpgradePaths matches the versions of previous releases exactly, among
+// the releases of the product.
+func (p *planner) planUpgradePaths(releases []pivnet.Release) error {
+	if p.spec.UpgradePaths == nil {
+		return nil
+	}
+
+	var current []pivnet.ReleaseUpgradePath
+	if p.release != nil {
+		var err error
+		current, err = p.client.ReleaseUpgradePaths(p.slug, p.release.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	all := make([]named, len(releases))
+	for i, r := range releases {
+		all[i] = named{ID: r.ID, Name: r.Version}
+	}
+
+	existing := make([]named, len(current))
+	for i, u := range current {
+		existing[i] = named{ID: u.Release.ID, Name: u.Release.Version}
+	}
+
+	refs := make([]Ref, len(*p.spec.UpgradePaths))
+	for i, version := range *p.spec.UpgradePaths {
+		if version == p.spec.Release.Version {
+			return fmt.Errorf("upgrade_paths cannot contain the version of the release itself: '%s'", version)
+		}
+		refs[i] = Ref{Name: version}
+	}
+
+	return p.planRefs(
+		ResourceUpgradePath,
+		refs,
+		all,
+		existing,
+		func(releaseID int, id int) error {
+			return p.client.AddReleaseUpgradePath(p.slug, releaseID, id)
+		},
+		func(releaseID int, id int) error {
+			return p.client.RemoveReleaseUpgradePath(p.slug, releaseID, id)
+		},
+	)
+}
+
+func (p *planner) planDependencySpecifiers() error {
+	if p.spec.DependencySpecifiers == nil {
+		return nil
+	}
+
+	var current []pivnet.DependencySpecifier
+	if p.release != nil {
+		var err error
+		current, err = p.client.DependencySpecifiers(p.slug, p.release.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	key := func(productSlug string, specifier string) string {
+		return productSlug + " " + specifier
+	}
+
+	existing := make(map[string]bool)
+	for _, d := range current {
+		existing[key(d.Product.Slug, d.Specifier)] = true
+	}
+
+	desired := make(map[string]bool)
+	for _, d := range *p.spec.DependencySpecifiers {
+		k := key(d.ProductSlug, d.Specifier)
+		if desired[k] {
+			continue
+		}
+		desired[k] = true
+
+		if existing[k] {
+			continue
+		}
+
+		productSlug, specifier := d.ProductSlug, d.Specifier
+		p.add(Change{
+			Action:   ActionAdd,
+			Resource: ResourceDependencySpecifier,
+			Name:     k,
+			apply: func(release *pivnet.Release) error {
+				_, err := p.client.CreateDependencySpecifier(p.slug, release.ID, productSlug, specifier)
+				return err
+			},
+		})
+	}
+
+	for _, d := range current {
+		k := key(d.Product.Slug, d.Specifier)
+		if desired[k] {
+			continue
+		}
+
+		id := d.ID
+		p.add(Change{
+			Action:   ActionRemove,
+			Resource: ResourceDependencySpecifier,
+			ID:       id,
+			Name:     k,
+			apply: func(release *pivnet.Release) error {
+				return p.client.DeleteDependencySpecifier(p.slug, release.ID, id)
+			},
+		})
+	}
+
+	return nil
+}
+
+// resolve finds the item that ref refers to, by ID or by a name that must
+// be unique.
+func resolve(resource string, ref Ref, all []named) (named, error) {
+	if ref.ID != 0 {
+		for _, item := range all {
+			if item.ID == ref.ID {
+				return item, nil
+			}
+		}
+		return named{}, fmt.Errorf("no %s found with id %d", resource, ref.ID)
+	}
+
+	var matches []named
+	for _, item := range all {
+		if item.Name == ref.Name {
+			matches = append(matches, item)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return named{}, fmt.Errorf("no %s found named '%s'", resource, ref.Name)
+	case 1:
+		return matches[0], nil
+	default:
+		return named{}, fmt.Errorf(
+			"%d of type %s are named '%s', refer to it by id instead",
+			len(matches),
+			resource,
+			ref.Name,
+		)
+	}
+}
+
+func containsID(items []named, id int) bool {
+	for _, item := range items {
+		if item.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package releaseplan
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+	"github.com/pivotal-cf/pivnet-cli/v3/ui"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
+	Release(productSlug string, releaseID int) (pivnet.Release, error)
+	CreateRelease(config pivnet.CreateReleaseConfig) (pivnet.Release, error)
+	UpdateRelease(productSlug string, release pivnet.Release) (pivnet.Release, error)
+	EULAs() ([]pivnet.EULA, error)
+	ReleaseTypes() ([]pivnet.ReleaseType, error)
+	ProductFiles(productSlug string) ([]pivnet.ProductFile, error)
+	ProductFilesAddedToRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error)
+	AddProductFileToRelease(productSlug string, releaseID int, productFileID int) error
+	RemoveProductFileFromRelease(productSlug string, releaseID int, productFileID int) error
+	FileGroups(productSlug string) ([]pivnet.FileGroup, error)
+	FileGroupsForRelease(productSlug string, releaseID int) ([]pivnet.FileGroup, error)
+	AddFileGroupToRelease(productSlug string, fileGroupID int, releaseID int) error
+	RemoveFileGroupFromRelease(productSlug string, fileGroupID int, releaseID int) error
+	ArtifactReferences(productSlug string) ([]pivnet.ArtifactReference, error)
+	ArtifactReferencesForRelease(productSlug string, releaseID int) ([]pivnet.ArtifactReference, error)
+	AddArtifactReferenceToRelease(productSlug string, artifactReferenceID int, releaseID int) error
+	RemoveArtifactReferenceFromRelease(productSlug string, artifactReferenceID int, releaseID int) error
+	UserGroups() ([]pivnet.UserGroup, error)
+	UserGroupsForRelease(productSlug string, releaseID int) ([]pivnet.UserGroup, error)
+	AddUserGroup(productSlug string, releaseID int, userGroupID int) error
+	RemoveUserGroup(productSlug string, releaseID int, userGroupID int) error
+	ReleaseUpgradePaths(productSlug string, releaseID int) ([]pivnet.ReleaseUpgradePath, error)
+	AddReleaseUpgradePath(productSlug string, releaseID int, previousReleaseID int) error
+	RemoveReleaseUpgradePath(productSlug string, releaseID int, previousReleaseID int) error
+	DependencySpecifiers(productSlug string, releaseID int) ([]pivnet.DependencySpecifier, error)
+	CreateDependencySpecifier(productSlug string, releaseID int, dependentProductSlug string, specifier string) (pivnet.DependencySpecifier, error)
+	DeleteDependencySpecifier(productSlug string, releaseID int, dependencySpecifierID int) error
+}
+
+type ReleasePlanClient struct {
+	pivnetClient PivnetClient
+	eh           errorhandler.ErrorHandler
+	format       string
+	outputWriter io.Writer
+	printer      printer.Printer
+	l            logger.Logger
+}
+
+func NewReleasePlanClient(
+	pivnetClient PivnetClient,
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+	l logger.Logger,
+) *ReleasePlanClient {
+	return &ReleasePlanClient{
+		pivnetClient: pivnetClient,
+		eh:           eh,
+		format:       format,
+		outputWriter: outputWriter,
+		printer:      printer,
+		l:            l,
+	}
+}
+
+// Plan prints the changes that would make the release match the spec at
+// specPath, without making them.
+func (c *ReleasePlanClient) Plan(specPath string) error {
+	plan, err := c.plan(specPath)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	return c.printPlan(plan)
+}
+
+// Apply prints the changes that make the release match the spec at specPath
+// and then makes them, in order. If a change fails, the changes before it
+// stay made, and applying the spec again plans only the rest.
+func (c *ReleasePlanClient) Apply(specPath string) error {
+	plan, err := c.plan(specPath)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	err = c.printPlan(plan)
+	if err != nil {
+		return err
+	}
+
+	if len(plan.Changes) == 0 {
+		return nil
+	}
+
+	release := pivnet.Release{ID: plan.ReleaseID}
+
+	for i, change := range plan.Changes {
+		c.l.Info(fmt.Sprintf(
+			"Applying change %d of %d: %s %s %s",
+			i+1,
+			len(plan.Changes),
+			change.Action,
+			change.Resource,
+			change.Name,
+		))
+
+		err := change.apply(&release)
+		if err != nil {
+			err = fmt.Errorf(
+				"failed to %s %s %s: %s (%d of %d changes were applied, run apply-release again to apply the rest)",
+				change.Action,
+				change.Resource,
+				change.Name,
+				err,
+				i,
+				len(plan.Changes),
+			)
+			return c.eh.HandleError(err)
+		}
+	}
+
+	if c.format == printer.PrintAsTable {
+		message := fmt.Sprintf(
+			"Applied %d changes to release %s %s",
+			len(plan.Changes),
+			plan.ProductSlug,
+			plan.Version,
+		)
+		coloredMessage := ui.SuccessColor.SprintFunc()(message)
+
+		_, err := fmt.Fprintln(c.outputWriter, coloredMessage)
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *ReleasePlanClient) plan(specPath string) (Plan, error) {
+	spec, err := ReadSpec(specPath)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	return newPlan(c.pivnetClient, spec)
+}
+
+func (c *ReleasePlanClient) printPlan(plan Plan) error {
+	switch c.format {
+
+	case printer.PrintAsTable:
+		if len(plan.Changes) == 0 {
+			message := fmt.Sprintf(
+				"Release %s %s is up to date",
+				plan.ProductSlug,
+				plan.Version,
+			)
+			coloredMessage := ui.SuccessColor.SprintFunc()(message)
+
+			_, err := fmt.Fprintln(c.outputWriter, coloredMessage)
+
+			return err
+		}
+
+		table := tablewriter.NewWriter(c.outputWriter)
+		table.SetHeader([]string{
+			"Action",
+			"Resource",
+			"ID",
+			"Name",
+			"Detail",
+		})
+
+		for _, change := range plan.Changes {
+			var id string
+			if change.ID != 0 {
+				id = strconv.Itoa(change.ID)
+			}
+
+			table.Append([]string{
+				change.Action,
+				change.Resource,
+				id,
+				change.Name,
+				change.Detail,
+			})
+		}
+		table.Render()
+		return nil
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(plan)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(plan)
+	}
+
+	return nil
+}
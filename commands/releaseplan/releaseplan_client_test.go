@@ -0,0 +1,539 @@
+package releaseplan_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseplan"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseplan/releaseplanfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+var _ = Describe("releaseplan commands", func() {
+	var (
+		fakePivnetClient *releaseplanfakes.FakePivnetClient
+		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+		format    string
+
+		tempDir  string
+		specPath string
+
+		client *releaseplan.ReleasePlanClient
+	)
+
+	writeSpec := func(contents string) {
+		err := ioutil.WriteFile(specPath, []byte(contents), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	printedPlan := func() releaseplan.Plan {
+		var plan releaseplan.Plan
+		err := json.Unmarshal(outBuffer.Bytes(), &plan)
+		Expect(err).NotTo(HaveOccurred())
+		return plan
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		specPath = filepath.Join(tempDir, "release.yml")
+
+		fakePivnetClient = &releaseplanfakes.FakePivnetClient{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		outBuffer = bytes.Buffer{}
+		format = printer.PrintAsJSON
+
+		fakePivnetClient.ReleasesForProductSlugReturns([]pivnet.Release{
+			{ID: 10, Version: "2.10.1"},
+			{ID: 20, Version: "2.10.2"},
+			{ID: 30, Version: "2.10.3"},
+		}, nil)
+
+		fakePivnetClient.ReleaseReturns(pivnet.Release{
+			ID:           30,
+			Version:      "2.10.3",
+			ReleaseType:  "Minor Release",
+			EULA:         &pivnet.EULA{Slug: "vmware_eula"},
+			Description:  "old description",
+			Availability: "Admins Only",
+		}, nil)
+
+		fakePivnetClient.EULAsReturns([]pivnet.EULA{{Slug: "vmware_eula"}}, nil)
+		fakePivnetClient.ReleaseTypesReturns([]pivnet.ReleaseType{"Minor Release", "Major Release"}, nil)
+
+		fakePivnetClient.ProductFilesReturns([]pivnet.ProductFile{
+			{ID: 1, Name: "mysql tile"},
+			{ID: 2, Name: "mysql docs"},
+			{ID: 3, Name: "mysql cli"},
+			{ID: 4, Name: "duplicate"},
+			{ID: 5, Name: "duplicate"},
+		}, nil)
+		fakePivnetClient.ProductFilesAddedToReleaseReturns([]pivnet.ProductFile{
+			{ID: 1, Name: "mysql tile"},
+			{ID: 2, Name: "mysql docs"},
+		}, nil)
+
+		fakePivnetClient.FileGroupsReturns([]pivnet.FileGroup{
+			{ID: 7, Name: "Stemcells"},
+		}, nil)
+
+		fakePivnetClient.UserGroupsReturns([]pivnet.UserGroup{
+			{ID: 8, Name: "partners"},
+		}, nil)
+		fakePivnetClient.UserGroupsForReleaseReturns([]pivnet.UserGroup{
+			{ID: 8, Name: "partners"},
+		}, nil)
+
+		fakePivnetClient.ReleaseUpgradePathsReturns([]pivnet.ReleaseUpgradePath{
+			{Release: pivnet.UpgradePathRelease{ID: 10, Version: "2.10.1"}},
+		}, nil)
+
+		fakePivnetClient.DependencySpecifiersReturns([]pivnet.DependencySpecifier{
+			{ID: 40, Product: pivnet.Product{Slug: "stemcells-ubuntu-xenial"}, Specifier: "456.*"},
+		}, nil)
+
+		fakePivnetClient.CreateReleaseReturns(pivnet.Release{ID: 99, Version: "2.11.0"}, nil)
+		fakePivnetClient.UpdateReleaseStub = func(productSlug string, release pivnet.Release) (pivnet.Release, error) {
+			return release, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = releaseplan.NewReleasePlanClient(
+			fakePivnetClient,
+			fakeErrorHandler,
+			format,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+			l,
+		)
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when the release exists", func() {
+		BeforeEach(func() {
+			writeSpec(`---
+product_slug: p-mysql
+release:
+  version: 2.10.3
+  description: new description
+  availability: All Users
+product_files: [mysql tile, 3]
+file_groups: [Stemcells]
+user_groups: [partners]
+upgrade_paths: [2.10.2]
+dependency_specifiers:
+- product_slug: stemcells-ubuntu-xenial
+  specifier: 621.*
+`)
+		})
+
+		Describe("Plan", func() {
+			It("prints the changes in order", func() {
+				err := client.Plan(specPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				plan := printedPlan()
+				Expect(plan.ProductSlug).To(Equal("p-mysql"))
+				Expect(plan.Version).To(Equal("2.10.3"))
+				Expect(plan.ReleaseID).To(Equal(30))
+
+				Expect(plan.Changes).To(Equal([]releaseplan.Change{
+					{
+						Action:   "update",
+						Resource: "release",
+						ID:       30,
+						Name:     "2.10.3",
+						Detail:   "description: 'old description' -> 'new description', availability: 'Admins Only' -> 'All Users'",
+					},
+					{Action: "add", Resource: "product_file", ID: 3, Name: "mysql cli"},
+					{Action: "remove", Resource: "product_file", ID: 2, Name: "mysql docs"},
+					{Action: "add", Resource: "file_group", ID: 7, Name: "Stemcells"},
+					{Action: "add", Resource: "upgrade_path", ID: 20, Name: "2.10.2"},
+					{Action: "remove", Resource: "upgrade_path", ID: 10, Name: "2.10.1"},
+					{Action: "add", Resource: "dependency_specifier", Name: "stemcells-ubuntu-xenial 621.*"},
+					{Action: "remove", Resource: "dependency_specifier", ID: 40, Name: "stemcells-ubuntu-xenial 456.*"},
+				}))
+			})
+
+			It("does not make any changes", func() {
+				err := client.Plan(specPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.RemoveProductFileFromReleaseCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.AddFileGroupToReleaseCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.AddReleaseUpgradePathCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.CreateDependencySpecifierCallCount()).To(Equal(0))
+			})
+
+			It("does not fetch sections that are left out", func() {
+				err := client.Plan(specPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.ArtifactReferencesCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.ArtifactReferencesForReleaseCallCount()).To(Equal(0))
+			})
+
+			Context("when the release already matches", func() {
+				BeforeEach(func() {
+					format = printer.PrintAsTable
+
+					writeSpec(`---
+product_slug: p-mysql
+release:
+  version: 2.10.3
+  description: old description
+product_files: [1, 2]
+user_groups: [partners]
+`)
+				})
+
+				It("prints that it is up to date", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(outBuffer.String()).To(ContainSubstring("Release p-mysql 2.10.3 is up to date"))
+				})
+			})
+
+			Context("when a file group of the release has product files", func() {
+				BeforeEach(func() {
+					format = printer.PrintAsTable
+
+					fakePivnetClient.FileGroupsForReleaseReturns([]pivnet.FileGroup{
+						{
+							ID:           7,
+							Name:         "Stemcells",
+							ProductFiles: []pivnet.ProductFile{{ID: 6, Name: "stemcell"}},
+						},
+					}, nil)
+
+					writeSpec(`---
+product_slug: p-mysql
+release:
+  version: 2.10.3
+  description: old description
+product_files: [1, 2]
+file_groups: [Stemcells]
+user_groups: [partners]
+`)
+				})
+
+				It("does not plan to remove the product files of the file group", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(outBuffer.String()).To(ContainSubstring("Release p-mysql 2.10.3 is up to date"))
+				})
+			})
+
+			Context("when a name matches more than one item", func() {
+				BeforeEach(func() {
+					writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3}
+product_files: [duplicate]
+`)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("refer to it by id"))
+				})
+			})
+
+			Context("when an item cannot be found", func() {
+				BeforeEach(func() {
+					writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3}
+file_groups: [Missing]
+`)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("no file_group found named 'Missing'"))
+				})
+			})
+
+			Context("when the spec would make a controlled release uncontrolled", func() {
+				BeforeEach(func() {
+					fakePivnetClient.ReleaseReturns(pivnet.Release{
+						ID:         30,
+						Version:    "2.10.3",
+						Controlled: true,
+					}, nil)
+
+					writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3, controlled: false}
+`)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("cannot be changed to false"))
+				})
+			})
+
+			Context("when the release type is not valid", func() {
+				BeforeEach(func() {
+					writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3, release_type: Unknown Release}
+`)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("release_type 'Unknown Release' must be one of"))
+				})
+			})
+
+			Context("when fetching the releases returns an error", func() {
+				var (
+					expectedErr error
+				)
+
+				BeforeEach(func() {
+					expectedErr = errors.New("releases error")
+					fakePivnetClient.ReleasesForProductSlugReturns(nil, expectedErr)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+				})
+			})
+		})
+
+		Describe("Apply", func() {
+			It("makes the planned changes", func() {
+				err := client.Apply(specPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(1))
+				productSlug, release := fakePivnetClient.UpdateReleaseArgsForCall(0)
+				Expect(productSlug).To(Equal("p-mysql"))
+				Expect(release.ID).To(Equal(30))
+				Expect(release.Description).To(Equal("new description"))
+				Expect(release.Availability).To(Equal("All Users"))
+
+				Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(1))
+				productSlug, releaseID, productFileID := fakePivnetClient.AddProductFileToReleaseArgsForCall(0)
+				Expect(productSlug).To(Equal("p-mysql"))
+				Expect(releaseID).To(Equal(30))
+				Expect(productFileID).To(Equal(3))
+
+				Expect(fakePivnetClient.RemoveProductFileFromReleaseCallCount()).To(Equal(1))
+				_, releaseID, productFileID = fakePivnetClient.RemoveProductFileFromReleaseArgsForCall(0)
+				Expect(releaseID).To(Equal(30))
+				Expect(productFileID).To(Equal(2))
+
+				Expect(fakePivnetClient.AddFileGroupToReleaseCallCount()).To(Equal(1))
+				_, fileGroupID, releaseID := fakePivnetClient.AddFileGroupToReleaseArgsForCall(0)
+				Expect(fileGroupID).To(Equal(7))
+				Expect(releaseID).To(Equal(30))
+
+				Expect(fakePivnetClient.AddUserGroupCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.RemoveUserGroupCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.AddReleaseUpgradePathCallCount()).To(Equal(1))
+				_, releaseID, previousReleaseID := fakePivnetClient.AddReleaseUpgradePathArgsForCall(0)
+				Expect(releaseID).To(Equal(30))
+				Expect(previousReleaseID).To(Equal(20))
+
+				Expect(fakePivnetClient.RemoveReleaseUpgradePathCallCount()).To(Equal(1))
+				_, _, previousReleaseID = fakePivnetClient.RemoveReleaseUpgradePathArgsForCall(0)
+				Expect(previousReleaseID).To(Equal(10))
+
+				Expect(fakePivnetClient.CreateDependencySpecifierCallCount()).To(Equal(1))
+				_, releaseID, dependentProductSlug, specifier := fakePivnetClient.CreateDependencySpecifierArgsForCall(0)
+				Expect(releaseID).To(Equal(30))
+				Expect(dependentProductSlug).To(Equal("stemcells-ubuntu-xenial"))
+				Expect(specifier).To(Equal("621.*"))
+
+				Expect(fakePivnetClient.DeleteDependencySpecifierCallCount()).To(Equal(1))
+				_, _, dependencySpecifierID := fakePivnetClient.DeleteDependencySpecifierArgsForCall(0)
+				Expect(dependencySpecifierID).To(Equal(40))
+			})
+
+			Context("when a change fails", func() {
+				BeforeEach(func() {
+					fakePivnetClient.AddFileGroupToReleaseReturns(errors.New("file group error"))
+				})
+
+				It("stops and reports how many changes were applied", func() {
+					err := client.Apply(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePivnetClient.AddReleaseUpgradePathCallCount()).To(Equal(0))
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(Equal(
+						"failed to add file_group Stemcells: file group error (3 of 8 changes were applied, run apply-release again to apply the rest)",
+					))
+				})
+			})
+
+			Context("when the format is table", func() {
+				BeforeEach(func() {
+					format = printer.PrintAsTable
+				})
+
+				It("prints the plan and a success message", func() {
+					err := client.Apply(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(outBuffer.String()).To(ContainSubstring("mysql cli"))
+					Expect(outBuffer.String()).To(ContainSubstring("Applied 8 changes to release p-mysql 2.10.3"))
+				})
+			})
+		})
+	})
+
+	Context("when the release does not exist", func() {
+		BeforeEach(func() {
+			writeSpec(`---
+product_slug: p-mysql
+release:
+  version: 2.11.0
+  release_type: Major Release
+  eula_slug: vmware_eula
+  availability: All Users
+  controlled: true
+product_files: [3]
+`)
+		})
+
+		Describe("Plan", func() {
+			It("plans to create the release first and set its availability last", func() {
+				err := client.Plan(specPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakePivnetClient.ReleaseCallCount()).To(Equal(0))
+				Expect(fakePivnetClient.ProductFilesAddedToReleaseCallCount()).To(Equal(0))
+
+				plan := printedPlan()
+				Expect(plan.ReleaseID).To(Equal(0))
+				Expect(plan.Changes).To(Equal([]releaseplan.Change{
+					{
+						Action:   "create",
+						Resource: "release",
+						Name:     "2.11.0",
+						Detail:   "release_type: 'Major Release', eula_slug: 'vmware_eula'",
+					},
+					{Action: "add", Resource: "product_file", ID: 3, Name: "mysql cli"},
+					{
+						Action:   "update",
+						Resource: "release",
+						Name:     "2.11.0",
+						Detail:   "availability: 'Admins Only' -> 'All Users'",
+					},
+				}))
+			})
+
+			Context("when the release type is missing", func() {
+				BeforeEach(func() {
+					writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.11.0, eula_slug: vmware_eula}
+`)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("release.release_type and release.eula_slug are required"))
+				})
+			})
+
+			Context("when the EULA is not valid", func() {
+				BeforeEach(func() {
+					writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.11.0, release_type: Major Release, eula_slug: other_eula}
+`)
+				})
+
+				It("invokes the error handler", func() {
+					err := client.Plan(specPath)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+					Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("eula_slug 'other_eula' must be one of: 'vmware_eula'"))
+				})
+			})
+		})
+
+		Describe("Apply", func() {
+			It("creates the release and makes the other changes to it", func() {
+				err := client.Apply(specPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(0))
+
+				Expect(fakePivnetClient.CreateReleaseCallCount()).To(Equal(1))
+				config := fakePivnetClient.CreateReleaseArgsForCall(0)
+				Expect(config.ProductSlug).To(Equal("p-mysql"))
+				Expect(config.Version).To(Equal("2.11.0"))
+				Expect(config.ReleaseType).To(Equal("Major Release"))
+				Expect(config.EULASlug).To(Equal("vmware_eula"))
+				Expect(config.Controlled).To(BeTrue())
+
+				Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(1))
+				_, release := fakePivnetClient.UpdateReleaseArgsForCall(0)
+				Expect(release.ID).To(Equal(99))
+				Expect(release.Availability).To(Equal("All Users"))
+
+				Expect(fakePivnetClient.AddProductFileToReleaseCallCount()).To(Equal(1))
+				_, releaseID, productFileID := fakePivnetClient.AddProductFileToReleaseArgsForCall(0)
+				Expect(releaseID).To(Equal(99))
+				Expect(productFileID).To(Equal(3))
+			})
+		})
+	})
+})
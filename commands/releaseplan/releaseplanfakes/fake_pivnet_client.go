@@ -0,0 +1,2236 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package releaseplanfakes
+
+import (
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseplan"
+)
+
+type FakePivnetClient struct {
+	AddArtifactReferenceToReleaseStub        func(string, int, int) error
+	addArtifactReferenceToReleaseMutex       sync.RWMutex
+	addArtifactReferenceToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addArtifactReferenceToReleaseReturns struct {
+		result1 error
+	}
+	addArtifactReferenceToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddFileGroupToReleaseStub        func(string, int, int) error
+	addFileGroupToReleaseMutex       sync.RWMutex
+	addFileGroupToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addFileGroupToReleaseReturns struct {
+		result1 error
+	}
+	addFileGroupToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddProductFileToReleaseStub        func(string, int, int) error
+	addProductFileToReleaseMutex       sync.RWMutex
+	addProductFileToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addProductFileToReleaseReturns struct {
+		result1 error
+	}
+	addProductFileToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddReleaseUpgradePathStub        func(string, int, int) error
+	addReleaseUpgradePathMutex       sync.RWMutex
+	addReleaseUpgradePathArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addReleaseUpgradePathReturns struct {
+		result1 error
+	}
+	addReleaseUpgradePathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddUserGroupStub        func(string, int, int) error
+	addUserGroupMutex       sync.RWMutex
+	addUserGroupArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addUserGroupReturns struct {
+		result1 error
+	}
+	addUserGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArtifactReferencesStub        func(string) ([]pivnet.ArtifactReference, error)
+	artifactReferencesMutex       sync.RWMutex
+	artifactReferencesArgsForCall []struct {
+		arg1 string
+	}
+	artifactReferencesReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	artifactReferencesReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	ArtifactReferencesForReleaseStub        func(string, int) ([]pivnet.ArtifactReference, error)
+	artifactReferencesForReleaseMutex       sync.RWMutex
+	artifactReferencesForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	artifactReferencesForReleaseReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	artifactReferencesForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	CreateDependencySpecifierStub        func(string, int, string, string) (pivnet.DependencySpecifier, error)
+	createDependencySpecifierMutex       sync.RWMutex
+	createDependencySpecifierArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 string
+		arg4 string
+	}
+	createDependencySpecifierReturns struct {
+		result1 pivnet.DependencySpecifier
+		result2 error
+	}
+	createDependencySpecifierReturnsOnCall map[int]struct {
+		result1 pivnet.DependencySpecifier
+		result2 error
+	}
+	CreateReleaseStub        func(pivnet.CreateReleaseConfig) (pivnet.Release, error)
+	createReleaseMutex       sync.RWMutex
+	createReleaseArgsForCall []struct {
+		arg1 pivnet.CreateReleaseConfig
+	}
+	createReleaseReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	createReleaseReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	DeleteDependencySpecifierStub        func(string, int, int) error
+	deleteDependencySpecifierMutex       sync.RWMutex
+	deleteDependencySpecifierArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	deleteDependencySpecifierReturns struct {
+		result1 error
+	}
+	deleteDependencySpecifierReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DependencySpecifiersStub        func(string, int) ([]pivnet.DependencySpecifier, error)
+	dependencySpecifiersMutex       sync.RWMutex
+	dependencySpecifiersArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	dependencySpecifiersReturns struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}
+	dependencySpecifiersReturnsOnCall map[int]struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}
+	EULAsStub        func() ([]pivnet.EULA, error)
+	eULAsMutex       sync.RWMutex
+	eULAsArgsForCall []struct {
+	}
+	eULAsReturns struct {
+		result1 []pivnet.EULA
+		result2 error
+	}
+	eULAsReturnsOnCall map[int]struct {
+		result1 []pivnet.EULA
+		result2 error
+	}
+	FileGroupsStub        func(string) ([]pivnet.FileGroup, error)
+	fileGroupsMutex       sync.RWMutex
+	fileGroupsArgsForCall []struct {
+		arg1 string
+	}
+	fileGroupsReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	fileGroupsReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	FileGroupsForReleaseStub        func(string, int) ([]pivnet.FileGroup, error)
+	fileGroupsForReleaseMutex       sync.RWMutex
+	fileGroupsForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	fileGroupsForReleaseReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	fileGroupsForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	ProductFilesStub        func(string) ([]pivnet.ProductFile, error)
+	productFilesMutex       sync.RWMutex
+	productFilesArgsForCall []struct {
+		arg1 string
+	}
+	productFilesReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	productFilesReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ProductFilesAddedToReleaseStub        func(string, int) ([]pivnet.ProductFile, error)
+	productFilesAddedToReleaseMutex       sync.RWMutex
+	productFilesAddedToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	productFilesAddedToReleaseReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	productFilesAddedToReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ReleaseStub        func(string, int) (pivnet.Release, error)
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	ReleaseTypesStub        func() ([]pivnet.ReleaseType, error)
+	releaseTypesMutex       sync.RWMutex
+	releaseTypesArgsForCall []struct {
+	}
+	releaseTypesReturns struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}
+	releaseTypesReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}
+	ReleaseUpgradePathsStub        func(string, int) ([]pivnet.ReleaseUpgradePath, error)
+	releaseUpgradePathsMutex       sync.RWMutex
+	releaseUpgradePathsArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	releaseUpgradePathsReturns struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	releaseUpgradePathsReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	ReleasesForProductSlugStub        func(string, ...pivnet.QueryParameter) ([]pivnet.Release, error)
+	releasesForProductSlugMutex       sync.RWMutex
+	releasesForProductSlugArgsForCall []struct {
+		arg1 string
+		arg2 []pivnet.QueryParameter
+	}
+	releasesForProductSlugReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	releasesForProductSlugReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	RemoveArtifactReferenceFromReleaseStub        func(string, int, int) error
+	removeArtifactReferenceFromReleaseMutex       sync.RWMutex
+	removeArtifactReferenceFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeArtifactReferenceFromReleaseReturns struct {
+		result1 error
+	}
+	removeArtifactReferenceFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFileGroupFromReleaseStub        func(string, int, int) error
+	removeFileGroupFromReleaseMutex       sync.RWMutex
+	removeFileGroupFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeFileGroupFromReleaseReturns struct {
+		result1 error
+	}
+	removeFileGroupFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveProductFileFromReleaseStub        func(string, int, int) error
+	removeProductFileFromReleaseMutex       sync.RWMutex
+	removeProductFileFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeProductFileFromReleaseReturns struct {
+		result1 error
+	}
+	removeProductFileFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveReleaseUpgradePathStub        func(string, int, int) error
+	removeReleaseUpgradePathMutex       sync.RWMutex
+	removeReleaseUpgradePathArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeReleaseUpgradePathReturns struct {
+		result1 error
+	}
+	removeReleaseUpgradePathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveUserGroupStub        func(string, int, int) error
+	removeUserGroupMutex       sync.RWMutex
+	removeUserGroupArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeUserGroupReturns struct {
+		result1 error
+	}
+	removeUserGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateReleaseStub        func(string, pivnet.Release) (pivnet.Release, error)
+	updateReleaseMutex       sync.RWMutex
+	updateReleaseArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.Release
+	}
+	updateReleaseReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	updateReleaseReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	UserGroupsStub        func() ([]pivnet.UserGroup, error)
+	userGroupsMutex       sync.RWMutex
+	userGroupsArgsForCall []struct {
+	}
+	userGroupsReturns struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	userGroupsReturnsOnCall map[int]struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	UserGroupsForReleaseStub        func(string, int) ([]pivnet.UserGroup, error)
+	userGroupsForReleaseMutex       sync.RWMutex
+	userGroupsForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	userGroupsForReleaseReturns struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	userGroupsForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePivnetClient) AddArtifactReferenceToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addArtifactReferenceToReleaseMutex.Lock()
+	ret, specificReturn := fake.addArtifactReferenceToReleaseReturnsOnCall[len(fake.addArtifactReferenceToReleaseArgsForCall)]
+	fake.addArtifactReferenceToReleaseArgsForCall = append(fake.addArtifactReferenceToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddArtifactReferenceToReleaseStub
+	fakeReturns := fake.addArtifactReferenceToReleaseReturns
+	fake.recordInvocation("AddArtifactReferenceToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addArtifactReferenceToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) AddArtifactReferenceToReleaseCallCount() int {
+	fake.addArtifactReferenceToReleaseMutex.RLock()
+	defer fake.addArtifactReferenceToReleaseMutex.RUnlock()
+	return len(fake.addArtifactReferenceToReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) AddArtifactReferenceToReleaseCalls(stub func(string, int, int) error) {
+	fake.addArtifactReferenceToReleaseMutex.Lock()
+	defer fake.addArtifactReferenceToReleaseMutex.Unlock()
+	fake.AddArtifactReferenceToReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) AddArtifactReferenceToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addArtifactReferenceToReleaseMutex.RLock()
+	defer fake.addArtifactReferenceToReleaseMutex.RUnlock()
+	argsForCall := fake.addArtifactReferenceToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) AddArtifactReferenceToReleaseReturns(result1 error) {
+	fake.addArtifactReferenceToReleaseMutex.Lock()
+	defer fake.addArtifactReferenceToReleaseMutex.Unlock()
+	fake.AddArtifactReferenceToReleaseStub = nil
+	fake.addArtifactReferenceToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddArtifactReferenceToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addArtifactReferenceToReleaseMutex.Lock()
+	defer fake.addArtifactReferenceToReleaseMutex.Unlock()
+	fake.AddArtifactReferenceToReleaseStub = nil
+	if fake.addArtifactReferenceToReleaseReturnsOnCall == nil {
+		fake.addArtifactReferenceToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addArtifactReferenceToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddFileGroupToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addFileGroupToReleaseMutex.Lock()
+	ret, specificReturn := fake.addFileGroupToReleaseReturnsOnCall[len(fake.addFileGroupToReleaseArgsForCall)]
+	fake.addFileGroupToReleaseArgsForCall = append(fake.addFileGroupToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddFileGroupToReleaseStub
+	fakeReturns := fake.addFileGroupToReleaseReturns
+	fake.recordInvocation("AddFileGroupToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addFileGroupToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) AddFileGroupToReleaseCallCount() int {
+	fake.addFileGroupToReleaseMutex.RLock()
+	defer fake.addFileGroupToReleaseMutex.RUnlock()
+	return len(fake.addFileGroupToReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) AddFileGroupToReleaseCalls(stub func(string, int, int) error) {
+	fake.addFileGroupToReleaseMutex.Lock()
+	defer fake.addFileGroupToReleaseMutex.Unlock()
+	fake.AddFileGroupToReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) AddFileGroupToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addFileGroupToReleaseMutex.RLock()
+	defer fake.addFileGroupToReleaseMutex.RUnlock()
+	argsForCall := fake.addFileGroupToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) AddFileGroupToReleaseReturns(result1 error) {
+	fake.addFileGroupToReleaseMutex.Lock()
+	defer fake.addFileGroupToReleaseMutex.Unlock()
+	fake.AddFileGroupToReleaseStub = nil
+	fake.addFileGroupToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddFileGroupToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addFileGroupToReleaseMutex.Lock()
+	defer fake.addFileGroupToReleaseMutex.Unlock()
+	fake.AddFileGroupToReleaseStub = nil
+	if fake.addFileGroupToReleaseReturnsOnCall == nil {
+		fake.addFileGroupToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addFileGroupToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddProductFileToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addProductFileToReleaseMutex.Lock()
+	ret, specificReturn := fake.addProductFileToReleaseReturnsOnCall[len(fake.addProductFileToReleaseArgsForCall)]
+	fake.addProductFileToReleaseArgsForCall = append(fake.addProductFileToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddProductFileToReleaseStub
+	fakeReturns := fake.addProductFileToReleaseReturns
+	fake.recordInvocation("AddProductFileToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addProductFileToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) AddProductFileToReleaseCallCount() int {
+	fake.addProductFileToReleaseMutex.RLock()
+	defer fake.addProductFileToReleaseMutex.RUnlock()
+	return len(fake.addProductFileToReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) AddProductFileToReleaseCalls(stub func(string, int, int) error) {
+	fake.addProductFileToReleaseMutex.Lock()
+	defer fake.addProductFileToReleaseMutex.Unlock()
+	fake.AddProductFileToReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) AddProductFileToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addProductFileToReleaseMutex.RLock()
+	defer fake.addProductFileToReleaseMutex.RUnlock()
+	argsForCall := fake.addProductFileToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) AddProductFileToReleaseReturns(result1 error) {
+	fake.addProductFileToReleaseMutex.Lock()
+	defer fake.addProductFileToReleaseMutex.Unlock()
+	fake.AddProductFileToReleaseStub = nil
+	fake.addProductFileToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddProductFileToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addProductFileToReleaseMutex.Lock()
+	defer fake.addProductFileToReleaseMutex.Unlock()
+	fake.AddProductFileToReleaseStub = nil
+	if fake.addProductFileToReleaseReturnsOnCall == nil {
+		fake.addProductFileToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addProductFileToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddReleaseUpgradePath(arg1 string, arg2 int, arg3 int) error {
+	fake.addReleaseUpgradePathMutex.Lock()
+	ret, specificReturn := fake.addReleaseUpgradePathReturnsOnCall[len(fake.addReleaseUpgradePathArgsForCall)]
+	fake.addReleaseUpgradePathArgsForCall = append(fake.addReleaseUpgradePathArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddReleaseUpgradePathStub
+	fakeReturns := fake.addReleaseUpgradePathReturns
+	fake.recordInvocation("AddReleaseUpgradePath", []interface{}{arg1, arg2, arg3})
+	fake.addReleaseUpgradePathMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) AddReleaseUpgradePathCallCount() int {
+	fake.addReleaseUpgradePathMutex.RLock()
+	defer fake.addReleaseUpgradePathMutex.RUnlock()
+	return len(fake.addReleaseUpgradePathArgsForCall)
+}
+
+func (fake *FakePivnetClient) AddReleaseUpgradePathCalls(stub func(string, int, int) error) {
+	fake.addReleaseUpgradePathMutex.Lock()
+	defer fake.addReleaseUpgradePathMutex.Unlock()
+	fake.AddReleaseUpgradePathStub = stub
+}
+
+func (fake *FakePivnetClient) AddReleaseUpgradePathArgsForCall(i int) (string, int, int) {
+	fake.addReleaseUpgradePathMutex.RLock()
+	defer fake.addReleaseUpgradePathMutex.RUnlock()
+	argsForCall := fake.addReleaseUpgradePathArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) AddReleaseUpgradePathReturns(result1 error) {
+	fake.addReleaseUpgradePathMutex.Lock()
+	defer fake.addReleaseUpgradePathMutex.Unlock()
+	fake.AddReleaseUpgradePathStub = nil
+	fake.addReleaseUpgradePathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddReleaseUpgradePathReturnsOnCall(i int, result1 error) {
+	fake.addReleaseUpgradePathMutex.Lock()
+	defer fake.addReleaseUpgradePathMutex.Unlock()
+	fake.AddReleaseUpgradePathStub = nil
+	if fake.addReleaseUpgradePathReturnsOnCall == nil {
+		fake.addReleaseUpgradePathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addReleaseUpgradePathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddUserGroup(arg1 string, arg2 int, arg3 int) error {
+	fake.addUserGroupMutex.Lock()
+	ret, specificReturn := fake.addUserGroupReturnsOnCall[len(fake.addUserGroupArgsForCall)]
+	fake.addUserGroupArgsForCall = append(fake.addUserGroupArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddUserGroupStub
+	fakeReturns := fake.addUserGroupReturns
+	fake.recordInvocation("AddUserGroup", []interface{}{arg1, arg2, arg3})
+	fake.addUserGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) AddUserGroupCallCount() int {
+	fake.addUserGroupMutex.RLock()
+	defer fake.addUserGroupMutex.RUnlock()
+	return len(fake.addUserGroupArgsForCall)
+}
+
+func (fake *FakePivnetClient) AddUserGroupCalls(stub func(string, int, int) error) {
+	fake.addUserGroupMutex.Lock()
+	defer fake.addUserGroupMutex.Unlock()
+	fake.AddUserGroupStub = stub
+}
+
+func (fake *FakePivnetClient) AddUserGroupArgsForCall(i int) (string, int, int) {
+	fake.addUserGroupMutex.RLock()
+	defer fake.addUserGroupMutex.RUnlock()
+	argsForCall := fake.addUserGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) AddUserGroupReturns(result1 error) {
+	fake.addUserGroupMutex.Lock()
+	defer fake.addUserGroupMutex.Unlock()
+	fake.AddUserGroupStub = nil
+	fake.addUserGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) AddUserGroupReturnsOnCall(i int, result1 error) {
+	fake.addUserGroupMutex.Lock()
+	defer fake.addUserGroupMutex.Unlock()
+	fake.AddUserGroupStub = nil
+	if fake.addUserGroupReturnsOnCall == nil {
+		fake.addUserGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addUserGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) ArtifactReferences(arg1 string) ([]pivnet.ArtifactReference, error) {
+	fake.artifactReferencesMutex.Lock()
+	ret, specificReturn := fake.artifactReferencesReturnsOnCall[len(fake.artifactReferencesArgsForCall)]
+	fake.artifactReferencesArgsForCall = append(fake.artifactReferencesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ArtifactReferencesStub
+	fakeReturns := fake.artifactReferencesReturns
+	fake.recordInvocation("ArtifactReferences", []interface{}{arg1})
+	fake.artifactReferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesCallCount() int {
+	fake.artifactReferencesMutex.RLock()
+	defer fake.artifactReferencesMutex.RUnlock()
+	return len(fake.artifactReferencesArgsForCall)
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesCalls(stub func(string) ([]pivnet.ArtifactReference, error)) {
+	fake.artifactReferencesMutex.Lock()
+	defer fake.artifactReferencesMutex.Unlock()
+	fake.ArtifactReferencesStub = stub
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesArgsForCall(i int) string {
+	fake.artifactReferencesMutex.RLock()
+	defer fake.artifactReferencesMutex.RUnlock()
+	argsForCall := fake.artifactReferencesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.artifactReferencesMutex.Lock()
+	defer fake.artifactReferencesMutex.Unlock()
+	fake.ArtifactReferencesStub = nil
+	fake.artifactReferencesReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.artifactReferencesMutex.Lock()
+	defer fake.artifactReferencesMutex.Unlock()
+	fake.ArtifactReferencesStub = nil
+	if fake.artifactReferencesReturnsOnCall == nil {
+		fake.artifactReferencesReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.artifactReferencesReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForRelease(arg1 string, arg2 int) ([]pivnet.ArtifactReference, error) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	ret, specificReturn := fake.artifactReferencesForReleaseReturnsOnCall[len(fake.artifactReferencesForReleaseArgsForCall)]
+	fake.artifactReferencesForReleaseArgsForCall = append(fake.artifactReferencesForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ArtifactReferencesForReleaseStub
+	fakeReturns := fake.artifactReferencesForReleaseReturns
+	fake.recordInvocation("ArtifactReferencesForRelease", []interface{}{arg1, arg2})
+	fake.artifactReferencesForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseCallCount() int {
+	fake.artifactReferencesForReleaseMutex.RLock()
+	defer fake.artifactReferencesForReleaseMutex.RUnlock()
+	return len(fake.artifactReferencesForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseCalls(stub func(string, int) ([]pivnet.ArtifactReference, error)) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	defer fake.artifactReferencesForReleaseMutex.Unlock()
+	fake.ArtifactReferencesForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseArgsForCall(i int) (string, int) {
+	fake.artifactReferencesForReleaseMutex.RLock()
+	defer fake.artifactReferencesForReleaseMutex.RUnlock()
+	argsForCall := fake.artifactReferencesForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	defer fake.artifactReferencesForReleaseMutex.Unlock()
+	fake.ArtifactReferencesForReleaseStub = nil
+	fake.artifactReferencesForReleaseReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ArtifactReferencesForReleaseReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.artifactReferencesForReleaseMutex.Lock()
+	defer fake.artifactReferencesForReleaseMutex.Unlock()
+	fake.ArtifactReferencesForReleaseStub = nil
+	if fake.artifactReferencesForReleaseReturnsOnCall == nil {
+		fake.artifactReferencesForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.artifactReferencesForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) CreateDependencySpecifier(arg1 string, arg2 int, arg3 string, arg4 string) (pivnet.DependencySpecifier, error) {
+	fake.createDependencySpecifierMutex.Lock()
+	ret, specificReturn := fake.createDependencySpecifierReturnsOnCall[len(fake.createDependencySpecifierArgsForCall)]
+	fake.createDependencySpecifierArgsForCall = append(fake.createDependencySpecifierArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateDependencySpecifierStub
+	fakeReturns := fake.createDependencySpecifierReturns
+	fake.recordInvocation("CreateDependencySpecifier", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createDependencySpecifierMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) CreateDependencySpecifierCallCount() int {
+	fake.createDependencySpecifierMutex.RLock()
+	defer fake.createDependencySpecifierMutex.RUnlock()
+	return len(fake.createDependencySpecifierArgsForCall)
+}
+
+func (fake *FakePivnetClient) CreateDependencySpecifierCalls(stub func(string, int, string, string) (pivnet.DependencySpecifier, error)) {
+	fake.createDependencySpecifierMutex.Lock()
+	defer fake.createDependencySpecifierMutex.Unlock()
+	fake.CreateDependencySpecifierStub = stub
+}
+
+func (fake *FakePivnetClient) CreateDependencySpecifierArgsForCall(i int) (string, int, string, string) {
+	fake.createDependencySpecifierMutex.RLock()
+	defer fake.createDependencySpecifierMutex.RUnlock()
+	argsForCall := fake.createDependencySpecifierArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePivnetClient) CreateDependencySpecifierReturns(result1 pivnet.DependencySpecifier, result2 error) {
+	fake.createDependencySpecifierMutex.Lock()
+	defer fake.createDependencySpecifierMutex.Unlock()
+	fake.CreateDependencySpecifierStub = nil
+	fake.createDependencySpecifierReturns = struct {
+		result1 pivnet.DependencySpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) CreateDependencySpecifierReturnsOnCall(i int, result1 pivnet.DependencySpecifier, result2 error) {
+	fake.createDependencySpecifierMutex.Lock()
+	defer fake.createDependencySpecifierMutex.Unlock()
+	fake.CreateDependencySpecifierStub = nil
+	if fake.createDependencySpecifierReturnsOnCall == nil {
+		fake.createDependencySpecifierReturnsOnCall = make(map[int]struct {
+			result1 pivnet.DependencySpecifier
+			result2 error
+		})
+	}
+	fake.createDependencySpecifierReturnsOnCall[i] = struct {
+		result1 pivnet.DependencySpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) CreateRelease(arg1 pivnet.CreateReleaseConfig) (pivnet.Release, error) {
+	fake.createReleaseMutex.Lock()
+	ret, specificReturn := fake.createReleaseReturnsOnCall[len(fake.createReleaseArgsForCall)]
+	fake.createReleaseArgsForCall = append(fake.createReleaseArgsForCall, struct {
+		arg1 pivnet.CreateReleaseConfig
+	}{arg1})
+	stub := fake.CreateReleaseStub
+	fakeReturns := fake.createReleaseReturns
+	fake.recordInvocation("CreateRelease", []interface{}{arg1})
+	fake.createReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) CreateReleaseCallCount() int {
+	fake.createReleaseMutex.RLock()
+	defer fake.createReleaseMutex.RUnlock()
+	return len(fake.createReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) CreateReleaseCalls(stub func(pivnet.CreateReleaseConfig) (pivnet.Release, error)) {
+	fake.createReleaseMutex.Lock()
+	defer fake.createReleaseMutex.Unlock()
+	fake.CreateReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) CreateReleaseArgsForCall(i int) pivnet.CreateReleaseConfig {
+	fake.createReleaseMutex.RLock()
+	defer fake.createReleaseMutex.RUnlock()
+	argsForCall := fake.createReleaseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePivnetClient) CreateReleaseReturns(result1 pivnet.Release, result2 error) {
+	fake.createReleaseMutex.Lock()
+	defer fake.createReleaseMutex.Unlock()
+	fake.CreateReleaseStub = nil
+	fake.createReleaseReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) CreateReleaseReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.createReleaseMutex.Lock()
+	defer fake.createReleaseMutex.Unlock()
+	fake.CreateReleaseStub = nil
+	if fake.createReleaseReturnsOnCall == nil {
+		fake.createReleaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.createReleaseReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) DeleteDependencySpecifier(arg1 string, arg2 int, arg3 int) error {
+	fake.deleteDependencySpecifierMutex.Lock()
+	ret, specificReturn := fake.deleteDependencySpecifierReturnsOnCall[len(fake.deleteDependencySpecifierArgsForCall)]
+	fake.deleteDependencySpecifierArgsForCall = append(fake.deleteDependencySpecifierArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDependencySpecifierStub
+	fakeReturns := fake.deleteDependencySpecifierReturns
+	fake.recordInvocation("DeleteDependencySpecifier", []interface{}{arg1, arg2, arg3})
+	fake.deleteDependencySpecifierMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) DeleteDependencySpecifierCallCount() int {
+	fake.deleteDependencySpecifierMutex.RLock()
+	defer fake.deleteDependencySpecifierMutex.RUnlock()
+	return len(fake.deleteDependencySpecifierArgsForCall)
+}
+
+func (fake *FakePivnetClient) DeleteDependencySpecifierCalls(stub func(string, int, int) error) {
+	fake.deleteDependencySpecifierMutex.Lock()
+	defer fake.deleteDependencySpecifierMutex.Unlock()
+	fake.DeleteDependencySpecifierStub = stub
+}
+
+func (fake *FakePivnetClient) DeleteDependencySpecifierArgsForCall(i int) (string, int, int) {
+	fake.deleteDependencySpecifierMutex.RLock()
+	defer fake.deleteDependencySpecifierMutex.RUnlock()
+	argsForCall := fake.deleteDependencySpecifierArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) DeleteDependencySpecifierReturns(result1 error) {
+	fake.deleteDependencySpecifierMutex.Lock()
+	defer fake.deleteDependencySpecifierMutex.Unlock()
+	fake.DeleteDependencySpecifierStub = nil
+	fake.deleteDependencySpecifierReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) DeleteDependencySpecifierReturnsOnCall(i int, result1 error) {
+	fake.deleteDependencySpecifierMutex.Lock()
+	defer fake.deleteDependencySpecifierMutex.Unlock()
+	fake.DeleteDependencySpecifierStub = nil
+	if fake.deleteDependencySpecifierReturnsOnCall == nil {
+		fake.deleteDependencySpecifierReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDependencySpecifierReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) DependencySpecifiers(arg1 string, arg2 int) ([]pivnet.DependencySpecifier, error) {
+	fake.dependencySpecifiersMutex.Lock()
+	ret, specificReturn := fake.dependencySpecifiersReturnsOnCall[len(fake.dependencySpecifiersArgsForCall)]
+	fake.dependencySpecifiersArgsForCall = append(fake.dependencySpecifiersArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DependencySpecifiersStub
+	fakeReturns := fake.dependencySpecifiersReturns
+	fake.recordInvocation("DependencySpecifiers", []interface{}{arg1, arg2})
+	fake.dependencySpecifiersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersCallCount() int {
+	fake.dependencySpecifiersMutex.RLock()
+	defer fake.dependencySpecifiersMutex.RUnlock()
+	return len(fake.dependencySpecifiersArgsForCall)
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersCalls(stub func(string, int) ([]pivnet.DependencySpecifier, error)) {
+	fake.dependencySpecifiersMutex.Lock()
+	defer fake.dependencySpecifiersMutex.Unlock()
+	fake.DependencySpecifiersStub = stub
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersArgsForCall(i int) (string, int) {
+	fake.dependencySpecifiersMutex.RLock()
+	defer fake.dependencySpecifiersMutex.RUnlock()
+	argsForCall := fake.dependencySpecifiersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersReturns(result1 []pivnet.DependencySpecifier, result2 error) {
+	fake.dependencySpecifiersMutex.Lock()
+	defer fake.dependencySpecifiersMutex.Unlock()
+	fake.DependencySpecifiersStub = nil
+	fake.dependencySpecifiersReturns = struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) DependencySpecifiersReturnsOnCall(i int, result1 []pivnet.DependencySpecifier, result2 error) {
+	fake.dependencySpecifiersMutex.Lock()
+	defer fake.dependencySpecifiersMutex.Unlock()
+	fake.DependencySpecifiersStub = nil
+	if fake.dependencySpecifiersReturnsOnCall == nil {
+		fake.dependencySpecifiersReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.DependencySpecifier
+			result2 error
+		})
+	}
+	fake.dependencySpecifiersReturnsOnCall[i] = struct {
+		result1 []pivnet.DependencySpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) EULAs() ([]pivnet.EULA, error) {
+	fake.eULAsMutex.Lock()
+	ret, specificReturn := fake.eULAsReturnsOnCall[len(fake.eULAsArgsForCall)]
+	fake.eULAsArgsForCall = append(fake.eULAsArgsForCall, struct {
+	}{})
+	stub := fake.EULAsStub
+	fakeReturns := fake.eULAsReturns
+	fake.recordInvocation("EULAs", []interface{}{})
+	fake.eULAsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) EULAsCallCount() int {
+	fake.eULAsMutex.RLock()
+	defer fake.eULAsMutex.RUnlock()
+	return len(fake.eULAsArgsForCall)
+}
+
+func (fake *FakePivnetClient) EULAsCalls(stub func() ([]pivnet.EULA, error)) {
+	fake.eULAsMutex.Lock()
+	defer fake.eULAsMutex.Unlock()
+	fake.EULAsStub = stub
+}
+
+func (fake *FakePivnetClient) EULAsReturns(result1 []pivnet.EULA, result2 error) {
+	fake.eULAsMutex.Lock()
+	defer fake.eULAsMutex.Unlock()
+	fake.EULAsStub = nil
+	fake.eULAsReturns = struct {
+		result1 []pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) EULAsReturnsOnCall(i int, result1 []pivnet.EULA, result2 error) {
+	fake.eULAsMutex.Lock()
+	defer fake.eULAsMutex.Unlock()
+	fake.EULAsStub = nil
+	if fake.eULAsReturnsOnCall == nil {
+		fake.eULAsReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.EULA
+			result2 error
+		})
+	}
+	fake.eULAsReturnsOnCall[i] = struct {
+		result1 []pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroups(arg1 string) ([]pivnet.FileGroup, error) {
+	fake.fileGroupsMutex.Lock()
+	ret, specificReturn := fake.fileGroupsReturnsOnCall[len(fake.fileGroupsArgsForCall)]
+	fake.fileGroupsArgsForCall = append(fake.fileGroupsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FileGroupsStub
+	fakeReturns := fake.fileGroupsReturns
+	fake.recordInvocation("FileGroups", []interface{}{arg1})
+	fake.fileGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) FileGroupsCallCount() int {
+	fake.fileGroupsMutex.RLock()
+	defer fake.fileGroupsMutex.RUnlock()
+	return len(fake.fileGroupsArgsForCall)
+}
+
+func (fake *FakePivnetClient) FileGroupsCalls(stub func(string) ([]pivnet.FileGroup, error)) {
+	fake.fileGroupsMutex.Lock()
+	defer fake.fileGroupsMutex.Unlock()
+	fake.FileGroupsStub = stub
+}
+
+func (fake *FakePivnetClient) FileGroupsArgsForCall(i int) string {
+	fake.fileGroupsMutex.RLock()
+	defer fake.fileGroupsMutex.RUnlock()
+	argsForCall := fake.fileGroupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePivnetClient) FileGroupsReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsMutex.Lock()
+	defer fake.fileGroupsMutex.Unlock()
+	fake.FileGroupsStub = nil
+	fake.fileGroupsReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroupsReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsMutex.Lock()
+	defer fake.fileGroupsMutex.Unlock()
+	fake.FileGroupsStub = nil
+	if fake.fileGroupsReturnsOnCall == nil {
+		fake.fileGroupsReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.fileGroupsReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroupsForRelease(arg1 string, arg2 int) ([]pivnet.FileGroup, error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	ret, specificReturn := fake.fileGroupsForReleaseReturnsOnCall[len(fake.fileGroupsForReleaseArgsForCall)]
+	fake.fileGroupsForReleaseArgsForCall = append(fake.fileGroupsForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.FileGroupsForReleaseStub
+	fakeReturns := fake.fileGroupsForReleaseReturns
+	fake.recordInvocation("FileGroupsForRelease", []interface{}{arg1, arg2})
+	fake.fileGroupsForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseCallCount() int {
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	return len(fake.fileGroupsForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseCalls(stub func(string, int) ([]pivnet.FileGroup, error)) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseArgsForCall(i int) (string, int) {
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	argsForCall := fake.fileGroupsForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = nil
+	fake.fileGroupsForReleaseReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) FileGroupsForReleaseReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.fileGroupsForReleaseMutex.Lock()
+	defer fake.fileGroupsForReleaseMutex.Unlock()
+	fake.FileGroupsForReleaseStub = nil
+	if fake.fileGroupsForReleaseReturnsOnCall == nil {
+		fake.fileGroupsForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.fileGroupsForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFiles(arg1 string) ([]pivnet.ProductFile, error) {
+	fake.productFilesMutex.Lock()
+	ret, specificReturn := fake.productFilesReturnsOnCall[len(fake.productFilesArgsForCall)]
+	fake.productFilesArgsForCall = append(fake.productFilesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ProductFilesStub
+	fakeReturns := fake.productFilesReturns
+	fake.recordInvocation("ProductFiles", []interface{}{arg1})
+	fake.productFilesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ProductFilesCallCount() int {
+	fake.productFilesMutex.RLock()
+	defer fake.productFilesMutex.RUnlock()
+	return len(fake.productFilesArgsForCall)
+}
+
+func (fake *FakePivnetClient) ProductFilesCalls(stub func(string) ([]pivnet.ProductFile, error)) {
+	fake.productFilesMutex.Lock()
+	defer fake.productFilesMutex.Unlock()
+	fake.ProductFilesStub = stub
+}
+
+func (fake *FakePivnetClient) ProductFilesArgsForCall(i int) string {
+	fake.productFilesMutex.RLock()
+	defer fake.productFilesMutex.RUnlock()
+	argsForCall := fake.productFilesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePivnetClient) ProductFilesReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesMutex.Lock()
+	defer fake.productFilesMutex.Unlock()
+	fake.ProductFilesStub = nil
+	fake.productFilesReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFilesReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesMutex.Lock()
+	defer fake.productFilesMutex.Unlock()
+	fake.ProductFilesStub = nil
+	if fake.productFilesReturnsOnCall == nil {
+		fake.productFilesReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.productFilesReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFilesAddedToRelease(arg1 string, arg2 int) ([]pivnet.ProductFile, error) {
+	fake.productFilesAddedToReleaseMutex.Lock()
+	ret, specificReturn := fake.productFilesAddedToReleaseReturnsOnCall[len(fake.productFilesAddedToReleaseArgsForCall)]
+	fake.productFilesAddedToReleaseArgsForCall = append(fake.productFilesAddedToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ProductFilesAddedToReleaseStub
+	fakeReturns := fake.productFilesAddedToReleaseReturns
+	fake.recordInvocation("ProductFilesAddedToRelease", []interface{}{arg1, arg2})
+	fake.productFilesAddedToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ProductFilesAddedToReleaseCallCount() int {
+	fake.productFilesAddedToReleaseMutex.RLock()
+	defer fake.productFilesAddedToReleaseMutex.RUnlock()
+	return len(fake.productFilesAddedToReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) ProductFilesAddedToReleaseCalls(stub func(string, int) ([]pivnet.ProductFile, error)) {
+	fake.productFilesAddedToReleaseMutex.Lock()
+	defer fake.productFilesAddedToReleaseMutex.Unlock()
+	fake.ProductFilesAddedToReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) ProductFilesAddedToReleaseArgsForCall(i int) (string, int) {
+	fake.productFilesAddedToReleaseMutex.RLock()
+	defer fake.productFilesAddedToReleaseMutex.RUnlock()
+	argsForCall := fake.productFilesAddedToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ProductFilesAddedToReleaseReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesAddedToReleaseMutex.Lock()
+	defer fake.productFilesAddedToReleaseMutex.Unlock()
+	fake.ProductFilesAddedToReleaseStub = nil
+	fake.productFilesAddedToReleaseReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ProductFilesAddedToReleaseReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.productFilesAddedToReleaseMutex.Lock()
+	defer fake.productFilesAddedToReleaseMutex.Unlock()
+	fake.ProductFilesAddedToReleaseStub = nil
+	if fake.productFilesAddedToReleaseReturnsOnCall == nil {
+		fake.productFilesAddedToReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.productFilesAddedToReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) Release(arg1 string, arg2 int) (pivnet.Release, error) {
+	fake.releaseMutex.Lock()
+	ret, specificReturn := fake.releaseReturnsOnCall[len(fake.releaseArgsForCall)]
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseStub
+	fakeReturns := fake.releaseReturns
+	fake.recordInvocation("Release", []interface{}{arg1, arg2})
+	fake.releaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseCalls(stub func(string, int) (pivnet.Release, error)) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseArgsForCall(i int) (string, int) {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	argsForCall := fake.releaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	fake.releaseReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	if fake.releaseReturnsOnCall == nil {
+		fake.releaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseTypes() ([]pivnet.ReleaseType, error) {
+	fake.releaseTypesMutex.Lock()
+	ret, specificReturn := fake.releaseTypesReturnsOnCall[len(fake.releaseTypesArgsForCall)]
+	fake.releaseTypesArgsForCall = append(fake.releaseTypesArgsForCall, struct {
+	}{})
+	stub := fake.ReleaseTypesStub
+	fakeReturns := fake.releaseTypesReturns
+	fake.recordInvocation("ReleaseTypes", []interface{}{})
+	fake.releaseTypesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseTypesCallCount() int {
+	fake.releaseTypesMutex.RLock()
+	defer fake.releaseTypesMutex.RUnlock()
+	return len(fake.releaseTypesArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseTypesCalls(stub func() ([]pivnet.ReleaseType, error)) {
+	fake.releaseTypesMutex.Lock()
+	defer fake.releaseTypesMutex.Unlock()
+	fake.ReleaseTypesStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseTypesReturns(result1 []pivnet.ReleaseType, result2 error) {
+	fake.releaseTypesMutex.Lock()
+	defer fake.releaseTypesMutex.Unlock()
+	fake.ReleaseTypesStub = nil
+	fake.releaseTypesReturns = struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseTypesReturnsOnCall(i int, result1 []pivnet.ReleaseType, result2 error) {
+	fake.releaseTypesMutex.Lock()
+	defer fake.releaseTypesMutex.Unlock()
+	fake.ReleaseTypesStub = nil
+	if fake.releaseTypesReturnsOnCall == nil {
+		fake.releaseTypesReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseType
+			result2 error
+		})
+	}
+	fake.releaseTypesReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePaths(arg1 string, arg2 int) ([]pivnet.ReleaseUpgradePath, error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	ret, specificReturn := fake.releaseUpgradePathsReturnsOnCall[len(fake.releaseUpgradePathsArgsForCall)]
+	fake.releaseUpgradePathsArgsForCall = append(fake.releaseUpgradePathsArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ReleaseUpgradePathsStub
+	fakeReturns := fake.releaseUpgradePathsReturns
+	fake.recordInvocation("ReleaseUpgradePaths", []interface{}{arg1, arg2})
+	fake.releaseUpgradePathsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsCallCount() int {
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	return len(fake.releaseUpgradePathsArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsCalls(stub func(string, int) ([]pivnet.ReleaseUpgradePath, error)) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsArgsForCall(i int) (string, int) {
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	argsForCall := fake.releaseUpgradePathsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsReturns(result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = nil
+	fake.releaseUpgradePathsReturns = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseUpgradePathsReturnsOnCall(i int, result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.releaseUpgradePathsMutex.Lock()
+	defer fake.releaseUpgradePathsMutex.Unlock()
+	fake.ReleaseUpgradePathsStub = nil
+	if fake.releaseUpgradePathsReturnsOnCall == nil {
+		fake.releaseUpgradePathsReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseUpgradePath
+			result2 error
+		})
+	}
+	fake.releaseUpgradePathsReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleasesForProductSlug(arg1 string, arg2 ...pivnet.QueryParameter) ([]pivnet.Release, error) {
+	fake.releasesForProductSlugMutex.Lock()
+	ret, specificReturn := fake.releasesForProductSlugReturnsOnCall[len(fake.releasesForProductSlugArgsForCall)]
+	fake.releasesForProductSlugArgsForCall = append(fake.releasesForProductSlugArgsForCall, struct {
+		arg1 string
+		arg2 []pivnet.QueryParameter
+	}{arg1, arg2})
+	stub := fake.ReleasesForProductSlugStub
+	fakeReturns := fake.releasesForProductSlugReturns
+	fake.recordInvocation("ReleasesForProductSlug", []interface{}{arg1, arg2})
+	fake.releasesForProductSlugMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleasesForProductSlugCallCount() int {
+	fake.releasesForProductSlugMutex.RLock()
+	defer fake.releasesForProductSlugMutex.RUnlock()
+	return len(fake.releasesForProductSlugArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleasesForProductSlugCalls(stub func(string, ...pivnet.QueryParameter) ([]pivnet.Release, error)) {
+	fake.releasesForProductSlugMutex.Lock()
+	defer fake.releasesForProductSlugMutex.Unlock()
+	fake.ReleasesForProductSlugStub = stub
+}
+
+func (fake *FakePivnetClient) ReleasesForProductSlugArgsForCall(i int) (string, []pivnet.QueryParameter) {
+	fake.releasesForProductSlugMutex.RLock()
+	defer fake.releasesForProductSlugMutex.RUnlock()
+	argsForCall := fake.releasesForProductSlugArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleasesForProductSlugReturns(result1 []pivnet.Release, result2 error) {
+	fake.releasesForProductSlugMutex.Lock()
+	defer fake.releasesForProductSlugMutex.Unlock()
+	fake.ReleasesForProductSlugStub = nil
+	fake.releasesForProductSlugReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleasesForProductSlugReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.releasesForProductSlugMutex.Lock()
+	defer fake.releasesForProductSlugMutex.Unlock()
+	fake.ReleasesForProductSlugStub = nil
+	if fake.releasesForProductSlugReturnsOnCall == nil {
+		fake.releasesForProductSlugReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.releasesForProductSlugReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) RemoveArtifactReferenceFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeArtifactReferenceFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeArtifactReferenceFromReleaseReturnsOnCall[len(fake.removeArtifactReferenceFromReleaseArgsForCall)]
+	fake.removeArtifactReferenceFromReleaseArgsForCall = append(fake.removeArtifactReferenceFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveArtifactReferenceFromReleaseStub
+	fakeReturns := fake.removeArtifactReferenceFromReleaseReturns
+	fake.recordInvocation("RemoveArtifactReferenceFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeArtifactReferenceFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) RemoveArtifactReferenceFromReleaseCallCount() int {
+	fake.removeArtifactReferenceFromReleaseMutex.RLock()
+	defer fake.removeArtifactReferenceFromReleaseMutex.RUnlock()
+	return len(fake.removeArtifactReferenceFromReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) RemoveArtifactReferenceFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeArtifactReferenceFromReleaseMutex.Lock()
+	defer fake.removeArtifactReferenceFromReleaseMutex.Unlock()
+	fake.RemoveArtifactReferenceFromReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) RemoveArtifactReferenceFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeArtifactReferenceFromReleaseMutex.RLock()
+	defer fake.removeArtifactReferenceFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeArtifactReferenceFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) RemoveArtifactReferenceFromReleaseReturns(result1 error) {
+	fake.removeArtifactReferenceFromReleaseMutex.Lock()
+	defer fake.removeArtifactReferenceFromReleaseMutex.Unlock()
+	fake.RemoveArtifactReferenceFromReleaseStub = nil
+	fake.removeArtifactReferenceFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveArtifactReferenceFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeArtifactReferenceFromReleaseMutex.Lock()
+	defer fake.removeArtifactReferenceFromReleaseMutex.Unlock()
+	fake.RemoveArtifactReferenceFromReleaseStub = nil
+	if fake.removeArtifactReferenceFromReleaseReturnsOnCall == nil {
+		fake.removeArtifactReferenceFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeArtifactReferenceFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveFileGroupFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeFileGroupFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeFileGroupFromReleaseReturnsOnCall[len(fake.removeFileGroupFromReleaseArgsForCall)]
+	fake.removeFileGroupFromReleaseArgsForCall = append(fake.removeFileGroupFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveFileGroupFromReleaseStub
+	fakeReturns := fake.removeFileGroupFromReleaseReturns
+	fake.recordInvocation("RemoveFileGroupFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeFileGroupFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) RemoveFileGroupFromReleaseCallCount() int {
+	fake.removeFileGroupFromReleaseMutex.RLock()
+	defer fake.removeFileGroupFromReleaseMutex.RUnlock()
+	return len(fake.removeFileGroupFromReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) RemoveFileGroupFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeFileGroupFromReleaseMutex.Lock()
+	defer fake.removeFileGroupFromReleaseMutex.Unlock()
+	fake.RemoveFileGroupFromReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) RemoveFileGroupFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeFileGroupFromReleaseMutex.RLock()
+	defer fake.removeFileGroupFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeFileGroupFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) RemoveFileGroupFromReleaseReturns(result1 error) {
+	fake.removeFileGroupFromReleaseMutex.Lock()
+	defer fake.removeFileGroupFromReleaseMutex.Unlock()
+	fake.RemoveFileGroupFromReleaseStub = nil
+	fake.removeFileGroupFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveFileGroupFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeFileGroupFromReleaseMutex.Lock()
+	defer fake.removeFileGroupFromReleaseMutex.Unlock()
+	fake.RemoveFileGroupFromReleaseStub = nil
+	if fake.removeFileGroupFromReleaseReturnsOnCall == nil {
+		fake.removeFileGroupFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFileGroupFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveProductFileFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeProductFileFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeProductFileFromReleaseReturnsOnCall[len(fake.removeProductFileFromReleaseArgsForCall)]
+	fake.removeProductFileFromReleaseArgsForCall = append(fake.removeProductFileFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveProductFileFromReleaseStub
+	fakeReturns := fake.removeProductFileFromReleaseReturns
+	fake.recordInvocation("RemoveProductFileFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeProductFileFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) RemoveProductFileFromReleaseCallCount() int {
+	fake.removeProductFileFromReleaseMutex.RLock()
+	defer fake.removeProductFileFromReleaseMutex.RUnlock()
+	return len(fake.removeProductFileFromReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) RemoveProductFileFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeProductFileFromReleaseMutex.Lock()
+	defer fake.removeProductFileFromReleaseMutex.Unlock()
+	fake.RemoveProductFileFromReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) RemoveProductFileFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeProductFileFromReleaseMutex.RLock()
+	defer fake.removeProductFileFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeProductFileFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) RemoveProductFileFromReleaseReturns(result1 error) {
+	fake.removeProductFileFromReleaseMutex.Lock()
+	defer fake.removeProductFileFromReleaseMutex.Unlock()
+	fake.RemoveProductFileFromReleaseStub = nil
+	fake.removeProductFileFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveProductFileFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeProductFileFromReleaseMutex.Lock()
+	defer fake.removeProductFileFromReleaseMutex.Unlock()
+	fake.RemoveProductFileFromReleaseStub = nil
+	if fake.removeProductFileFromReleaseReturnsOnCall == nil {
+		fake.removeProductFileFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeProductFileFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveReleaseUpgradePath(arg1 string, arg2 int, arg3 int) error {
+	fake.removeReleaseUpgradePathMutex.Lock()
+	ret, specificReturn := fake.removeReleaseUpgradePathReturnsOnCall[len(fake.removeReleaseUpgradePathArgsForCall)]
+	fake.removeReleaseUpgradePathArgsForCall = append(fake.removeReleaseUpgradePathArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveReleaseUpgradePathStub
+	fakeReturns := fake.removeReleaseUpgradePathReturns
+	fake.recordInvocation("RemoveReleaseUpgradePath", []interface{}{arg1, arg2, arg3})
+	fake.removeReleaseUpgradePathMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) RemoveReleaseUpgradePathCallCount() int {
+	fake.removeReleaseUpgradePathMutex.RLock()
+	defer fake.removeReleaseUpgradePathMutex.RUnlock()
+	return len(fake.removeReleaseUpgradePathArgsForCall)
+}
+
+func (fake *FakePivnetClient) RemoveReleaseUpgradePathCalls(stub func(string, int, int) error) {
+	fake.removeReleaseUpgradePathMutex.Lock()
+	defer fake.removeReleaseUpgradePathMutex.Unlock()
+	fake.RemoveReleaseUpgradePathStub = stub
+}
+
+func (fake *FakePivnetClient) RemoveReleaseUpgradePathArgsForCall(i int) (string, int, int) {
+	fake.removeReleaseUpgradePathMutex.RLock()
+	defer fake.removeReleaseUpgradePathMutex.RUnlock()
+	argsForCall := fake.removeReleaseUpgradePathArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) RemoveReleaseUpgradePathReturns(result1 error) {
+	fake.removeReleaseUpgradePathMutex.Lock()
+	defer fake.removeReleaseUpgradePathMutex.Unlock()
+	fake.RemoveReleaseUpgradePathStub = nil
+	fake.removeReleaseUpgradePathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveReleaseUpgradePathReturnsOnCall(i int, result1 error) {
+	fake.removeReleaseUpgradePathMutex.Lock()
+	defer fake.removeReleaseUpgradePathMutex.Unlock()
+	fake.RemoveReleaseUpgradePathStub = nil
+	if fake.removeReleaseUpgradePathReturnsOnCall == nil {
+		fake.removeReleaseUpgradePathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReleaseUpgradePathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveUserGroup(arg1 string, arg2 int, arg3 int) error {
+	fake.removeUserGroupMutex.Lock()
+	ret, specificReturn := fake.removeUserGroupReturnsOnCall[len(fake.removeUserGroupArgsForCall)]
+	fake.removeUserGroupArgsForCall = append(fake.removeUserGroupArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveUserGroupStub
+	fakeReturns := fake.removeUserGroupReturns
+	fake.recordInvocation("RemoveUserGroup", []interface{}{arg1, arg2, arg3})
+	fake.removeUserGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) RemoveUserGroupCallCount() int {
+	fake.removeUserGroupMutex.RLock()
+	defer fake.removeUserGroupMutex.RUnlock()
+	return len(fake.removeUserGroupArgsForCall)
+}
+
+func (fake *FakePivnetClient) RemoveUserGroupCalls(stub func(string, int, int) error) {
+	fake.removeUserGroupMutex.Lock()
+	defer fake.removeUserGroupMutex.Unlock()
+	fake.RemoveUserGroupStub = stub
+}
+
+func (fake *FakePivnetClient) RemoveUserGroupArgsForCall(i int) (string, int, int) {
+	fake.removeUserGroupMutex.RLock()
+	defer fake.removeUserGroupMutex.RUnlock()
+	argsForCall := fake.removeUserGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePivnetClient) RemoveUserGroupReturns(result1 error) {
+	fake.removeUserGroupMutex.Lock()
+	defer fake.removeUserGroupMutex.Unlock()
+	fake.RemoveUserGroupStub = nil
+	fake.removeUserGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) RemoveUserGroupReturnsOnCall(i int, result1 error) {
+	fake.removeUserGroupMutex.Lock()
+	defer fake.removeUserGroupMutex.Unlock()
+	fake.RemoveUserGroupStub = nil
+	if fake.removeUserGroupReturnsOnCall == nil {
+		fake.removeUserGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeUserGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) UpdateRelease(arg1 string, arg2 pivnet.Release) (pivnet.Release, error) {
+	fake.updateReleaseMutex.Lock()
+	ret, specificReturn := fake.updateReleaseReturnsOnCall[len(fake.updateReleaseArgsForCall)]
+	fake.updateReleaseArgsForCall = append(fake.updateReleaseArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.Release
+	}{arg1, arg2})
+	stub := fake.UpdateReleaseStub
+	fakeReturns := fake.updateReleaseReturns
+	fake.recordInvocation("UpdateRelease", []interface{}{arg1, arg2})
+	fake.updateReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) UpdateReleaseCallCount() int {
+	fake.updateReleaseMutex.RLock()
+	defer fake.updateReleaseMutex.RUnlock()
+	return len(fake.updateReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) UpdateReleaseCalls(stub func(string, pivnet.Release) (pivnet.Release, error)) {
+	fake.updateReleaseMutex.Lock()
+	defer fake.updateReleaseMutex.Unlock()
+	fake.UpdateReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) UpdateReleaseArgsForCall(i int) (string, pivnet.Release) {
+	fake.updateReleaseMutex.RLock()
+	defer fake.updateReleaseMutex.RUnlock()
+	argsForCall := fake.updateReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) UpdateReleaseReturns(result1 pivnet.Release, result2 error) {
+	fake.updateReleaseMutex.Lock()
+	defer fake.updateReleaseMutex.Unlock()
+	fake.UpdateReleaseStub = nil
+	fake.updateReleaseReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) UpdateReleaseReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.updateReleaseMutex.Lock()
+	defer fake.updateReleaseMutex.Unlock()
+	fake.UpdateReleaseStub = nil
+	if fake.updateReleaseReturnsOnCall == nil {
+		fake.updateReleaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.updateReleaseReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) UserGroups() ([]pivnet.UserGroup, error) {
+	fake.userGroupsMutex.Lock()
+	ret, specificReturn := fake.userGroupsReturnsOnCall[len(fake.userGroupsArgsForCall)]
+	fake.userGroupsArgsForCall = append(fake.userGroupsArgsForCall, struct {
+	}{})
+	stub := fake.UserGroupsStub
+	fakeReturns := fake.userGroupsReturns
+	fake.recordInvocation("UserGroups", []interface{}{})
+	fake.userGroupsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) UserGroupsCallCount() int {
+	fake.userGroupsMutex.RLock()
+	defer fake.userGroupsMutex.RUnlock()
+	return len(fake.userGroupsArgsForCall)
+}
+
+func (fake *FakePivnetClient) UserGroupsCalls(stub func() ([]pivnet.UserGroup, error)) {
+	fake.userGroupsMutex.Lock()
+	defer fake.userGroupsMutex.Unlock()
+	fake.UserGroupsStub = stub
+}
+
+func (fake *FakePivnetClient) UserGroupsReturns(result1 []pivnet.UserGroup, result2 error) {
+	fake.userGroupsMutex.Lock()
+	defer fake.userGroupsMutex.Unlock()
+	fake.UserGroupsStub = nil
+	fake.userGroupsReturns = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) UserGroupsReturnsOnCall(i int, result1 []pivnet.UserGroup, result2 error) {
+	fake.userGroupsMutex.Lock()
+	defer fake.userGroupsMutex.Unlock()
+	fake.UserGroupsStub = nil
+	if fake.userGroupsReturnsOnCall == nil {
+		fake.userGroupsReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.userGroupsReturnsOnCall[i] = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) UserGroupsForRelease(arg1 string, arg2 int) ([]pivnet.UserGroup, error) {
+	fake.userGroupsForReleaseMutex.Lock()
+	ret, specificReturn := fake.userGroupsForReleaseReturnsOnCall[len(fake.userGroupsForReleaseArgsForCall)]
+	fake.userGroupsForReleaseArgsForCall = append(fake.userGroupsForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.UserGroupsForReleaseStub
+	fakeReturns := fake.userGroupsForReleaseReturns
+	fake.recordInvocation("UserGroupsForRelease", []interface{}{arg1, arg2})
+	fake.userGroupsForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) UserGroupsForReleaseCallCount() int {
+	fake.userGroupsForReleaseMutex.RLock()
+	defer fake.userGroupsForReleaseMutex.RUnlock()
+	return len(fake.userGroupsForReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) UserGroupsForReleaseCalls(stub func(string, int) ([]pivnet.UserGroup, error)) {
+	fake.userGroupsForReleaseMutex.Lock()
+	defer fake.userGroupsForReleaseMutex.Unlock()
+	fake.UserGroupsForReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) UserGroupsForReleaseArgsForCall(i int) (string, int) {
+	fake.userGroupsForReleaseMutex.RLock()
+	defer fake.userGroupsForReleaseMutex.RUnlock()
+	argsForCall := fake.userGroupsForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) UserGroupsForReleaseReturns(result1 []pivnet.UserGroup, result2 error) {
+	fake.userGroupsForReleaseMutex.Lock()
+	defer fake.userGroupsForReleaseMutex.Unlock()
+	fake.UserGroupsForReleaseStub = nil
+	fake.userGroupsForReleaseReturns = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) UserGroupsForReleaseReturnsOnCall(i int, result1 []pivnet.UserGroup, result2 error) {
+	fake.userGroupsForReleaseMutex.Lock()
+	defer fake.userGroupsForReleaseMutex.Unlock()
+	fake.UserGroupsForReleaseStub = nil
+	if fake.userGroupsForReleaseReturnsOnCall == nil {
+		fake.userGroupsForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.userGroupsForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addArtifactReferenceToReleaseMutex.RLock()
+	defer fake.addArtifactReferenceToReleaseMutex.RUnlock()
+	fake.addFileGroupToReleaseMutex.RLock()
+	defer fake.addFileGroupToReleaseMutex.RUnlock()
+	fake.addProductFileToReleaseMutex.RLock()
+	defer fake.addProductFileToReleaseMutex.RUnlock()
+	fake.addReleaseUpgradePathMutex.RLock()
+	defer fake.addReleaseUpgradePathMutex.RUnlock()
+	fake.addUserGroupMutex.RLock()
+	defer fake.addUserGroupMutex.RUnlock()
+	fake.artifactReferencesMutex.RLock()
+	defer fake.artifactReferencesMutex.RUnlock()
+	fake.artifactReferencesForReleaseMutex.RLock()
+	defer fake.artifactReferencesForReleaseMutex.RUnlock()
+	fake.createDependencySpecifierMutex.RLock()
+	defer fake.createDependencySpecifierMutex.RUnlock()
+	fake.createReleaseMutex.RLock()
+	defer fake.createReleaseMutex.RUnlock()
+	fake.deleteDependencySpecifierMutex.RLock()
+	defer fake.deleteDependencySpecifierMutex.RUnlock()
+	fake.dependencySpecifiersMutex.RLock()
+	defer fake.dependencySpecifiersMutex.RUnlock()
+	fake.eULAsMutex.RLock()
+	defer fake.eULAsMutex.RUnlock()
+	fake.fileGroupsMutex.RLock()
+	defer fake.fileGroupsMutex.RUnlock()
+	fake.fileGroupsForReleaseMutex.RLock()
+	defer fake.fileGroupsForReleaseMutex.RUnlock()
+	fake.productFilesMutex.RLock()
+	defer fake.productFilesMutex.RUnlock()
+	fake.productFilesAddedToReleaseMutex.RLock()
+	defer fake.productFilesAddedToReleaseMutex.RUnlock()
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	fake.releaseTypesMutex.RLock()
+	defer fake.releaseTypesMutex.RUnlock()
+	fake.releaseUpgradePathsMutex.RLock()
+	defer fake.releaseUpgradePathsMutex.RUnlock()
+	fake.releasesForProductSlugMutex.RLock()
+	defer fake.releasesForProductSlugMutex.RUnlock()
+	fake.removeArtifactReferenceFromReleaseMutex.RLock()
+	defer fake.removeArtifactReferenceFromReleaseMutex.RUnlock()
+	fake.removeFileGroupFromReleaseMutex.RLock()
+	defer fake.removeFileGroupFromReleaseMutex.RUnlock()
+	fake.removeProductFileFromReleaseMutex.RLock()
+	defer fake.removeProductFileFromReleaseMutex.RUnlock()
+	fake.removeReleaseUpgradePathMutex.RLock()
+	defer fake.removeReleaseUpgradePathMutex.RUnlock()
+	fake.removeUserGroupMutex.RLock()
+	defer fake.removeUserGroupMutex.RUnlock()
+	fake.updateReleaseMutex.RLock()
+	defer fake.updateReleaseMutex.RUnlock()
+	fake.userGroupsMutex.RLock()
+	defer fake.userGroupsMutex.RUnlock()
+	fake.userGroupsForReleaseMutex.RLock()
+	defer fake.userGroupsForReleaseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePivnetClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ releaseplan.PivnetClient = new(FakePivnetClient)
@@ -0,0 +1,144 @@
+package releaseplan
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Spec is the desired state of a release, e.g.
+//
+//	product_slug: p-mysql
+//	release:
+//	  version: 2.10.3
+//	  release_type: Minor Release
+//	  eula_slug: vmware_eula
+//	  availability: All Users
+//	product_files: [p-mysql 2.10.3, 1234]
+//	file_groups: [Stemcells]
+//	upgrade_paths: [2.10.2, 2.10.1]
+//	dependency_specifiers:
+//	- product_slug: stemcells-ubuntu-xenial
+//	  specifier: 621.*
+//
+// A section that is left out is not changed. A section that is present,
+// even if empty, lists everything the release should have, so anything else
+// is removed.
+type Spec struct {
+	ProductSlug          string                     `yaml:"product_slug"`
+	Release              ReleaseSpec                `yaml:"release"`
+	ProductFiles         *[]Ref                     `yaml:"product_files"`
+	FileGroups           *[]Ref                     `yaml:"file_groups"`
+	ArtifactReferences   *[]Ref                     `yaml:"artifact_references"`
+	UserGroups           *[]Ref                     `yaml:"user_groups"`
+	UpgradePaths         *[]string                  `yaml:"upgrade_paths"`
+	DependencySpecifiers *[]DependencySpecifierSpec `yaml:"dependency_specifiers"`
+}
+
+// ReleaseSpec holds the attributes of the release. Attributes that are left
+// empty are not changed.
+type ReleaseSpec struct {
+	Version               string `yaml:"version"`
+	ReleaseType           string `yaml:"release_type"`
+	EULASlug              string `yaml:"eula_slug"`
+	ReleaseDate           string `yaml:"release_date"`
+	Description           string `yaml:"description"`
+	ReleaseNotesURL       string `yaml:"release_notes_url"`
+	Availability          string `yaml:"availability"`
+	Controlled            *bool  `yaml:"controlled"`
+	ECCN                  string `yaml:"eccn"`
+	LicenseException      string `yaml:"license_exception"`
+	EndOfSupportDate      string `yaml:"end_of_support_date"`
+	EndOfGuidanceDate     string `yaml:"end_of_guidance_date"`
+	EndOfAvailabilityDate string `yaml:"end_of_availability_date"`
+}
+
+// Ref refers to an existing product file, file group, artifact reference or
+// user group by its ID or by its name. It can be written as a number for an
+// ID, a string for a name, or as a map with an id or a name.
+type Ref struct {
+	ID   int    `yaml:"id"`
+	Name string `yaml:"name"`
+}
+
+type DependencySpecifierSpec struct {
+	ProductSlug string `yaml:"product_slug"`
+	Specifier   string `yaml:"specifier"`
+}
+
+func (r *Ref) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var id int
+	if err := unmarshal(&id); err == nil {
+		*r = Ref{ID: id}
+		return nil
+	}
+
+	var name string
+	if err := unmarshal(&name); err == nil {
+		*r = Ref{Name: name}
+		return nil
+	}
+
+	type plain Ref
+	var ref plain
+	err := unmarshal(&ref)
+	if err != nil {
+		return err
+	}
+
+	*r = Ref(ref)
+	return nil
+}
+
+func (r Ref) String() string {
+	if r.ID != 0 {
+		return fmt.Sprintf("%d", r.ID)
+	}
+	return fmt.Sprintf("'%s'", r.Name)
+}
+
+// ReadSpec reads the spec at path. Unknown keys are an error so that a
+// misspelt section is not mistaken for one that is left out.
+func ReadSpec(path string) (Spec, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Spec{}, err
+	}
+
+	var spec Spec
+	err = yaml.UnmarshalStrict(b, &spec)
+	if err != nil {
+		return Spec{}, fmt.Errorf("could not parse %s: %s", path, err)
+	}
+
+	if spec.ProductSlug == "" {
+		return Spec{}, fmt.Errorf("%s is missing product_slug", path)
+	}
+
+	if spec.Release.Version == "" {
+		return Spec{}, fmt.Errorf("%s is missing release.version", path)
+	}
+
+	for _, refs := range []*[]Ref{spec.ProductFiles, spec.FileGroups, spec.ArtifactReferences, spec.UserGroups} {
+		if refs == nil {
+			continue
+		}
+
+		for _, ref := range *refs {
+			if ref.ID == 0 && ref.Name == "" {
+				return Spec{}, fmt.Errorf("%s has an entry without an id or a name", path)
+			}
+		}
+	}
+
+	if spec.DependencySpecifiers != nil {
+		for _, d := range *spec.DependencySpecifiers {
+			if d.ProductSlug == "" || d.Specifier == "" {
+				return Spec{}, fmt.Errorf("%s has a dependency specifier without a product_slug or a specifier", path)
+			}
+		}
+	}
+
+	return spec, nil
+}
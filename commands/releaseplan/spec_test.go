@@ -0,0 +1,154 @@
+package releaseplan_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/releaseplan"
+)
+
+var _ = Describe("ReadSpec", func() {
+	var (
+		tempDir  string
+		specPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		specPath = filepath.Join(tempDir, "release.yml")
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	writeSpec := func(contents string) {
+		err := ioutil.WriteFile(specPath, []byte(contents), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("reads the spec", func() {
+		writeSpec(`---
+product_slug: p-mysql
+release:
+  version: 2.10.3
+  release_type: Minor Release
+  controlled: true
+product_files: [some-file, 1234, {id: 5678}, {name: "9012"}]
+upgrade_paths: [2.10.2]
+dependency_specifiers:
+- product_slug: stemcells-ubuntu-xenial
+  specifier: 621.*
+`)
+
+		spec, err := releaseplan.ReadSpec(specPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(spec.ProductSlug).To(Equal("p-mysql"))
+		Expect(spec.Release.Version).To(Equal("2.10.3"))
+		Expect(spec.Release.ReleaseType).To(Equal("Minor Release"))
+		Expect(*spec.Release.Controlled).To(BeTrue())
+
+		Expect(*spec.ProductFiles).To(Equal([]releaseplan.Ref{
+			{Name: "some-file"},
+			{ID: 1234},
+			{ID: 5678},
+			{Name: "9012"},
+		}))
+		Expect(*spec.UpgradePaths).To(Equal([]string{"2.10.2"}))
+		Expect(*spec.DependencySpecifiers).To(Equal([]releaseplan.DependencySpecifierSpec{
+			{ProductSlug: "stemcells-ubuntu-xenial", Specifier: "621.*"},
+		}))
+	})
+
+	It("leaves out sections that are not present, and keeps empty ones", func() {
+		writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3}
+file_groups: []
+`)
+
+		spec, err := releaseplan.ReadSpec(specPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(spec.ProductFiles).To(BeNil())
+		Expect(spec.Release.Controlled).To(BeNil())
+		Expect(spec.FileGroups).NotTo(BeNil())
+		Expect(*spec.FileGroups).To(BeEmpty())
+	})
+
+	Context("when the spec contains an unknown key", func() {
+		BeforeEach(func() {
+			writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3}
+product_file: [1234]
+`)
+		})
+
+		It("returns an error", func() {
+			_, err := releaseplan.ReadSpec(specPath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("product_file"))
+		})
+	})
+
+	Context("when the product slug is missing", func() {
+		BeforeEach(func() {
+			writeSpec(`---
+release: {version: 2.10.3}
+`)
+		})
+
+		It("returns an error", func() {
+			_, err := releaseplan.ReadSpec(specPath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("product_slug"))
+		})
+	})
+
+	Context("when the release version is missing", func() {
+		BeforeEach(func() {
+			writeSpec(`---
+product_slug: p-mysql
+`)
+		})
+
+		It("returns an error", func() {
+			_, err := releaseplan.ReadSpec(specPath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("release.version"))
+		})
+	})
+
+	Context("when a dependency specifier is incomplete", func() {
+		BeforeEach(func() {
+			writeSpec(`---
+product_slug: p-mysql
+release: {version: 2.10.3}
+dependency_specifiers:
+- product_slug: stemcells-ubuntu-xenial
+`)
+		})
+
+		It("returns an error", func() {
+			_, err := releaseplan.ReadSpec(specPath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("dependency specifier"))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		It("returns an error", func() {
+			_, err := releaseplan.ReadSpec(filepath.Join(tempDir, "missing.yml"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
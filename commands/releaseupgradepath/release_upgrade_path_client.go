@@ -17,6 +17,7 @@ import (
 type PivnetClient interface {
 	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	ReleaseUpgradePaths(productSlug string, releaseID int) ([]pivnet.ReleaseUpgradePath, error)
 	AddReleaseUpgradePath(productSlug string, releaseID int, previousReleaseID int) error
 	RemoveReleaseUpgradePath(productSlug string, releaseID int, previousReleaseID int) error
@@ -58,7 +59,7 @@ func NewReleaseUpgradePathClient(
 }
 
 func (c *ReleaseUpgradePathClient) List(productSlug string, releaseVersion string) error {
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -104,6 +104,7 @@ var _ = Describe("releaseupgradepath commands", func() {
 
 	JustBeforeEach(func() {
 		fakePivnetClient.ReleaseForVersionReturns(releaseForVersion, releaseForVersionErr)
+		fakePivnetClient.ReleaseForConstraintReturns(releaseForVersion, releaseForVersionErr)
 	})
 
 	Describe("ReleaseUpgradePaths", func() {
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"io"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/ratelimit"
+)
+
+type DownloadStemcellForTileArgs struct {
+	Path string `positional-arg-name:"PATH" description:"Path to a .pivotal file e.g. ./cf-2.10.3-build.2.pivotal"`
+}
+
+type DownloadStemcellForTileCommand struct {
+	Args         DownloadStemcellForTileArgs `positional-args:"yes" required:"true"`
+	ProductSlug  string                      `long:"product-slug" short:"p" description:"Stemcell product slug (default: found from the os of the stemcell criteria) e.g. stemcells-ubuntu-xenial"`
+	Globs        []string                    `long:"glob" short:"g" description:"Glob to match the stemcell for an IaaS e.g. *aws*" required:"true"`
+	DownloadDir  string                      `long:"download-dir" short:"d" default:"." description:"Local existing directory to download files to e.g. /tmp/my-file/"`
+	AcceptEULA   bool                        `long:"accept-eula" description:"Automatically accept EULA if necessary (Available for pivots only)"`
+	Resume       bool                        `long:"resume" description:"Keep interrupted downloads as .partial files and continue them on the next run"`
+	SkipExisting bool                        `long:"skip-existing" description:"Skip files that already exist in the download directory and match the expected checksums"`
+	CacheDir     string                      `long:"cache-dir" description:"Directory to cache product files in by SHA256 (default: cache_dir of the profile)"`
+	DryRun       bool                        `long:"dry-run" description:"Print the stemcell files that would be downloaded without downloading them"`
+	LimitRate    string                      `long:"limit-rate" description:"Maximum download rate in bytes per second, with an optional K, M or G suffix e.g. 50M"`
+	Progress     string                      `long:"progress" description:"How to report download progress (default: bar on a terminal, log otherwise)" choice:"bar" choice:"log" choice:"json" choice:"none"`
+}
+
+//go:generate counterfeiter . StemcellClient
+type StemcellClient interface {
+	DownloadForTile(tilePath string, productSlug string, globs []string, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) error
+}
+
+var NewStemcellClient = func(client stemcell.PivnetClient, downloader stemcell.Downloader) StemcellClient {
+	return stemcell.NewStemcellClient(
+		client,
+		downloader,
+		tile.MetadataReader{},
+		Filter,
+		ErrorHandler,
+		Pivnet.Logger,
+	)
+}
+
+func (command *DownloadStemcellForTileCommand) Execute([]string) error {
+	err := Init(true)
+	if err != nil {
+		return err
+	}
+
+	client := NewPivnetClient()
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	if command.LimitRate != "" {
+		bytesPerSecond, err := ratelimit.ParseRate(command.LimitRate)
+		if err != nil {
+			return err
+		}
+		client = client.WithDownloadRateLimit(bytesPerSecond)
+	}
+
+	return NewStemcellClient(client, NewProductFileClient(client)).DownloadForTile(
+		command.Args.Path,
+		command.ProductSlug,
+		command.Globs,
+		command.DownloadDir,
+		command.AcceptEULA,
+		LogWriter,
+		productfile.DownloadOptions{
+			Parallel:     1,
+			Resume:       command.Resume,
+			SkipExisting: command.SkipExisting,
+			CacheDir:     cacheDirFor(command.CacheDir),
+			DryRun:       command.DryRun,
+			Progress:     progressFor(command.Progress),
+		},
+	)
+}
@@ -0,0 +1,61 @@
+package stemcell
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+)
+
+// productSlugs are the Pivnet products that stemcells for each operating
+// system named in stemcell_criteria are released as.
+var productSlugs = map[string]string{
+	"ubuntu-trusty": "stemcells",
+	"ubuntu-xenial": "stemcells-ubuntu-xenial",
+	"ubuntu-jammy":  "stemcells-ubuntu-jammy",
+	"windows1803":   "stemcells-windows-server",
+	"windows2016":   "stemcells-windows-server",
+	"windows2019":   "stemcells-windows-server",
+}
+
+// ProductSlugFor returns the slug of the Pivnet product that stemcells for
+// the operating system os are released as.
+func ProductSlugFor(os string) (string, error) {
+	slug, ok := productSlugs[os]
+	if !ok {
+		return "", fmt.Errorf(
+			"no stemcell product is known for os '%s', provide one with --product-slug (known: %s)",
+			os,
+			strings.Join(knownOSes(), ", "),
+		)
+	}
+
+	return slug, nil
+}
+
+// ConstraintFor returns the version constraint that stemcells must satisfy
+// to meet criteria. When patch security updates are enabled any later
+// stemcell in the same line may be used, e.g. 621.90 allows 621.125 but not
+// 622.1, otherwise only the exact version may.
+func ConstraintFor(criteria tile.StemcellCriteria) (string, error) {
+	if criteria.Version == "" {
+		return "", fmt.Errorf("stemcell_criteria has no version")
+	}
+
+	if criteria.EnablePatchSecurityUpdates {
+		return "^" + criteria.Version, nil
+	}
+
+	return "=" + criteria.Version, nil
+}
+
+func knownOSes() []string {
+	oses := make([]string, 0, len(productSlugs))
+	for os := range productSlugs {
+		oses = append(oses, os)
+	}
+	sort.Strings(oses)
+
+	return oses
+}
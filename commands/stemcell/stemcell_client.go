@@ -0,0 +1,138 @@
+package stemcell
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+)
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleasesForProductSlug(productSlug string, params ...pivnet.QueryParameter) ([]pivnet.Release, error)
+}
+
+//go:generate counterfeiter . Filter
+type Filter interface {
+	ReleasesByConstraint(releases []pivnet.Release, constraint string) ([]pivnet.Release, error)
+}
+
+//go:generate counterfeiter . Downloader
+type Downloader interface {
+	Download(productSlug string, releaseVersion string, globs []string, productFileIDs []int, downloadDir string, acceptEULA bool, progressWriter io.Writer, options productfile.DownloadOptions) ([]string, error)
+}
+
+//go:generate counterfeiter . MetadataReader
+type MetadataReader interface {
+	ReadMetadata(tilePath string) (tile.Metadata, error)
+}
+
+type StemcellClient struct {
+	pivnetClient   PivnetClient
+	downloader     Downloader
+	metadataReader MetadataReader
+	filter         Filter
+	eh             errorhandler.ErrorHandler
+	l              logger.Logger
+}
+
+func NewStemcellClient(
+	pivnetClient PivnetClient,
+	downloader Downloader,
+	metadataReader MetadataReader,
+	filter Filter,
+	eh errorhandler.ErrorHandler,
+	l logger.Logger,
+) *StemcellClient {
+	return &StemcellClient{
+		pivnetClient:   pivnetClient,
+		downloader:     downloader,
+		metadataReader: metadataReader,
+		filter:         filter,
+		eh:             eh,
+		l:              l,
+	}
+}
+
+// DownloadForTile downloads the product files matching globs from the newest
+// stemcell release that meets the stemcell_criteria of the tile at tilePath.
+// The stemcell product is found from the os of the criteria unless
+// productSlug is provided.
+func (c *StemcellClient) DownloadForTile(
+	tilePath string,
+	productSlug string,
+	globs []string,
+	downloadDir string,
+	acceptEULA bool,
+	progressWriter io.Writer,
+	options productfile.DownloadOptions,
+) error {
+	metadata, err := c.metadataReader.ReadMetadata(tilePath)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	criteria := metadata.StemcellCriteria
+
+	if productSlug == "" {
+		productSlug, err = ProductSlugFor(criteria.OS)
+		if err != nil {
+			return c.eh.HandleError(err)
+		}
+	}
+
+	constraint, err := ConstraintFor(criteria)
+	if err != nil {
+		return c.eh.HandleError(fmt.Errorf("could not read '%s': %s", tilePath, err))
+	}
+
+	releases, err := c.pivnetClient.ReleasesForProductSlug(productSlug)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	matchingReleases, err := c.filter.ReleasesByConstraint(releases, constraint)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	if len(matchingReleases) == 0 {
+		err := fmt.Errorf(
+			"no release of '%s' matches the stemcell criteria of %s %s: %s %s",
+			productSlug,
+			metadata.Name,
+			metadata.ProductVersion,
+			criteria.OS,
+			constraint,
+		)
+		return c.eh.HandleError(err)
+	}
+
+	release := matchingReleases[0]
+
+	c.l.Info(fmt.Sprintf(
+		"Resolved stemcell for %s %s (%s %s) to %s %s",
+		metadata.Name,
+		metadata.ProductVersion,
+		criteria.OS,
+		constraint,
+		productSlug,
+		release.Version,
+	))
+
+	_, err = c.downloader.Download(
+		productSlug,
+		release.Version,
+		globs,
+		nil,
+		downloadDir,
+		acceptEULA,
+		progressWriter,
+		options,
+	)
+	return err
+}
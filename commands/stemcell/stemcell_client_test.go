@@ -0,0 +1,224 @@
+package stemcell_test
+
+import (
+	"errors"
+	"log"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell/stemcellfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
+)
+
+var _ = Describe("stemcell commands", func() {
+	const (
+		tilePath = "some/dir/cf-2.10.3.pivotal"
+	)
+
+	var (
+		fakePivnetClient   *stemcellfakes.FakePivnetClient
+		fakeDownloader     *stemcellfakes.FakeDownloader
+		fakeMetadataReader *stemcellfakes.FakeMetadataReader
+		fakeErrorHandler   *errorhandlerfakes.FakeErrorHandler
+
+		releases []pivnet.Release
+
+		client *stemcell.StemcellClient
+	)
+
+	BeforeEach(func() {
+		fakePivnetClient = &stemcellfakes.FakePivnetClient{}
+		fakeDownloader = &stemcellfakes.FakeDownloader{}
+		fakeMetadataReader = &stemcellfakes.FakeMetadataReader{}
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+
+		fakeMetadataReader.ReadMetadataReturns(tile.Metadata{
+			Name:           "cf",
+			ProductVersion: "2.10.3",
+			StemcellCriteria: tile.StemcellCriteria{
+				OS:                         "ubuntu-xenial",
+				Version:                    "621.90",
+				EnablePatchSecurityUpdates: true,
+			},
+		}, nil)
+
+		releases = []pivnet.Release{
+			{ID: 1, Version: "621.89"},
+			{ID: 2, Version: "621.125"},
+			{ID: 3, Version: "621.90"},
+			{ID: 4, Version: "456.30"},
+			{ID: 5, Version: "622.1"},
+		}
+
+		fakePivnetClient.ReleasesForProductSlugReturns(releases, nil)
+
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+
+		client = stemcell.NewStemcellClient(
+			fakePivnetClient,
+			fakeDownloader,
+			fakeMetadataReader,
+			filter.NewFilter(l),
+			fakeErrorHandler,
+			l,
+		)
+	})
+
+	Describe("DownloadForTile", func() {
+		var (
+			productSlug string
+			options     productfile.DownloadOptions
+		)
+
+		BeforeEach(func() {
+			productSlug = ""
+			options = productfile.DownloadOptions{Parallel: 1, Resume: true}
+		})
+
+		downloadForTile := func() error {
+			return client.DownloadForTile(
+				tilePath,
+				productSlug,
+				[]string{"*aws*"},
+				"some/dir",
+				true,
+				GinkgoWriter,
+				options,
+			)
+		}
+
+		It("downloads the newest stemcell matching the criteria", func() {
+			err := downloadForTile()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeMetadataReader.ReadMetadataCallCount()).To(Equal(1))
+			Expect(fakeMetadataReader.ReadMetadataArgsForCall(0)).To(Equal(tilePath))
+
+			Expect(fakePivnetClient.ReleasesForProductSlugCallCount()).To(Equal(1))
+			invokedSlug, _ := fakePivnetClient.ReleasesForProductSlugArgsForCall(0)
+			Expect(invokedSlug).To(Equal("stemcells-ubuntu-xenial"))
+
+			Expect(fakeDownloader.DownloadCallCount()).To(Equal(1))
+			slug, version, globs, productFileIDs, downloadDir, acceptEULA, _, invokedOptions := fakeDownloader.DownloadArgsForCall(0)
+			Expect(slug).To(Equal("stemcells-ubuntu-xenial"))
+			Expect(version).To(Equal("621.125"))
+			Expect(globs).To(Equal([]string{"*aws*"}))
+			Expect(productFileIDs).To(BeEmpty())
+			Expect(downloadDir).To(Equal("some/dir"))
+			Expect(acceptEULA).To(BeTrue())
+			Expect(invokedOptions).To(Equal(options))
+		})
+
+		Context("when a product slug is provided", func() {
+			BeforeEach(func() {
+				productSlug = "stemcells-ubuntu-xenial-fips"
+			})
+
+			It("uses it instead of the product for the os", func() {
+				err := downloadForTile()
+				Expect(err).NotTo(HaveOccurred())
+
+				invokedSlug, _ := fakePivnetClient.ReleasesForProductSlugArgsForCall(0)
+				Expect(invokedSlug).To(Equal("stemcells-ubuntu-xenial-fips"))
+
+				slug, _, _, _, _, _, _, _ := fakeDownloader.DownloadArgsForCall(0)
+				Expect(slug).To(Equal("stemcells-ubuntu-xenial-fips"))
+			})
+		})
+
+		Context("when no release matches the criteria", func() {
+			BeforeEach(func() {
+				fakePivnetClient.ReleasesForProductSlugReturns(releases[3:], nil)
+			})
+
+			It("invokes the error handler without downloading", func() {
+				_ = downloadForTile()
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				err := fakeErrorHandler.HandleErrorArgsForCall(0)
+				Expect(err.Error()).To(ContainSubstring("no release of 'stemcells-ubuntu-xenial' matches"))
+				Expect(err.Error()).To(ContainSubstring("cf 2.10.3"))
+
+				Expect(fakeDownloader.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the os of the criteria is not known", func() {
+			BeforeEach(func() {
+				fakeMetadataReader.ReadMetadataReturns(tile.Metadata{
+					StemcellCriteria: tile.StemcellCriteria{OS: "plan9", Version: "1.0"},
+				}, nil)
+			})
+
+			It("invokes the error handler", func() {
+				_ = downloadForTile()
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				err := fakeErrorHandler.HandleErrorArgsForCall(0)
+				Expect(err.Error()).To(ContainSubstring("os 'plan9'"))
+
+				Expect(fakePivnetClient.ReleasesForProductSlugCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the tile cannot be read", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("metadata error")
+				fakeMetadataReader.ReadMetadataReturns(tile.Metadata{}, expectedErr)
+			})
+
+			It("invokes the error handler without listing releases", func() {
+				_ = downloadForTile()
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+
+				Expect(fakePivnetClient.ReleasesForProductSlugCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listing releases returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("releases error")
+				fakePivnetClient.ReleasesForProductSlugReturns(nil, expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				_ = downloadForTile()
+
+				Expect(fakeErrorHandler.HandleErrorCallCount()).To(Equal(1))
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0)).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when downloading returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download error")
+				fakeDownloader.DownloadReturns(nil, expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := downloadForTile()
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+	})
+})
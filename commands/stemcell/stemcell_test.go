@@ -0,0 +1,91 @@
+package stemcell_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/semver"
+)
+
+var _ = Describe("stemcell", func() {
+	Describe("ProductSlugFor", func() {
+		It("returns the product for each known os", func() {
+			for os, slug := range map[string]string{
+				"ubuntu-trusty": "stemcells",
+				"ubuntu-xenial": "stemcells-ubuntu-xenial",
+				"ubuntu-jammy":  "stemcells-ubuntu-jammy",
+				"windows2019":   "stemcells-windows-server",
+			} {
+				actual, err := stemcell.ProductSlugFor(os)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual).To(Equal(slug), os)
+			}
+		})
+
+		Context("when the os is not known", func() {
+			It("returns an error naming the os", func() {
+				_, err := stemcell.ProductSlugFor("plan9")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("os 'plan9'"))
+				Expect(err.Error()).To(ContainSubstring("--product-slug"))
+			})
+		})
+	})
+
+	Describe("ConstraintFor", func() {
+		var (
+			criteria tile.StemcellCriteria
+		)
+
+		check := func(version string) bool {
+			constraint, err := stemcell.ConstraintFor(criteria)
+			Expect(err).NotTo(HaveOccurred())
+
+			c, err := semver.ParseConstraint(constraint)
+			Expect(err).NotTo(HaveOccurred())
+
+			v, err := semver.ParseLenient(version)
+			Expect(err).NotTo(HaveOccurred())
+
+			return c.Check(v)
+		}
+
+		BeforeEach(func() {
+			criteria = tile.StemcellCriteria{
+				OS:      "ubuntu-xenial",
+				Version: "621.90",
+			}
+		})
+
+		It("only matches the exact version", func() {
+			Expect(check("621.90")).To(BeTrue())
+			Expect(check("621.91")).To(BeFalse())
+			Expect(check("621.89")).To(BeFalse())
+		})
+
+		Context("when patch security updates are enabled", func() {
+			BeforeEach(func() {
+				criteria.EnablePatchSecurityUpdates = true
+			})
+
+			It("matches later versions of the same line", func() {
+				Expect(check("621.90")).To(BeTrue())
+				Expect(check("621.125")).To(BeTrue())
+				Expect(check("621.89")).To(BeFalse())
+				Expect(check("622.1")).To(BeFalse())
+			})
+		})
+
+		Context("when the criteria have no version", func() {
+			BeforeEach(func() {
+				criteria.Version = ""
+			})
+
+			It("returns an error", func() {
+				_, err := stemcell.ConstraintFor(criteria)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
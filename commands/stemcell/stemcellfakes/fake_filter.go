@@ -0,0 +1,124 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package stemcellfakes
+
+import (
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell"
+)
+
+type FakeFilter struct {
+	ReleasesByConstraintStub        func([]pivnet.Release, string) ([]pivnet.Release, error)
+	releasesByConstraintMutex       sync.RWMutex
+	releasesByConstraintArgsForCall []struct {
+		arg1 []pivnet.Release
+		arg2 string
+	}
+	releasesByConstraintReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	releasesByConstraintReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFilter) ReleasesByConstraint(arg1 []pivnet.Release, arg2 string) ([]pivnet.Release, error) {
+	var arg1Copy []pivnet.Release
+	if arg1 != nil {
+		arg1Copy = make([]pivnet.Release, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.releasesByConstraintMutex.Lock()
+	ret, specificReturn := fake.releasesByConstraintReturnsOnCall[len(fake.releasesByConstraintArgsForCall)]
+	fake.releasesByConstraintArgsForCall = append(fake.releasesByConstraintArgsForCall, struct {
+		arg1 []pivnet.Release
+		arg2 string
+	}{arg1Copy, arg2})
+	stub := fake.ReleasesByConstraintStub
+	fakeReturns := fake.releasesByConstraintReturns
+	fake.recordInvocation("ReleasesByConstraint", []interface{}{arg1Copy, arg2})
+	fake.releasesByConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFilter) ReleasesByConstraintCallCount() int {
+	fake.releasesByConstraintMutex.RLock()
+	defer fake.releasesByConstraintMutex.RUnlock()
+	return len(fake.releasesByConstraintArgsForCall)
+}
+
+func (fake *FakeFilter) ReleasesByConstraintCalls(stub func([]pivnet.Release, string) ([]pivnet.Release, error)) {
+	fake.releasesByConstraintMutex.Lock()
+	defer fake.releasesByConstraintMutex.Unlock()
+	fake.ReleasesByConstraintStub = stub
+}
+
+func (fake *FakeFilter) ReleasesByConstraintArgsForCall(i int) ([]pivnet.Release, string) {
+	fake.releasesByConstraintMutex.RLock()
+	defer fake.releasesByConstraintMutex.RUnlock()
+	argsForCall := fake.releasesByConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFilter) ReleasesByConstraintReturns(result1 []pivnet.Release, result2 error) {
+	fake.releasesByConstraintMutex.Lock()
+	defer fake.releasesByConstraintMutex.Unlock()
+	fake.ReleasesByConstraintStub = nil
+	fake.releasesByConstraintReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilter) ReleasesByConstraintReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.releasesByConstraintMutex.Lock()
+	defer fake.releasesByConstraintMutex.Unlock()
+	fake.ReleasesByConstraintStub = nil
+	if fake.releasesByConstraintReturnsOnCall == nil {
+		fake.releasesByConstraintReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.releasesByConstraintReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.releasesByConstraintMutex.RLock()
+	defer fake.releasesByConstraintMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFilter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ stemcell.Filter = new(FakeFilter)
@@ -0,0 +1,117 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package stemcellfakes
+
+import (
+	"sync"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+)
+
+type FakeMetadataReader struct {
+	ReadMetadataStub        func(string) (tile.Metadata, error)
+	readMetadataMutex       sync.RWMutex
+	readMetadataArgsForCall []struct {
+		arg1 string
+	}
+	readMetadataReturns struct {
+		result1 tile.Metadata
+		result2 error
+	}
+	readMetadataReturnsOnCall map[int]struct {
+		result1 tile.Metadata
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMetadataReader) ReadMetadata(arg1 string) (tile.Metadata, error) {
+	fake.readMetadataMutex.Lock()
+	ret, specificReturn := fake.readMetadataReturnsOnCall[len(fake.readMetadataArgsForCall)]
+	fake.readMetadataArgsForCall = append(fake.readMetadataArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadMetadataStub
+	fakeReturns := fake.readMetadataReturns
+	fake.recordInvocation("ReadMetadata", []interface{}{arg1})
+	fake.readMetadataMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMetadataReader) ReadMetadataCallCount() int {
+	fake.readMetadataMutex.RLock()
+	defer fake.readMetadataMutex.RUnlock()
+	return len(fake.readMetadataArgsForCall)
+}
+
+func (fake *FakeMetadataReader) ReadMetadataCalls(stub func(string) (tile.Metadata, error)) {
+	fake.readMetadataMutex.Lock()
+	defer fake.readMetadataMutex.Unlock()
+	fake.ReadMetadataStub = stub
+}
+
+func (fake *FakeMetadataReader) ReadMetadataArgsForCall(i int) string {
+	fake.readMetadataMutex.RLock()
+	defer fake.readMetadataMutex.RUnlock()
+	argsForCall := fake.readMetadataArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeMetadataReader) ReadMetadataReturns(result1 tile.Metadata, result2 error) {
+	fake.readMetadataMutex.Lock()
+	defer fake.readMetadataMutex.Unlock()
+	fake.ReadMetadataStub = nil
+	fake.readMetadataReturns = struct {
+		result1 tile.Metadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMetadataReader) ReadMetadataReturnsOnCall(i int, result1 tile.Metadata, result2 error) {
+	fake.readMetadataMutex.Lock()
+	defer fake.readMetadataMutex.Unlock()
+	fake.ReadMetadataStub = nil
+	if fake.readMetadataReturnsOnCall == nil {
+		fake.readMetadataReturnsOnCall = make(map[int]struct {
+			result1 tile.Metadata
+			result2 error
+		})
+	}
+	fake.readMetadataReturnsOnCall[i] = struct {
+		result1 tile.Metadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMetadataReader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.readMetadataMutex.RLock()
+	defer fake.readMetadataMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMetadataReader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ stemcell.MetadataReader = new(FakeMetadataReader)
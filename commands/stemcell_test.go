@@ -0,0 +1,206 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/productfile"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/stemcell"
+)
+
+var _ = Describe("stemcell commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeStemcellClient *commandsfakes.FakeStemcellClient
+	)
+
+	BeforeEach(func() {
+		fakeStemcellClient = &commandsfakes.FakeStemcellClient{}
+
+		commands.NewStemcellClient = func(stemcell.PivnetClient, stemcell.Downloader) commands.StemcellClient {
+			return fakeStemcellClient
+		}
+	})
+
+	Describe("DownloadStemcellForTileCommand", func() {
+		var (
+			cmd *commands.DownloadStemcellForTileCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.DownloadStemcellForTileCommand{
+				Args:        commands.DownloadStemcellForTileArgs{Path: "some/path/cf-2.10.3.pivotal"},
+				Globs:       []string{"*aws*"},
+				DownloadDir: "some/dir",
+				AcceptEULA:  true,
+				Progress:    "none",
+			}
+		})
+
+		It("invokes the Stemcell client", func() {
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStemcellClient.DownloadForTileCallCount()).To(Equal(1))
+
+			tilePath, productSlug, globs, downloadDir, acceptEULA, _, options := fakeStemcellClient.DownloadForTileArgsForCall(0)
+			Expect(tilePath).To(Equal("some/path/cf-2.10.3.pivotal"))
+			Expect(productSlug).To(BeEmpty())
+			Expect(globs).To(Equal([]string{"*aws*"}))
+			Expect(downloadDir).To(Equal("some/dir"))
+			Expect(acceptEULA).To(BeTrue())
+			Expect(options.Parallel).To(Equal(1))
+			Expect(options.Progress).To(Equal(productfile.ProgressNone))
+		})
+
+		Context("when a product slug is provided", func() {
+			BeforeEach(func() {
+				cmd.ProductSlug = "stemcells-ubuntu-jammy"
+			})
+
+			It("passes it to the Stemcell client", func() {
+				err := cmd.Execute(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, productSlug, _, _, _, _, _ := fakeStemcellClient.DownloadForTileArgsForCall(0)
+				Expect(productSlug).To(Equal("stemcells-ubuntu-jammy"))
+			})
+		})
+
+		Context("when the download rate is invalid", func() {
+			BeforeEach(func() {
+				cmd.LimitRate = "fast"
+			})
+
+			It("returns an error without downloading", func() {
+				err := cmd.Execute(nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid rate 'fast'"))
+
+				Expect(fakeStemcellClient.DownloadForTileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the Stemcell client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeStemcellClient.DownloadForTileReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+			})
+		})
+
+		Describe("Args", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadStemcellForTileCommand{}, "Args")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+		})
+
+		Describe("ProductSlug flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadStemcellForTileCommand{}, "ProductSlug")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("product-slug"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("p"))
+			})
+		})
+
+		Describe("Globs flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadStemcellForTileCommand{}, "Globs")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("glob"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("g"))
+			})
+		})
+
+		Describe("DownloadDir flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadStemcellForTileCommand{}, "DownloadDir")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("download-dir"))
+			})
+
+			It("contains default value", func() {
+				Expect(defaultVal(field)).To(Equal("."))
+			})
+		})
+
+		Describe("Progress flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.DownloadStemcellForTileCommand{}, "Progress")
+			})
+
+			It("contains choice", func() {
+				Expect(string(field.Tag)).To(
+					MatchRegexp(`choice:"bar".*choice:"log".*choice:"json".*choice:"none"`))
+			})
+		})
+	})
+})
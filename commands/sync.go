@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"io"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/pivfile"
+)
+
+type SyncCommand struct {
+	Manifest   string `long:"manifest" short:"m" default:"Pivfile" description:"Path to the manifest listing the products to download"`
+	Lockfile   string `long:"lockfile" short:"l" description:"Path to the lockfile (default: the manifest path with .lock appended)"`
+	Locked     bool   `long:"locked" description:"Download exactly the product files recorded in the lockfile instead of resolving the manifest"`
+	AcceptEULA bool   `long:"accept-eula" description:"Automatically accept EULA if necessary (Available for pivots only)"`
+}
+
+//go:generate counterfeiter . PivfileClient
+type PivfileClient interface {
+	Sync(manifestPath string, lockfilePath string, locked bool, acceptEULA bool, progressWriter io.Writer) error
+}
+
+var NewPivfileClient = func(client pivfile.PivnetClient, downloader pivfile.Downloader) PivfileClient {
+	return pivfile.NewPivfileClient(
+		client,
+		downloader,
+		Filter,
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+		Pivnet.Logger,
+	)
+}
+
+func (command *SyncCommand) Execute([]string) error {
+	err := Init(true)
+	if err != nil {
+		return err
+	}
+
+	client := NewPivnetClient()
+	err = Auth.AuthenticateClient(client)
+	if err != nil {
+		return err
+	}
+
+	lockfile := command.Lockfile
+	if lockfile == "" {
+		lockfile = command.Manifest + ".lock"
+	}
+
+	return NewPivfileClient(client, NewProductFileClient(client)).Sync(
+		command.Manifest,
+		lockfile,
+		command.Locked,
+		command.AcceptEULA,
+		LogWriter,
+	)
+}
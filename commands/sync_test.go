@@ -0,0 +1,181 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/pivfile"
+)
+
+var _ = Describe("sync commands", func() {
+	var (
+		field reflect.StructField
+
+		fakePivfileClient *commandsfakes.FakePivfileClient
+	)
+
+	BeforeEach(func() {
+		fakePivfileClient = &commandsfakes.FakePivfileClient{}
+
+		commands.NewPivfileClient = func(pivfile.PivnetClient, pivfile.Downloader) commands.PivfileClient {
+			return fakePivfileClient
+		}
+	})
+
+	Describe("SyncCommand", func() {
+		var (
+			cmd *commands.SyncCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.SyncCommand{
+				Manifest: "some/Pivfile",
+			}
+		})
+
+		It("invokes the Pivfile client", func() {
+			err := cmd.Execute(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePivfileClient.SyncCallCount()).To(Equal(1))
+
+			manifestPath, lockfilePath, locked, acceptEULA, _ := fakePivfileClient.SyncArgsForCall(0)
+			Expect(manifestPath).To(Equal("some/Pivfile"))
+			Expect(lockfilePath).To(Equal("some/Pivfile.lock"))
+			Expect(locked).To(BeFalse())
+			Expect(acceptEULA).To(BeFalse())
+		})
+
+		Context("when a lockfile is provided", func() {
+			BeforeEach(func() {
+				cmd.Lockfile = "other/Pivfile.lock"
+				cmd.Locked = true
+			})
+
+			It("passes it to the Pivfile client", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				_, lockfilePath, locked, _, _ := fakePivfileClient.SyncArgsForCall(0)
+				Expect(lockfilePath).To(Equal("other/Pivfile.lock"))
+				Expect(locked).To(BeTrue())
+			})
+		})
+
+		Context("when the Pivfile client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakePivfileClient.SyncReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Context("when Authentication returns an error", func() {
+			BeforeEach(func() {
+				authErr = fmt.Errorf("auth error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(authErr))
+			})
+		})
+
+		Describe("Manifest flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.SyncCommand{}, "Manifest")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("manifest"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("m"))
+			})
+
+			It("defaults to Pivfile", func() {
+				Expect(defaultVal(field)).To(Equal("Pivfile"))
+			})
+		})
+
+		Describe("Lockfile flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.SyncCommand{}, "Lockfile")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("lockfile"))
+			})
+
+			It("contains short name", func() {
+				Expect(shortTag(field)).To(Equal("l"))
+			})
+		})
+
+		Describe("Locked flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.SyncCommand{}, "Locked")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("locked"))
+			})
+		})
+
+		Describe("AcceptEULA flag", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.SyncCommand{}, "AcceptEULA")
+			})
+
+			It("is not required", func() {
+				Expect(isRequired(field)).To(BeFalse())
+			})
+
+			It("contains long name", func() {
+				Expect(longTag(field)).To(Equal("accept-eula"))
+			})
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package commands
+
+import "github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+
+type InspectTileArgs struct {
+	Path string `positional-arg-name:"PATH" description:"Path to a .pivotal file e.g. ./cf-2.10.3-build.2.pivotal"`
+}
+
+type InspectTileCommand struct {
+	Args InspectTileArgs `positional-args:"yes" required:"true"`
+}
+
+//go:generate counterfeiter . TileClient
+type TileClient interface {
+	Inspect(tilePath string) error
+}
+
+var NewTileClient = func() TileClient {
+	return tile.NewTileClient(
+		ErrorHandler,
+		Pivnet.Format,
+		OutputWriter,
+		Printer,
+	)
+}
+
+func (command *InspectTileCommand) Execute([]string) error {
+	err := Init(false)
+	if err != nil {
+		return err
+	}
+
+	return NewTileClient().Inspect(command.Args.Path)
+}
@@ -0,0 +1,62 @@
+package tile_test
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tile Suite")
+}
+
+const tileMetadata = `---
+name: cf
+product_version: 2.10.3
+label: Small Footprint TAS
+metadata_version: "2.10"
+minimum_version_for_upgrade: 2.9.0
+stemcell_criteria:
+  os: ubuntu-xenial
+  version: "621.90"
+  enable_patch_security_updates: true
+additional_stemcells_criteria:
+- os: windows2019
+  version: "2019.30"
+requires_product_versions:
+- name: p-isolation-segment
+  version: ~> 2.10
+property_blueprints:
+- name: some-property
+  type: string
+`
+
+// writeTile writes a zip to path with the given files in it.
+func writeTile(path string, files map[string]string) {
+	f, err := os.Create(path)
+	Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, contents := range files {
+		fw, err := w.Create(name)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = fw.Write([]byte(contents))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	err = w.Close()
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func tempDir() string {
+	dir, err := ioutil.TempDir("", "")
+	Expect(err).NotTo(HaveOccurred())
+	return dir
+}
@@ -0,0 +1,90 @@
+package tile
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Metadata is the part of the metadata of a tile that describes the product
+// and what it needs to be installed.
+type Metadata struct {
+	Name                        string             `yaml:"name" json:"name"`
+	ProductVersion              string             `yaml:"product_version" json:"product_version"`
+	Label                       string             `yaml:"label" json:"label"`
+	MetadataVersion             string             `yaml:"metadata_version" json:"metadata_version"`
+	MinimumVersionForUpgrade    string             `yaml:"minimum_version_for_upgrade" json:"minimum_version_for_upgrade"`
+	StemcellCriteria            StemcellCriteria   `yaml:"stemcell_criteria" json:"stemcell_criteria"`
+	AdditionalStemcellsCriteria []StemcellCriteria `yaml:"additional_stemcells_criteria" json:"additional_stemcells_criteria"`
+	RequiresProductVersions     []ProductVersion   `yaml:"requires_product_versions" json:"requires_product_versions"`
+}
+
+type StemcellCriteria struct {
+	OS                         string `yaml:"os" json:"os"`
+	Version                    string `yaml:"version" json:"version"`
+	RequiresCPI                bool   `yaml:"requires_cpi" json:"requires_cpi"`
+	EnablePatchSecurityUpdates bool   `yaml:"enable_patch_security_updates" json:"enable_patch_security_updates"`
+}
+
+type ProductVersion struct {
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+}
+
+// MetadataReader reads the metadata of tiles on disk.
+type MetadataReader struct{}
+
+func (MetadataReader) ReadMetadata(tilePath string) (Metadata, error) {
+	return ReadMetadata(tilePath)
+}
+
+// ReadMetadata reads the metadata of the tile at tilePath, which is the
+// first metadata/*.yml file in the zip, without extracting anything else.
+func ReadMetadata(tilePath string) (Metadata, error) {
+	r, err := zip.OpenReader(tilePath)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("could not open '%s' as a tile: %s", tilePath, err)
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if path.Dir(f.Name) != "metadata" {
+			continue
+		}
+
+		ext := path.Ext(f.Name)
+		if ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return Metadata{}, err
+		}
+
+		contents, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return Metadata{}, err
+		}
+
+		var metadata Metadata
+		err = yaml.Unmarshal(contents, &metadata)
+		if err != nil {
+			return Metadata{}, fmt.Errorf("could not parse '%s' in '%s': %s", f.Name, tilePath, err)
+		}
+
+		return metadata, nil
+	}
+
+	return Metadata{}, fmt.Errorf("'%s' is not a tile: it has no metadata/*.yml", tilePath)
+}
+
+// IsTile reports whether a file name is that of a tile.
+func IsTile(fileName string) bool {
+	return strings.EqualFold(path.Ext(fileName), ".pivotal")
+}
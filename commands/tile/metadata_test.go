@@ -0,0 +1,107 @@
+package tile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+)
+
+var _ = Describe("ReadMetadata", func() {
+	var (
+		dir      string
+		tilePath string
+	)
+
+	BeforeEach(func() {
+		dir = tempDir()
+		tilePath = filepath.Join(dir, "cf-2.10.3.pivotal")
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(dir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reads the metadata from metadata/*.yml", func() {
+		writeTile(tilePath, map[string]string{
+			"releases/cf.tgz":  "not-a-release",
+			"metadata/cf.yml":  tileMetadata,
+			"migrations/v1/01": "{}",
+		})
+
+		metadata, err := tile.ReadMetadata(tilePath)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(metadata).To(Equal(tile.Metadata{
+			Name:                     "cf",
+			ProductVersion:           "2.10.3",
+			Label:                    "Small Footprint TAS",
+			MetadataVersion:          "2.10",
+			MinimumVersionForUpgrade: "2.9.0",
+			StemcellCriteria: tile.StemcellCriteria{
+				OS:                         "ubuntu-xenial",
+				Version:                    "621.90",
+				EnablePatchSecurityUpdates: true,
+			},
+			AdditionalStemcellsCriteria: []tile.StemcellCriteria{
+				{OS: "windows2019", Version: "2019.30"},
+			},
+			RequiresProductVersions: []tile.ProductVersion{
+				{Name: "p-isolation-segment", Version: "~> 2.10"},
+			},
+		}))
+	})
+
+	Context("when the zip has no metadata", func() {
+		BeforeEach(func() {
+			writeTile(tilePath, map[string]string{
+				"releases/cf.tgz": "not-a-release",
+			})
+		})
+
+		It("returns an error", func() {
+			_, err := tile.ReadMetadata(tilePath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has no metadata/*.yml"))
+		})
+	})
+
+	Context("when the metadata is not valid YAML", func() {
+		BeforeEach(func() {
+			writeTile(tilePath, map[string]string{
+				"metadata/cf.yml": "name: [",
+			})
+		})
+
+		It("returns an error", func() {
+			_, err := tile.ReadMetadata(tilePath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not parse 'metadata/cf.yml'"))
+		})
+	})
+
+	Context("when the file is not a zip", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(tilePath, []byte("not-a-zip"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error", func() {
+			_, err := tile.ReadMetadata(tilePath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not open '%s' as a tile", tilePath))
+		})
+	})
+})
+
+var _ = Describe("IsTile", func() {
+	It("is true for .pivotal files", func() {
+		Expect(tile.IsTile("cf-2.10.3.pivotal")).To(BeTrue())
+		Expect(tile.IsTile("CF-2.10.3.PIVOTAL")).To(BeTrue())
+		Expect(tile.IsTile("bosh-stemcell-621.90.tgz")).To(BeFalse())
+	})
+})
@@ -0,0 +1,130 @@
+package tile
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+)
+
+type TileClient struct {
+	eh           errorhandler.ErrorHandler
+	format       string
+	outputWriter io.Writer
+	printer      printer.Printer
+}
+
+func NewTileClient(
+	eh errorhandler.ErrorHandler,
+	format string,
+	outputWriter io.Writer,
+	printer printer.Printer,
+) *TileClient {
+	return &TileClient{
+		eh:           eh,
+		format:       format,
+		outputWriter: outputWriter,
+		printer:      printer,
+	}
+}
+
+// inspectedTile is the metadata of a tile together with where it is.
+type inspectedTile struct {
+	Path     string `json:"path" yaml:"path"`
+	Metadata `yaml:",inline"`
+}
+
+// Inspect prints the metadata of the tile at tilePath.
+func (c *TileClient) Inspect(tilePath string) error {
+	tiles, err := c.read([]string{tilePath})
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	switch c.format {
+	case printer.PrintAsTable:
+		return c.printTable(tiles)
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(tiles[0])
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(tiles[0])
+	}
+
+	return nil
+}
+
+// InspectAll prints the metadata of each tile in tilePaths, as a list.
+func (c *TileClient) InspectAll(tilePaths []string) error {
+	tiles, err := c.read(tilePaths)
+	if err != nil {
+		return c.eh.HandleError(err)
+	}
+
+	switch c.format {
+	case printer.PrintAsTable:
+		return c.printTable(tiles)
+	case printer.PrintAsJSON:
+		return c.printer.PrintJSON(tiles)
+	case printer.PrintAsYAML:
+		return c.printer.PrintYAML(tiles)
+	}
+
+	return nil
+}
+
+func (c *TileClient) read(tilePaths []string) ([]inspectedTile, error) {
+	tiles := make([]inspectedTile, len(tilePaths))
+	for i, tilePath := range tilePaths {
+		metadata, err := ReadMetadata(tilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		tiles[i] = inspectedTile{
+			Path:     tilePath,
+			Metadata: metadata,
+		}
+	}
+	return tiles, nil
+}
+
+func (c *TileClient) printTable(tiles []inspectedTile) error {
+	table := tablewriter.NewWriter(c.outputWriter)
+	table.SetHeader([]string{
+		"Path",
+		"Name",
+		"Version",
+		"Stemcell",
+		"Requires",
+	})
+
+	for _, t := range tiles {
+		stemcells := []string{stemcellFor(t.StemcellCriteria)}
+		for _, s := range t.AdditionalStemcellsCriteria {
+			stemcells = append(stemcells, stemcellFor(s))
+		}
+
+		var requires []string
+		for _, p := range t.RequiresProductVersions {
+			requires = append(requires, fmt.Sprintf("%s %s", p.Name, p.Version))
+		}
+
+		table.Append([]string{
+			t.Path,
+			t.Name,
+			t.ProductVersion,
+			strings.Join(stemcells, ",\n"),
+			strings.Join(requires, ",\n"),
+		})
+	}
+	table.Render()
+
+	return nil
+}
+
+func stemcellFor(criteria StemcellCriteria) string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s", criteria.OS, criteria.Version))
+}
@@ -0,0 +1,142 @@
+package tile_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/tile"
+	"github.com/pivotal-cf/pivnet-cli/v3/errorhandler/errorhandlerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/printer"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = Describe("tile commands", func() {
+	var (
+		fakeErrorHandler *errorhandlerfakes.FakeErrorHandler
+
+		outBuffer bytes.Buffer
+		format    string
+
+		dir      string
+		tilePath string
+
+		client *tile.TileClient
+	)
+
+	BeforeEach(func() {
+		fakeErrorHandler = &errorhandlerfakes.FakeErrorHandler{}
+		outBuffer = bytes.Buffer{}
+		format = printer.PrintAsJSON
+
+		dir = tempDir()
+		tilePath = filepath.Join(dir, "cf-2.10.3.pivotal")
+		writeTile(tilePath, map[string]string{"metadata/cf.yml": tileMetadata})
+	})
+
+	JustBeforeEach(func() {
+		client = tile.NewTileClient(
+			fakeErrorHandler,
+			format,
+			&outBuffer,
+			printer.NewPrinter(&outBuffer),
+		)
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(dir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Inspect", func() {
+		It("prints the metadata of the tile", func() {
+			err := client.Inspect(tilePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			var printed map[string]interface{}
+			err = json.Unmarshal(outBuffer.Bytes(), &printed)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(printed["path"]).To(Equal(tilePath))
+			Expect(printed["name"]).To(Equal("cf"))
+			Expect(printed["product_version"]).To(Equal("2.10.3"))
+			Expect(printed["stemcell_criteria"]).To(HaveKeyWithValue("os", "ubuntu-xenial"))
+		})
+
+		Context("when printing as yaml", func() {
+			BeforeEach(func() {
+				format = printer.PrintAsYAML
+			})
+
+			It("prints the metadata inline with the path", func() {
+				err := client.Inspect(tilePath)
+				Expect(err).NotTo(HaveOccurred())
+
+				var printed map[string]interface{}
+				err = yaml.Unmarshal(outBuffer.Bytes(), &printed)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(printed["path"]).To(Equal(tilePath))
+				Expect(printed["product_version"]).To(Equal("2.10.3"))
+			})
+		})
+
+		Context("when printing as a table", func() {
+			BeforeEach(func() {
+				format = printer.PrintAsTable
+			})
+
+			It("prints the name, version, stemcells and dependencies", func() {
+				err := client.Inspect(tilePath)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(outBuffer.String()).To(ContainSubstring("2.10.3"))
+				Expect(outBuffer.String()).To(ContainSubstring("ubuntu-xenial 621.90"))
+				Expect(outBuffer.String()).To(ContainSubstring("windows2019 2019.30"))
+				Expect(outBuffer.String()).To(ContainSubstring("p-isolation-segment ~> 2.10"))
+			})
+		})
+
+		Context("when the tile cannot be read", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				tilePath = filepath.Join(dir, "missing.pivotal")
+				expectedErr = errors.New("handled")
+				fakeErrorHandler.HandleErrorReturns(expectedErr)
+			})
+
+			It("invokes the error handler", func() {
+				err := client.Inspect(tilePath)
+				Expect(err).To(Equal(expectedErr))
+
+				Expect(fakeErrorHandler.HandleErrorArgsForCall(0).Error()).To(ContainSubstring("could not open"))
+			})
+		})
+	})
+
+	Describe("InspectAll", func() {
+		It("prints the metadata of each tile as a list", func() {
+			otherTilePath := filepath.Join(dir, "p-isolation-segment-2.10.3.pivotal")
+			writeTile(otherTilePath, map[string]string{"metadata/p-isolation-segment.yml": "name: p-isolation-segment\nproduct_version: 2.10.3\n"})
+
+			err := client.InspectAll([]string{tilePath, otherTilePath})
+			Expect(err).NotTo(HaveOccurred())
+
+			var printed []map[string]interface{}
+			err = json.Unmarshal(outBuffer.Bytes(), &printed)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(printed).To(HaveLen(2))
+			Expect(printed[0]["name"]).To(Equal("cf"))
+			Expect(printed[1]["name"]).To(Equal("p-isolation-segment"))
+			Expect(printed[1]["path"]).To(Equal(otherTilePath))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands"
+	"github.com/pivotal-cf/pivnet-cli/v3/commands/commandsfakes"
+)
+
+var _ = Describe("tile commands", func() {
+	var (
+		field reflect.StructField
+
+		fakeTileClient *commandsfakes.FakeTileClient
+	)
+
+	BeforeEach(func() {
+		fakeTileClient = &commandsfakes.FakeTileClient{}
+
+		commands.NewTileClient = func() commands.TileClient {
+			return fakeTileClient
+		}
+	})
+
+	Describe("InspectTileCommand", func() {
+		var (
+			cmd *commands.InspectTileCommand
+		)
+
+		BeforeEach(func() {
+			cmd = &commands.InspectTileCommand{
+				Args: commands.InspectTileArgs{Path: "some/path/cf-2.10.3.pivotal"},
+			}
+		})
+
+		It("invokes the Tile client", func() {
+			err := cmd.Execute(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeTileClient.InspectCallCount()).To(Equal(1))
+			Expect(fakeTileClient.InspectArgsForCall(0)).To(Equal("some/path/cf-2.10.3.pivotal"))
+		})
+
+		Context("when the Tile client returns an error", func() {
+			var (
+				expectedErr error
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("expected error")
+				fakeTileClient.InspectReturns(expectedErr)
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(expectedErr))
+			})
+		})
+
+		Context("when Init returns an error", func() {
+			BeforeEach(func() {
+				initErr = fmt.Errorf("init error")
+			})
+
+			It("forwards the error", func() {
+				err := cmd.Execute(nil)
+
+				Expect(err).To(Equal(initErr))
+			})
+		})
+
+		Describe("Args", func() {
+			BeforeEach(func() {
+				field = fieldFor(commands.InspectTileCommand{}, "Args")
+			})
+
+			It("is required", func() {
+				Expect(isRequired(field)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -16,6 +16,7 @@ import (
 //go:generate counterfeiter . PivnetClient
 type PivnetClient interface {
 	ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error)
+	ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error)
 	UserGroups() ([]pivnet.UserGroup, error)
 	UserGroupsForRelease(productSlug string, releaseID int) ([]pivnet.UserGroup, error)
 	UserGroup(userGroupID int) (pivnet.UserGroup, error)
@@ -62,7 +63,7 @@ func (c *UserGroupClient) List(productSlug string, releaseVersion string) error
 		return c.printUserGroups(userGroups)
 	}
 
-	release, err := c.pivnetClient.ReleaseForVersion(productSlug, releaseVersion)
+	release, err := c.pivnetClient.ReleaseForConstraint(productSlug, releaseVersion)
 	if err != nil {
 		return c.eh.HandleError(err)
 	}
@@ -63,6 +63,20 @@ type FakePivnetClient struct {
 	deleteUserGroupReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReleaseForConstraintStub        func(string, string) (pivnet.Release, error)
+	releaseForConstraintMutex       sync.RWMutex
+	releaseForConstraintArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	releaseForConstraintReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	releaseForConstraintReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
 	ReleaseForVersionStub        func(string, string) (pivnet.Release, error)
 	releaseForVersionMutex       sync.RWMutex
 	releaseForVersionArgsForCall []struct {
@@ -168,15 +182,16 @@ func (fake *FakePivnetClient) AddMemberToGroup(arg1 int, arg2 string, arg3 bool)
 		arg2 string
 		arg3 bool
 	}{arg1, arg2, arg3})
+	stub := fake.AddMemberToGroupStub
+	fakeReturns := fake.addMemberToGroupReturns
 	fake.recordInvocation("AddMemberToGroup", []interface{}{arg1, arg2, arg3})
 	fake.addMemberToGroupMutex.Unlock()
-	if fake.AddMemberToGroupStub != nil {
-		return fake.AddMemberToGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.addMemberToGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -233,15 +248,16 @@ func (fake *FakePivnetClient) AddUserGroup(arg1 string, arg2 int, arg3 int) erro
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.AddUserGroupStub
+	fakeReturns := fake.addUserGroupReturns
 	fake.recordInvocation("AddUserGroup", []interface{}{arg1, arg2, arg3})
 	fake.addUserGroupMutex.Unlock()
-	if fake.AddUserGroupStub != nil {
-		return fake.AddUserGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addUserGroupReturns
 	return fakeReturns.result1
 }
 
@@ -300,15 +316,16 @@ func (fake *FakePivnetClient) CreateUserGroup(arg1 string, arg2 string, arg3 []s
 		arg2 string
 		arg3 []string
 	}{arg1, arg2, arg3Copy})
+	stub := fake.CreateUserGroupStub
+	fakeReturns := fake.createUserGroupReturns
 	fake.recordInvocation("CreateUserGroup", []interface{}{arg1, arg2, arg3Copy})
 	fake.createUserGroupMutex.Unlock()
-	if fake.CreateUserGroupStub != nil {
-		return fake.CreateUserGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createUserGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -363,15 +380,16 @@ func (fake *FakePivnetClient) DeleteUserGroup(arg1 int) error {
 	fake.deleteUserGroupArgsForCall = append(fake.deleteUserGroupArgsForCall, struct {
 		arg1 int
 	}{arg1})
+	stub := fake.DeleteUserGroupStub
+	fakeReturns := fake.deleteUserGroupReturns
 	fake.recordInvocation("DeleteUserGroup", []interface{}{arg1})
 	fake.deleteUserGroupMutex.Unlock()
-	if fake.DeleteUserGroupStub != nil {
-		return fake.DeleteUserGroupStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteUserGroupReturns
 	return fakeReturns.result1
 }
 
@@ -417,6 +435,71 @@ func (fake *FakePivnetClient) DeleteUserGroupReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakePivnetClient) ReleaseForConstraint(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.releaseForConstraintMutex.Lock()
+	ret, specificReturn := fake.releaseForConstraintReturnsOnCall[len(fake.releaseForConstraintArgsForCall)]
+	fake.releaseForConstraintArgsForCall = append(fake.releaseForConstraintArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleaseForConstraintStub
+	fakeReturns := fake.releaseForConstraintReturns
+	fake.recordInvocation("ReleaseForConstraint", []interface{}{arg1, arg2})
+	fake.releaseForConstraintMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCallCount() int {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	return len(fake.releaseForConstraintArgsForCall)
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = stub
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintArgsForCall(i int) (string, string) {
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
+	argsForCall := fake.releaseForConstraintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturns(result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	fake.releaseForConstraintReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) ReleaseForConstraintReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.releaseForConstraintMutex.Lock()
+	defer fake.releaseForConstraintMutex.Unlock()
+	fake.ReleaseForConstraintStub = nil
+	if fake.releaseForConstraintReturnsOnCall == nil {
+		fake.releaseForConstraintReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.releaseForConstraintReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivnet.Release, error) {
 	fake.releaseForVersionMutex.Lock()
 	ret, specificReturn := fake.releaseForVersionReturnsOnCall[len(fake.releaseForVersionArgsForCall)]
@@ -424,15 +507,16 @@ func (fake *FakePivnetClient) ReleaseForVersion(arg1 string, arg2 string) (pivne
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ReleaseForVersionStub
+	fakeReturns := fake.releaseForVersionReturns
 	fake.recordInvocation("ReleaseForVersion", []interface{}{arg1, arg2})
 	fake.releaseForVersionMutex.Unlock()
-	if fake.ReleaseForVersionStub != nil {
-		return fake.ReleaseForVersionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.releaseForVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -488,15 +572,16 @@ func (fake *FakePivnetClient) RemoveMemberFromGroup(arg1 int, arg2 string) (pivn
 		arg1 int
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.RemoveMemberFromGroupStub
+	fakeReturns := fake.removeMemberFromGroupReturns
 	fake.recordInvocation("RemoveMemberFromGroup", []interface{}{arg1, arg2})
 	fake.removeMemberFromGroupMutex.Unlock()
-	if fake.RemoveMemberFromGroupStub != nil {
-		return fake.RemoveMemberFromGroupStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.removeMemberFromGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -553,15 +638,16 @@ func (fake *FakePivnetClient) RemoveUserGroup(arg1 string, arg2 int, arg3 int) e
 		arg2 int
 		arg3 int
 	}{arg1, arg2, arg3})
+	stub := fake.RemoveUserGroupStub
+	fakeReturns := fake.removeUserGroupReturns
 	fake.recordInvocation("RemoveUserGroup", []interface{}{arg1, arg2, arg3})
 	fake.removeUserGroupMutex.Unlock()
-	if fake.RemoveUserGroupStub != nil {
-		return fake.RemoveUserGroupStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeUserGroupReturns
 	return fakeReturns.result1
 }
 
@@ -613,15 +699,16 @@ func (fake *FakePivnetClient) UpdateUserGroup(arg1 pivnet.UserGroup) (pivnet.Use
 	fake.updateUserGroupArgsForCall = append(fake.updateUserGroupArgsForCall, struct {
 		arg1 pivnet.UserGroup
 	}{arg1})
+	stub := fake.UpdateUserGroupStub
+	fakeReturns := fake.updateUserGroupReturns
 	fake.recordInvocation("UpdateUserGroup", []interface{}{arg1})
 	fake.updateUserGroupMutex.Unlock()
-	if fake.UpdateUserGroupStub != nil {
-		return fake.UpdateUserGroupStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateUserGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -676,15 +763,16 @@ func (fake *FakePivnetClient) UserGroup(arg1 int) (pivnet.UserGroup, error) {
 	fake.userGroupArgsForCall = append(fake.userGroupArgsForCall, struct {
 		arg1 int
 	}{arg1})
+	stub := fake.UserGroupStub
+	fakeReturns := fake.userGroupReturns
 	fake.recordInvocation("UserGroup", []interface{}{arg1})
 	fake.userGroupMutex.Unlock()
-	if fake.UserGroupStub != nil {
-		return fake.UserGroupStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.userGroupReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -738,15 +826,16 @@ func (fake *FakePivnetClient) UserGroups() ([]pivnet.UserGroup, error) {
 	ret, specificReturn := fake.userGroupsReturnsOnCall[len(fake.userGroupsArgsForCall)]
 	fake.userGroupsArgsForCall = append(fake.userGroupsArgsForCall, struct {
 	}{})
+	stub := fake.UserGroupsStub
+	fakeReturns := fake.userGroupsReturns
 	fake.recordInvocation("UserGroups", []interface{}{})
 	fake.userGroupsMutex.Unlock()
-	if fake.UserGroupsStub != nil {
-		return fake.UserGroupsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.userGroupsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -795,15 +884,16 @@ func (fake *FakePivnetClient) UserGroupsForRelease(arg1 string, arg2 int) ([]piv
 		arg1 string
 		arg2 int
 	}{arg1, arg2})
+	stub := fake.UserGroupsForReleaseStub
+	fakeReturns := fake.userGroupsForReleaseReturns
 	fake.recordInvocation("UserGroupsForRelease", []interface{}{arg1, arg2})
 	fake.userGroupsForReleaseMutex.Unlock()
-	if fake.UserGroupsForReleaseStub != nil {
-		return fake.UserGroupsForReleaseStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.userGroupsForReleaseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -863,6 +953,8 @@ func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
 	defer fake.createUserGroupMutex.RUnlock()
 	fake.deleteUserGroupMutex.RLock()
 	defer fake.deleteUserGroupMutex.RUnlock()
+	fake.releaseForConstraintMutex.RLock()
+	defer fake.releaseForConstraintMutex.RUnlock()
 	fake.releaseForVersionMutex.RLock()
 	defer fake.releaseForVersionMutex.RUnlock()
 	fake.removeMemberFromGroupMutex.RLock()
@@ -0,0 +1,15 @@
+package diskspace
+
+// Checker reports the space available on the filesystems holding
+// directories.
+type Checker struct{}
+
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Free returns the number of bytes available to the current user on the
+// filesystem that holds dir.
+func (c Checker) Free(dir string) (uint64, error) {
+	return free(dir)
+}
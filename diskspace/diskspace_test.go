@@ -0,0 +1,42 @@
+package diskspace_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/diskspace"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		tempDir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns the free space of the directory", func() {
+		free, err := diskspace.NewChecker().Free(tempDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(free).To(BeNumerically(">", 0))
+	})
+
+	Context("when the directory does not exist", func() {
+		It("returns an error", func() {
+			_, err := diskspace.NewChecker().Free(filepath.Join(tempDir, "missing"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
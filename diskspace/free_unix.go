@@ -0,0 +1,16 @@
+//go:build !windows
+// +build !windows
+
+package diskspace
+
+import "syscall"
+
+func free(dir string) (uint64, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(dir, &stat)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
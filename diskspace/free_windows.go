@@ -0,0 +1,28 @@
+package diskspace
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var getDiskFreeSpaceEx = syscall.NewLazyDLL("kernel32.dll").NewProc("GetDiskFreeSpaceExW")
+
+func free(dir string) (uint64, error) {
+	path, err := syscall.UTF16PtrFromString(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var freeBytesAvailable uint64
+	r, _, err := getDiskFreeSpaceEx.Call(
+		uintptr(unsafe.Pointer(path)),
+		uintptr(unsafe.Pointer(&freeBytesAvailable)),
+		0,
+		0,
+	)
+	if r == 0 {
+		return 0, err
+	}
+
+	return freeBytesAvailable, nil
+}
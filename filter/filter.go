@@ -3,10 +3,12 @@ package filter
 import (
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	pivnet "github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/semver"
 )
 
 type Filter struct {
@@ -37,6 +39,45 @@ func (f Filter) ReleasesByVersion(releases []pivnet.Release, version string) ([]
 	return filteredReleases, nil
 }
 
+// ReleasesByConstraint returns all releases whose version satisfies the
+// provided semantic version constraint, highest version first. Releases whose
+// version cannot be parsed are skipped.
+func (f Filter) ReleasesByConstraint(releases []pivnet.Release, constraint string) ([]pivnet.Release, error) {
+	c, err := semver.ParseConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	type versionedRelease struct {
+		release pivnet.Release
+		version semver.Version
+	}
+
+	var matches []versionedRelease
+	for _, release := range releases {
+		v, err := semver.ParseLenient(release.Version)
+		if err != nil {
+			f.l.Debug("filter.ReleasesByConstraint skipping release", logger.Data{"version": release.Version})
+			continue
+		}
+
+		if c.Check(v) {
+			matches = append(matches, versionedRelease{release: release, version: v})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].version.Compare(matches[j].version) > 0
+	})
+
+	filteredReleases := make([]pivnet.Release, 0, len(matches))
+	for _, m := range matches {
+		filteredReleases = append(filteredReleases, m.release)
+	}
+
+	return filteredReleases, nil
+}
+
 func (f Filter) ProductFileKeysByGlobs(
 	productFiles []pivnet.ProductFile,
 	globs []string,
@@ -129,6 +129,99 @@ var _ = Describe("Filter", func() {
 		})
 	})
 
+	Describe("ReleasesByConstraint", func() {
+		var (
+			constraint string
+			releases   []pivnet.Release
+		)
+
+		BeforeEach(func() {
+			constraint = "~2.10"
+
+			releases = []pivnet.Release{
+				{ID: 1, Version: "2.9.4"},
+				{ID: 2, Version: "2.10.2"},
+				{ID: 3, Version: "2.10.10"},
+				{ID: 4, Version: "2.11.0-rc.1"},
+				{ID: 5, Version: "not-a-version"},
+				{ID: 6, Version: "2.10.3-build.12"},
+				{ID: 7, Version: "2.10.6-rc.1"},
+			}
+		})
+
+		It("returns matching releases without prereleases, highest version first", func() {
+			filteredReleases, err := f.ReleasesByConstraint(releases, constraint)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filteredReleases).To(Equal([]pivnet.Release{
+				releases[2],
+				releases[1],
+			}))
+		})
+
+		Context("when the constraint is latest", func() {
+			BeforeEach(func() {
+				constraint = "latest"
+			})
+
+			It("returns all releases that are not prereleases, highest first", func() {
+				filteredReleases, err := f.ReleasesByConstraint(releases, constraint)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(filteredReleases).To(Equal([]pivnet.Release{
+					releases[2],
+					releases[1],
+					releases[0],
+				}))
+			})
+		})
+
+		Context("when the constraint names a prerelease", func() {
+			BeforeEach(func() {
+				constraint = ">=2.11.0-rc.1"
+			})
+
+			It("returns the prereleases of that version", func() {
+				filteredReleases, err := f.ReleasesByConstraint(releases, constraint)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(filteredReleases).To(Equal([]pivnet.Release{
+					releases[3],
+				}))
+			})
+		})
+
+		Context("when no releases match", func() {
+			BeforeEach(func() {
+				constraint = ">=3.0.0"
+			})
+
+			It("returns empty slice without error", func() {
+				filteredReleases, err := f.ReleasesByConstraint(releases, constraint)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(filteredReleases).NotTo(BeNil())
+				Expect(filteredReleases).To(HaveLen(0))
+			})
+		})
+
+		Context("when the constraint is invalid", func() {
+			BeforeEach(func() {
+				constraint = "some-invalid-constraint"
+			})
+
+			It("returns error", func() {
+				_, err := f.ReleasesByConstraint(releases, constraint)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("ProductFileKeysByGlobs", func() {
 		var (
 			productFiles []pivnet.ProductFile
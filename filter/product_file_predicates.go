@@ -0,0 +1,156 @@
+package filter
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+)
+
+// ProductFilePredicate reports whether a product file should be kept.
+type ProductFilePredicate func(pivnet.ProductFile) (bool, error)
+
+// ProductFiles returns the product files that satisfy every predicate, in
+// their original order.
+func (f Filter) ProductFiles(
+	productFiles []pivnet.ProductFile,
+	predicates ...ProductFilePredicate,
+) ([]pivnet.ProductFile, error) {
+	f.l.Debug("filter.ProductFiles", logger.Data{"predicates": len(predicates)})
+
+	filtered := []pivnet.ProductFile{}
+	for _, p := range productFiles {
+		matched, err := All(predicates...)(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if matched {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered, nil
+}
+
+// All is satisfied when every predicate is, or when there are none.
+func All(predicates ...ProductFilePredicate) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		for _, predicate := range predicates {
+			matched, err := predicate(p)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// Any is satisfied when at least one predicate is.
+func Any(predicates ...ProductFilePredicate) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		for _, predicate := range predicates {
+			matched, err := predicate(p)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	}
+}
+
+func Not(predicate ProductFilePredicate) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		matched, err := predicate(p)
+		return !matched, err
+	}
+}
+
+// MatchingGlobs matches product files whose file name, the last segment of
+// their AWS object key, matches any of the globs.
+func MatchingGlobs(globs []string) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		parts := strings.Split(p.AWSObjectKey, "/")
+		fileName := parts[len(parts)-1]
+
+		for _, pattern := range globs {
+			matched, err := filepath.Match(pattern, fileName)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	}
+}
+
+func WithIDs(ids []int) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		for _, id := range ids {
+			if p.ID == id {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// OfFileTypes matches product files of any of the file types, e.g. Software
+// or Documentation, ignoring case.
+func OfFileTypes(fileTypes []string) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		return containsFold(fileTypes, p.FileType), nil
+	}
+}
+
+// ForPlatforms matches product files for any of the platforms, ignoring
+// case.
+func ForPlatforms(platforms []string) ProductFilePredicate {
+	return func(p pivnet.ProductFile) (bool, error) {
+		for _, platform := range p.Platforms {
+			if containsFold(platforms, platform) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// InFileGroups matches product files that belong to any of the named file
+// groups, ignoring case. It returns an error if a name does not match any of
+// fileGroups.
+func InFileGroups(fileGroups []pivnet.FileGroup, names []string) (ProductFilePredicate, error) {
+	ids := map[int]bool{}
+
+	for _, name := range names {
+		found := false
+		for _, fg := range fileGroups {
+			if !strings.EqualFold(fg.Name, name) {
+				continue
+			}
+
+			found = true
+			for _, pf := range fg.ProductFiles {
+				ids[pf.ID] = true
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("file group not found: '%s'", name)
+		}
+	}
+
+	return func(p pivnet.ProductFile) (bool, error) {
+		return ids[p.ID], nil
+	}, nil
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
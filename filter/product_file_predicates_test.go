@@ -0,0 +1,125 @@
+package filter_test
+
+import (
+	"errors"
+
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger/loggerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProductFiles", func() {
+	var (
+		f            *filter.Filter
+		productFiles []pivnet.ProductFile
+	)
+
+	BeforeEach(func() {
+		f = filter.NewFilter(&loggerfakes.FakeLogger{})
+
+		productFiles = []pivnet.ProductFile{
+			{
+				ID:           1234,
+				FileType:     pivnet.FileTypeSoftware,
+				Platforms:    []string{"Linux", "Windows"},
+				AWSObjectKey: "/some/remote/path/to/file-0.tgz",
+			},
+			{
+				ID:           2345,
+				FileType:     pivnet.FileTypeDocumentation,
+				AWSObjectKey: "/some/remote/path/to/file-1.pdf",
+			},
+			{
+				ID:           3456,
+				FileType:     pivnet.FileTypeOpenSourceLicense,
+				Platforms:    []string{"Linux"},
+				AWSObjectKey: "/some/remote/path/to/file-2.txt",
+			},
+		}
+	})
+
+	It("returns all product files when there are no predicates", func() {
+		filtered, err := f.ProductFiles(productFiles)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filtered).To(Equal(productFiles))
+	})
+
+	It("returns the product files that match every predicate", func() {
+		filtered, err := f.ProductFiles(
+			productFiles,
+			filter.ForPlatforms([]string{"linux"}),
+			filter.Not(filter.OfFileTypes([]string{"open source license"})),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filtered).To(Equal([]pivnet.ProductFile{productFiles[0]}))
+	})
+
+	Context("when a predicate returns an error", func() {
+		It("returns the error", func() {
+			predicateErr := errors.New("predicate error")
+
+			_, err := f.ProductFiles(productFiles, func(pivnet.ProductFile) (bool, error) {
+				return false, predicateErr
+			})
+			Expect(err).To(Equal(predicateErr))
+		})
+	})
+
+	Describe("Any", func() {
+		It("matches product files that match any predicate", func() {
+			filtered, err := f.ProductFiles(
+				productFiles,
+				filter.Any(filter.WithIDs([]int{2345}), filter.MatchingGlobs([]string{"*.txt"})),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filtered).To(Equal([]pivnet.ProductFile{productFiles[1], productFiles[2]}))
+		})
+	})
+
+	Describe("MatchingGlobs", func() {
+		It("matches on the file name", func() {
+			filtered, err := f.ProductFiles(productFiles, filter.MatchingGlobs([]string{"file-*.tgz", "*.pdf"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filtered).To(Equal([]pivnet.ProductFile{productFiles[0], productFiles[1]}))
+		})
+
+		Context("when a bad pattern is passed", func() {
+			It("returns an error", func() {
+				_, err := f.ProductFiles(productFiles, filter.MatchingGlobs([]string{"["}))
+				Expect(err).To(MatchError("syntax error in pattern"))
+			})
+		})
+	})
+
+	Describe("InFileGroups", func() {
+		var (
+			fileGroups []pivnet.FileGroup
+		)
+
+		BeforeEach(func() {
+			fileGroups = []pivnet.FileGroup{
+				{Name: "Group A", ProductFiles: []pivnet.ProductFile{{ID: 1234}}},
+				{Name: "Group B", ProductFiles: []pivnet.ProductFile{{ID: 3456}}},
+			}
+		})
+
+		It("matches product files in any of the named file groups", func() {
+			inFileGroups, err := filter.InFileGroups(fileGroups, []string{"group a", "Group B"})
+			Expect(err).NotTo(HaveOccurred())
+
+			filtered, err := f.ProductFiles(productFiles, inFileGroups)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filtered).To(Equal([]pivnet.ProductFile{productFiles[0], productFiles[2]}))
+		})
+
+		Context("when a file group is not found", func() {
+			It("returns an error", func() {
+				_, err := filter.InFileGroups(fileGroups, []string{"Group C"})
+				Expect(err).To(MatchError("file group not found: 'Group C'"))
+			})
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package gp
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+)
+
+// cancelTransport cancels requests, including reading their responses, when
+// ctx is done. go-pivnet does not take a context, so this is the only way to
+// interrupt a request it makes.
+type cancelTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func newCancelTransport(ctx context.Context, next http.RoundTripper) http.RoundTripper {
+	return &cancelTransport{
+		ctx:  ctx,
+		next: next,
+	}
+}
+
+func (t *cancelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(req.Context())
+	go func() {
+		select {
+		case <-t.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelBody{
+		ReadCloser: resp.Body,
+		ctx:        t.ctx,
+		cancel:     cancel,
+	}
+	return resp, nil
+}
+
+// cancelBody releases the request context once the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Read reports a read that failed because the context is done as a
+// *net.OpError, which the go-pivnet downloader assumes every error other
+// than an unexpected EOF to be.
+func (b *cancelBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && b.ctx.Err() != nil {
+		if _, ok := err.(*net.OpError); !ok {
+			err = &net.OpError{Op: "read", Net: "tcp", Err: err}
+		}
+	}
+	return n, err
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,97 @@
+package gp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger/loggerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/gp"
+	"github.com/pivotal-cf/pivnet-cli/v3/gp/gpfakes"
+)
+
+var _ = Describe("Cancellation", func() {
+	const (
+		productSlug = "product-slug"
+	)
+
+	var (
+		server *ghttp.Server
+
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		client *gp.Client
+
+		fileGroupsPath string
+		unblock        chan struct{}
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.SetAllowUnhandledRequests(true)
+
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fileGroupsPath = fmt.Sprintf("%s/products/%s/file_groups", apiPrefix, productSlug)
+		unblock = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		config := pivnet.ClientConfig{
+			Host:      server.URL(),
+			UserAgent: "some-user-agent",
+		}
+		client = gp.NewClient(ctx, &gpfakes.FakeAccessTokenService{}, config, gp.RetryConfig{}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		close(unblock)
+		cancel()
+		server.Close()
+	})
+
+	Context("when the context is already done", func() {
+		BeforeEach(func() {
+			cancel()
+		})
+
+		It("does not send the request", func() {
+			_, err := client.FileGroups(productSlug)
+			Expect(err).To(HaveOccurred())
+
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when the context is done while a request is in flight", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fileGroupsPath),
+					func(w http.ResponseWriter, r *http.Request) {
+						<-unblock
+					},
+				),
+			)
+		})
+
+		It("returns without waiting for the response", func() {
+			go func() {
+				defer GinkgoRecover()
+				Eventually(server.ReceivedRequests).Should(HaveLen(1))
+				cancel()
+			}()
+
+			start := time.Now()
+			_, err := client.FileGroups(productSlug)
+			Expect(err).To(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+	})
+})
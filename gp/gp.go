@@ -1,17 +1,34 @@
 package gp
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pivotal-cf/go-pivnet/v7"
 	"github.com/pivotal-cf/go-pivnet/v7/download"
 	"github.com/pivotal-cf/go-pivnet/v7/logger"
+	"github.com/pivotal-cf/pivnet-cli/v3/filter"
+	"github.com/pivotal-cf/pivnet-cli/v3/ratelimit"
 )
 
+// concurrentDownloadRanges is the number of ranges that go-pivnet downloads a
+// product file in.
+const concurrentDownloadRanges = 10
+
 type Client struct {
-	client pivnet.Client
+	ctx         context.Context
+	client      pivnet.Client
+	config      pivnet.ClientConfig
+	retryConfig RetryConfig
+	logger      logger.Logger
+
+	downloadLimiter *ratelimit.Limiter
 }
 
 //go:generate counterfeiter . AccessTokenService
@@ -19,12 +36,33 @@ type AccessTokenService interface {
 	AccessToken() (string, error)
 }
 
-func NewClient(token AccessTokenService, config pivnet.ClientConfig, logger logger.Logger) *Client {
+// NewClient returns a client for the Pivnet API. Requests that are safe to
+// repeat, including those made to download product files, are retried as
+// configured by retryConfig. Every request is cancelled when ctx is done.
+func NewClient(ctx context.Context, token AccessTokenService, config pivnet.ClientConfig, retryConfig RetryConfig, logger logger.Logger) *Client {
+	client := pivnet.NewClient(token, config, logger)
+	client.HTTP.Transport = newCancelTransport(
+		ctx,
+		newRetryTransport(client.HTTP.Transport, retryConfig, logger),
+	)
+
 	return &Client{
-		client: pivnet.NewClient(token, config, logger),
+		ctx:         ctx,
+		client:      client,
+		config:      config,
+		retryConfig: retryConfig,
+		logger:      logger,
 	}
 }
 
+// WithDownloadRateLimit returns a copy of the client that downloads product
+// files no faster than bytesPerSecond in total, however many are downloading
+// at the same time.
+func (c Client) WithDownloadRateLimit(bytesPerSecond int64) *Client {
+	c.downloadLimiter = ratelimit.NewLimiter(bytesPerSecond)
+	return &c
+}
+
 func (c Client) Auth() (bool, error) {
 	return c.client.Auth.Check()
 }
@@ -45,13 +83,28 @@ func (c Client) Release(productSlug string, releaseID int) (pivnet.Release, erro
 	return c.client.Releases.Get(productSlug, releaseID)
 }
 
+// ReleaseForVersion returns the release with exactly the provided version.
+// Commands that change a release use it, so that a constraint can never
+// select a release other than the one that was meant.
 func (c Client) ReleaseForVersion(productSlug string, releaseVersion string) (pivnet.Release, error) {
+	return c.releaseFor(productSlug, releaseVersion, false)
+}
+
+// ReleaseForConstraint returns the release with exactly the provided version.
+// If there is none and the version is a semantic version constraint such as
+// "~2.10" or "latest", the highest release satisfying it is returned. It is
+// only for commands that read or download a release.
+func (c Client) ReleaseForConstraint(productSlug string, releaseVersion string) (pivnet.Release, error) {
+	return c.releaseFor(productSlug, releaseVersion, true)
+}
+
+func (c Client) releaseFor(productSlug string, releaseVersion string, resolveConstraint bool) (pivnet.Release, error) {
 	releases, err := c.ReleasesForProductSlug(productSlug)
 	if err != nil {
 		return pivnet.Release{}, err
 	}
 
-	release, err := c.releaseForReleaseVersion(releases, releaseVersion)
+	release, err := c.releaseForReleaseVersion(releases, releaseVersion, resolveConstraint)
 	if err != nil {
 		return pivnet.Release{}, err
 	}
@@ -59,17 +112,46 @@ func (c Client) ReleaseForVersion(productSlug string, releaseVersion string) (pi
 	return c.client.Releases.Get(productSlug, release.ID)
 }
 
-func (c Client) releaseForReleaseVersion(releases []pivnet.Release, releaseVersion string) (pivnet.Release, error) {
+func (c Client) releaseForReleaseVersion(releases []pivnet.Release, releaseVersion string, resolveConstraint bool) (pivnet.Release, error) {
 	for _, r := range releases {
 		if r.Version == releaseVersion {
 			return r, nil
 		}
 	}
 
-	return pivnet.Release{}, fmt.Errorf(
+	notFound := fmt.Errorf(
 		"release not found for version: '%s'",
 		releaseVersion,
 	)
+
+	if !resolveConstraint {
+		return pivnet.Release{}, notFound
+	}
+
+	// A version that is not a valid constraint was meant as an exact version,
+	// unless it uses an operator.
+	matchingReleases, err := filter.NewFilter(c.logger).ReleasesByConstraint(releases, releaseVersion)
+	if err != nil {
+		if looksLikeConstraint(releaseVersion) {
+			return pivnet.Release{}, err
+		}
+		return pivnet.Release{}, notFound
+	}
+
+	if len(matchingReleases) == 0 {
+		return pivnet.Release{}, notFound
+	}
+
+	c.logger.Debug("Resolved release version", logger.Data{
+		"constraint": releaseVersion,
+		"version":    matchingReleases[0].Version,
+	})
+
+	return matchingReleases[0], nil
+}
+
+func looksLikeConstraint(releaseVersion string) bool {
+	return strings.ContainsAny(releaseVersion, "~^<>=!") || strings.Contains(releaseVersion, "||")
 }
 
 func (c Client) UpdateRelease(productSlug string, release pivnet.Release) (pivnet.Release, error) {
@@ -170,6 +252,14 @@ func (c Client) ProductFilesForRelease(productSlug string, releaseID int) ([]piv
 	return productFiles, nil
 }
 
+// ProductFilesAddedToRelease returns only the product files that were added
+// to the release itself. Unlike ProductFilesForRelease it leaves out the
+// product files of the file groups of the release, so it is what commands
+// that add and remove product files compare against.
+func (c Client) ProductFilesAddedToRelease(productSlug string, releaseID int) ([]pivnet.ProductFile, error) {
+	return c.client.ProductFiles.ListForRelease(productSlug, releaseID)
+}
+
 func (c Client) ProductFiles(productSlug string) ([]pivnet.ProductFile, error) {
 	return c.client.ProductFiles.List(productSlug)
 }
@@ -186,8 +276,198 @@ func (c Client) DeleteProductFile(productSlug string, productFileID int) (pivnet
 	return c.client.ProductFiles.Delete(productSlug, productFileID)
 }
 
+// DownloadProductFile downloads a product file in concurrent ranges, as
+// go-pivnet does, but through a client that retries failed requests.
 func (c Client) DownloadProductFile(location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error {
-	return c.client.ProductFiles.DownloadForRelease(location, productSlug, releaseID, productFileID, progressWriter)
+	pf, err := c.client.ProductFiles.GetForRelease(productSlug, releaseID, productFileID)
+	if err != nil {
+		return fmt.Errorf("GetForRelease: %s", err)
+	}
+
+	downloadLink, err := pf.DownloadLink()
+	if err != nil {
+		return fmt.Errorf("DownloadLink: %s", err)
+	}
+
+	downloader := download.Client{
+		HTTPClient: c.downloadHTTPClient(),
+		Ranger:     download.NewRanger(concurrentDownloadRanges),
+		Bar:        newProgressBar(progressWriter, 0),
+		Logger:     c.logger,
+		Timeout:    30 * time.Second,
+	}
+
+	err = downloader.Get(
+		location,
+		pivnet.NewProductFileLinkFetcher(downloadLink, c.client),
+		progressWriter,
+	)
+	if err != nil {
+		return fmt.Errorf("Downloader.Get: %s", err)
+	}
+
+	return nil
+}
+
+func (c Client) downloadHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: newCancelTransport(
+			c.ctx,
+			newRetryTransport(
+				newLimitTransport(
+					c.downloadLimiter,
+					&http.Transport{
+						TLSClientConfig: &tls.Config{
+							InsecureSkipVerify: c.config.SkipSSLValidation,
+						},
+						Proxy: http.ProxyFromEnvironment,
+					},
+				),
+				c.retryConfig,
+				c.logger,
+			),
+		),
+	}
+}
+
+// ResumeProductFileDownload downloads a product file as a single stream,
+// starting at startingByte. Unlike DownloadProductFile the bytes are written
+// in order, so an interrupted download leaves a valid prefix on disk that can
+// be continued later.
+//
+// If digestWriter is not nil it receives a copy of every byte, in order. When
+// a request fails part way and is retried from startingByte, digestWriter is
+// reset first if it implements Reset().
+func (c Client) ResumeProductFileDownload(location *download.FileInfo, productSlug string, releaseID int, productFileID int, startingByte int64, progressWriter io.Writer, digestWriter io.Writer) error {
+	pf, err := c.client.ProductFiles.GetForRelease(productSlug, releaseID, productFileID)
+	if err != nil {
+		return fmt.Errorf("GetForRelease: %s", err)
+	}
+
+	downloadLink, err := pf.DownloadLink()
+	if err != nil {
+		return fmt.Errorf("DownloadLink: %s", err)
+	}
+
+	downloader := download.Client{
+		HTTPClient: c.downloadHTTPClient(),
+		Ranger:     resumeRanger{startingByte: startingByte},
+		Bar:        newResumeBar(newProgressBar(progressWriter, startingByte), digestWriter),
+		Logger:     c.logger,
+		Timeout:    30 * time.Second,
+	}
+
+	err = downloader.Get(
+		location,
+		pivnet.NewProductFileLinkFetcher(downloadLink, c.client),
+		progressWriter,
+	)
+	if err != nil {
+		return fmt.Errorf("Downloader.Get: %s", err)
+	}
+
+	return nil
+}
+
+type resumeRanger struct {
+	startingByte int64
+}
+
+func (r resumeRanger) BuildRange(contentLength int64) ([]download.Range, error) {
+	if r.startingByte > contentLength {
+		return nil, fmt.Errorf(
+			"partial file is larger than the remote file: %d bytes on disk, %d bytes remote",
+			r.startingByte,
+			contentLength,
+		)
+	}
+
+	if r.startingByte == contentLength {
+		return nil, nil
+	}
+
+	return []download.Range{
+		download.NewRange(
+			r.startingByte,
+			contentLength-1,
+			http.Header{"Range": []string{fmt.Sprintf("bytes=%d-%d", r.startingByte, contentLength-1)}},
+		),
+	}, nil
+}
+
+type resetter interface {
+	Reset()
+}
+
+// resumeBar also feeds the downloaded bytes to a digest writer.
+//
+// The downloader reads each response through a timeout reader, which keeps
+// reading in the background after it has timed out. The bytes of a request
+// that has been rewound therefore reach the digest late, and are dropped:
+// every request is tagged with the rewind it follows, and only the bytes of
+// the current request are written.
+type resumeBar struct {
+	progressBar
+	digest *requestDigest
+}
+
+type requestDigest struct {
+	mu      sync.Mutex
+	writer  io.Writer
+	request int
+}
+
+func newResumeBar(bar progressBar, digestWriter io.Writer) resumeBar {
+	b := resumeBar{progressBar: bar}
+	if digestWriter != nil {
+		b.digest = &requestDigest{writer: digestWriter}
+	}
+	return b
+}
+
+// Add with a negative value rewinds the bar by the bytes of a failed request
+// before it is retried from startingByte, so the digest has to start over
+// too. Any other value only moves the bar.
+func (b resumeBar) Add(totalWritten int) int {
+	if totalWritten < 0 && b.digest != nil {
+		b.digest.mu.Lock()
+		b.digest.request++
+		if r, ok := b.digest.writer.(resetter); ok {
+			r.Reset()
+		}
+		b.digest.mu.Unlock()
+	}
+
+	return b.progressBar.Add(totalWritten)
+}
+
+func (b resumeBar) NewProxyReader(reader io.Reader) io.Reader {
+	if b.digest != nil {
+		b.digest.mu.Lock()
+		request := b.digest.request
+		b.digest.mu.Unlock()
+
+		reader = io.TeeReader(reader, requestWriter{digest: b.digest, request: request})
+	}
+
+	return b.progressBar.NewProxyReader(reader)
+}
+
+// requestWriter writes to the digest only while its request is current.
+type requestWriter struct {
+	digest  *requestDigest
+	request int
+}
+
+func (w requestWriter) Write(p []byte) (int, error) {
+	w.digest.mu.Lock()
+	defer w.digest.mu.Unlock()
+
+	if w.request != w.digest.request {
+		return len(p), nil
+	}
+
+	return w.digest.writer.Write(p)
 }
 
 func (c Client) Products() ([]pivnet.Product, error) {
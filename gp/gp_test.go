@@ -1,14 +1,21 @@
 package gp_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"github.com/pivotal-cf/pivnet-cli/v3/gp/gpfakes"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
 	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/download"
 	"github.com/pivotal-cf/go-pivnet/v7/logger/loggerfakes"
 	"github.com/pivotal-cf/pivnet-cli/v3/gp"
 )
@@ -36,7 +43,7 @@ var _ = Describe("Client", func() {
 			Host:      server.URL(),
 			UserAgent: "some-user-agent",
 		}
-		client = gp.NewClient(fakeAccessTokenService, config, fakeLogger)
+		client = gp.NewClient(context.Background(), fakeAccessTokenService, config, gp.RetryConfig{}, fakeLogger)
 	})
 
 	Describe("ReleaseForVersion", func() {
@@ -133,6 +140,88 @@ var _ = Describe("Client", func() {
 			})
 		})
 
+		Context("When the release version is a constraint", func() {
+			BeforeEach(func() {
+				releaseVersion = "~2.10"
+
+				release = pivnet.Release{
+					ID:      1234,
+					Version: "2.10.3",
+				}
+
+				releasesResponse = pivnet.ReleasesResponse{[]pivnet.Release{
+					{ID: 2345, Version: "2.10.1"},
+					release,
+					{ID: 3456, Version: "2.11.0"},
+				}}
+				releaseResponse = release
+			})
+
+			It("does not resolve it", func() {
+				_, err := client.ReleaseForVersion(productSlug, releaseVersion)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("release not found for version: '~2.10'"))
+			})
+
+			Context("When it is resolved with ReleaseForConstraint", func() {
+				It("returns the highest matching release", func() {
+					returnedRelease, err := client.ReleaseForConstraint(productSlug, releaseVersion)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(returnedRelease.ID).To(Equal(release.ID))
+					Expect(returnedRelease.Version).To(Equal("2.10.3"))
+				})
+
+				Context("When no release matches", func() {
+					BeforeEach(func() {
+						releaseVersion = "~3.0"
+					})
+
+					It("returns an error", func() {
+						_, err := client.ReleaseForConstraint(productSlug, releaseVersion)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("release not found for version: '~3.0'"))
+					})
+				})
+
+				Context("When the constraint is invalid", func() {
+					BeforeEach(func() {
+						releaseVersion = "~>2.x."
+					})
+
+					It("returns the parse error", func() {
+						_, err := client.ReleaseForConstraint(productSlug, releaseVersion)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("invalid version constraint '~>2.x.'"))
+					})
+				})
+
+				Context("When the version is not a constraint", func() {
+					BeforeEach(func() {
+						releaseVersion = "some-missing-version"
+					})
+
+					It("returns that the release was not found", func() {
+						_, err := client.ReleaseForConstraint(productSlug, releaseVersion)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("release not found for version: 'some-missing-version'"))
+					})
+				})
+			})
+		})
+
+		Context("When the release version is latest", func() {
+			BeforeEach(func() {
+				releaseVersion = "latest"
+			})
+
+			It("does not resolve it", func() {
+				_, err := client.ReleaseForVersion(productSlug, releaseVersion)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("release not found for version: 'latest'"))
+			})
+		})
+
 		Context("When getting release returns an error", func() {
 			BeforeEach(func() {
 				releaseResponseStatusCode = http.StatusTeapot
@@ -226,4 +315,251 @@ var _ = Describe("Client", func() {
 			Expect(returnedProductFiles[1].ID).To(Equal(fileGroupProductFile.ID))
 		})
 	})
+
+	Describe("ProductFilesAddedToRelease", func() {
+		var (
+			productSlug string
+			releaseID   int
+		)
+
+		BeforeEach(func() {
+			productSlug = "product-slug"
+			releaseID = 1234
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						"GET",
+						fmt.Sprintf(
+							"%s/products/%s/releases/%d/product_files",
+							apiPrefix,
+							productSlug,
+							releaseID,
+						),
+					),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ProductFilesResponse{
+						[]pivnet.ProductFile{{ID: 5678}},
+					}),
+				),
+			)
+		})
+
+		It("returns the product files of the release without those of its file groups", func() {
+			returnedProductFiles, err := client.ProductFilesAddedToRelease(productSlug, releaseID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(returnedProductFiles)).To(Equal(1))
+			Expect(returnedProductFiles[0].ID).To(Equal(5678))
+
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("ResumeProductFileDownload", func() {
+		const (
+			fileContents = "file-contents"
+		)
+
+		var (
+			productSlug   string
+			releaseID     int
+			productFileID int
+
+			startingByte int64
+			rangeHeader  string
+
+			file *os.File
+		)
+
+		BeforeEach(func() {
+			productSlug = "product-slug"
+			releaseID = 1234
+			productFileID = 5678
+
+			startingByte = 5
+			rangeHeader = "bytes=5-12"
+
+			var err error
+			file, err = ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = file.WriteString(fileContents[:startingByte])
+			Expect(err).NotTo(HaveOccurred())
+
+			err = file.Close()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := os.Remove(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			downloadPath := fmt.Sprintf(
+				"%s/products/%s/releases/%d/product_files/%d/download",
+				apiPrefix,
+				productSlug,
+				releaseID,
+				productFileID,
+			)
+
+			productFile := pivnet.ProductFile{
+				ID: productFileID,
+				Links: &pivnet.Links{
+					Download: map[string]string{"href": server.URL() + downloadPath},
+				},
+			}
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						"GET",
+						fmt.Sprintf(
+							"%s/products/%s/releases/%d/product_files/%d",
+							apiPrefix,
+							productSlug,
+							releaseID,
+							productFileID,
+						),
+					),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ProductFileResponse{ProductFile: productFile}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", downloadPath),
+					ghttp.RespondWith(http.StatusFound, nil, http.Header{"Location": []string{server.URL() + "/some-file"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("HEAD", "/some-file"),
+					ghttp.RespondWith(http.StatusOK, nil, http.Header{"Content-Length": []string{"13"}}),
+				),
+			)
+
+			if rangeHeader != "" {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/some-file"),
+						ghttp.VerifyHeader(http.Header{"Range": []string{rangeHeader}}),
+						ghttp.RespondWith(http.StatusPartialContent, fileContents[startingByte:]),
+					),
+				)
+			}
+		})
+
+		It("downloads the remainder of the file", func() {
+			fileInfo := &download.FileInfo{Name: file.Name(), Mode: 0644}
+
+			err := client.ResumeProductFileDownload(fileInfo, productSlug, releaseID, productFileID, startingByte, GinkgoWriter, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(fileContents))
+		})
+
+		Context("when a digest writer is provided", func() {
+			It("writes the downloaded bytes to it", func() {
+				fileInfo := &download.FileInfo{Name: file.Name(), Mode: 0644}
+				digestWriter := &bytes.Buffer{}
+
+				err := client.ResumeProductFileDownload(fileInfo, productSlug, releaseID, productFileID, startingByte, GinkgoWriter, digestWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(digestWriter.String()).To(Equal(fileContents[startingByte:]))
+			})
+		})
+
+		Context("when the progress writer is a progress reporter", func() {
+			It("reports the progress to it instead of drawing a bar", func() {
+				fileInfo := &download.FileInfo{Name: file.Name(), Mode: 0644}
+				reporter := &progressReporter{}
+
+				err := client.ResumeProductFileDownload(fileInfo, productSlug, releaseID, productFileID, startingByte, reporter, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reporter.started).To(Equal([]int64{startingByte, 13}))
+				Expect(reporter.done).To(Equal(int64(13)))
+				Expect(reporter.written.String()).To(BeEmpty())
+			})
+		})
+
+		Context("when the download rate is limited", func() {
+			BeforeEach(func() {
+				client = client.WithDownloadRateLimit(4)
+			})
+
+			It("downloads no faster than the limit", func() {
+				fileInfo := &download.FileInfo{Name: file.Name(), Mode: 0644}
+
+				// The first second's worth is allowed straight away, and the rest
+				// of the 8 remaining bytes a second later.
+				start := time.Now()
+				err := client.ResumeProductFileDownload(fileInfo, productSlug, releaseID, productFileID, startingByte, GinkgoWriter, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+
+				contents, err := ioutil.ReadFile(file.Name())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal(fileContents))
+			})
+		})
+
+		Context("when the file is already complete", func() {
+			BeforeEach(func() {
+				startingByte = int64(len(fileContents))
+				rangeHeader = ""
+
+				err := ioutil.WriteFile(file.Name(), []byte(fileContents), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not download anything", func() {
+				fileInfo := &download.FileInfo{Name: file.Name(), Mode: 0644}
+
+				err := client.ResumeProductFileDownload(fileInfo, productSlug, releaseID, productFileID, startingByte, GinkgoWriter, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+
+		Context("when the file on disk is larger than the remote file", func() {
+			BeforeEach(func() {
+				startingByte = 20
+				rangeHeader = ""
+			})
+
+			It("returns an error", func() {
+				fileInfo := &download.FileInfo{Name: file.Name(), Mode: 0644}
+
+				err := client.ResumeProductFileDownload(fileInfo, productSlug, releaseID, productFileID, startingByte, GinkgoWriter, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("partial file is larger than the remote file"))
+			})
+		})
+	})
 })
+
+type progressReporter struct {
+	mutex   sync.Mutex
+	written bytes.Buffer
+	started []int64
+	done    int64
+}
+
+func (r *progressReporter) Write(p []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.written.Write(p)
+}
+
+func (r *progressReporter) StartProgress(done int64, total int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.started = []int64{done, total}
+}
+
+func (r *progressReporter) Progress(done int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.done = done
+}
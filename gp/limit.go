@@ -0,0 +1,44 @@
+package gp
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/ratelimit"
+)
+
+// limitTransport reads response bodies no faster than limiter allows. Every
+// response shares the one limiter, however many are being read at a time.
+type limitTransport struct {
+	limiter *ratelimit.Limiter
+	next    http.RoundTripper
+}
+
+func newLimitTransport(limiter *ratelimit.Limiter, next http.RoundTripper) http.RoundTripper {
+	if limiter == nil {
+		return next
+	}
+
+	return &limitTransport{
+		limiter: limiter,
+		next:    next,
+	}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = &limitBody{
+		Reader: ratelimit.NewReader(req.Context(), resp.Body, t.limiter),
+		Closer: resp.Body,
+	}
+	return resp, nil
+}
+
+type limitBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,94 @@
+package gp
+
+import (
+	"io"
+	"sync/atomic"
+
+	"github.com/pivotal-cf/go-pivnet/v7/download"
+)
+
+// ProgressReporter receives the progress of a product file download as
+// numbers. A progressWriter that implements it is used in place of a
+// progress bar.
+type ProgressReporter interface {
+	// StartProgress is called once the size of the file is known. done is
+	// the number of bytes already on disk when a download is resumed.
+	StartProgress(done int64, total int64)
+
+	// Progress is called with the number of bytes downloaded so far each
+	// time more are written. It is called from several goroutines at once
+	// when a file is downloaded in ranges, and with a lower value when a
+	// range is retried.
+	Progress(done int64)
+}
+
+// progressBar is the progress bar of the go-pivnet downloader.
+type progressBar interface {
+	SetTotal(contentLength int64)
+	SetOutput(output io.Writer)
+	Add(totalWritten int) int
+	Kickoff()
+	Finish()
+	NewProxyReader(reader io.Reader) io.Reader
+}
+
+// newProgressBar returns a bar that starts at startingByte. It reports to
+// progressWriter if that is a ProgressReporter, and otherwise draws a
+// progress bar that includes the download speed.
+func newProgressBar(progressWriter io.Writer, startingByte int64) progressBar {
+	if reporter, ok := progressWriter.(ProgressReporter); ok {
+		return &reportingBar{
+			reporter: reporter,
+			done:     startingByte,
+		}
+	}
+
+	bar := download.NewBar()
+	bar.ShowSpeed = true
+	bar.Set64(startingByte)
+	return bar
+}
+
+type reportingBar struct {
+	reporter ProgressReporter
+	total    int64
+	done     int64
+}
+
+func (b *reportingBar) SetTotal(contentLength int64) {
+	b.total = contentLength
+}
+
+func (b *reportingBar) SetOutput(output io.Writer) {}
+
+func (b *reportingBar) Kickoff() {
+	b.reporter.StartProgress(atomic.LoadInt64(&b.done), b.total)
+}
+
+func (b *reportingBar) Add(totalWritten int) int {
+	done := atomic.AddInt64(&b.done, int64(totalWritten))
+	b.reporter.Progress(done)
+	return int(done)
+}
+
+func (b *reportingBar) Finish() {}
+
+func (b *reportingBar) NewProxyReader(reader io.Reader) io.Reader {
+	return &reportingReader{
+		reader: reader,
+		bar:    b,
+	}
+}
+
+type reportingReader struct {
+	reader io.Reader
+	bar    *reportingBar
+}
+
+func (r *reportingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.bar.Add(n)
+	}
+	return n, err
+}
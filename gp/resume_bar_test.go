@@ -0,0 +1,66 @@
+package gp
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type resettableBuffer struct {
+	bytes.Buffer
+	resets int
+}
+
+func (b *resettableBuffer) Reset() {
+	b.Buffer.Reset()
+	b.resets++
+}
+
+var _ = Describe("resumeBar", func() {
+	var (
+		digest *resettableBuffer
+		bar    resumeBar
+	)
+
+	BeforeEach(func() {
+		digest = &resettableBuffer{}
+		bar = newResumeBar(newProgressBar(ioutil.Discard, 0), digest)
+		bar.SetTotal(100)
+	})
+
+	It("writes the bytes that are read to the digest", func() {
+		_, err := ioutil.ReadAll(bar.NewProxyReader(strings.NewReader("some-bytes")))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(digest.String()).To(Equal("some-bytes"))
+	})
+
+	It("only resets the digest when the bar is rewound", func() {
+		bar.Add(10)
+		Expect(digest.resets).To(Equal(0))
+
+		bar.Add(-10)
+		Expect(digest.resets).To(Equal(1))
+	})
+
+	Context("when a request is read after the bar was rewound", func() {
+		It("drops its bytes", func() {
+			stale := bar.NewProxyReader(strings.NewReader("stale-bytes"))
+
+			bar.Add(-5)
+
+			retried := bar.NewProxyReader(strings.NewReader("some-bytes"))
+
+			_, err := ioutil.ReadAll(stale)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = ioutil.ReadAll(retried)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(digest.String()).To(Equal("some-bytes"))
+		})
+	})
+})
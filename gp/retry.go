@@ -0,0 +1,235 @@
+package gp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryConfig limits how requests that fail with a transient error are
+// retried. The zero value does not retry.
+type RetryConfig struct {
+	// MaxRetries is the number of times a request is retried.
+	MaxRetries int
+
+	// Timeout bounds the total time spent on a request and its retries. There
+	// is no bound if it is zero.
+	Timeout time.Duration
+
+	// MinBackoff and MaxBackoff bound the exponential backoff between
+	// retries. They default to 1s and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// retryTransport retries requests that are safe to repeat when they fail
+// with a connection error or with a 429, 502, 503 or 504. It waits for as
+// long as the Retry-After header asks, or else backs off exponentially with
+// jitter.
+type retryTransport struct {
+	next   http.RoundTripper
+	config RetryConfig
+	logger logger.Logger
+}
+
+func newRetryTransport(next http.RoundTripper, config RetryConfig, logger logger.Logger) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if config.MinBackoff == 0 {
+		config.MinBackoff = defaultMinBackoff
+	}
+	if config.MaxBackoff == 0 {
+		config.MaxBackoff = defaultMaxBackoff
+	}
+
+	return &retryTransport{
+		next:   next,
+		config: config,
+		logger: logger,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.config.MaxRetries <= 0 || !idempotent(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	var deadline time.Time
+	if t.config.Timeout > 0 {
+		deadline = time.Now().Add(t.config.Timeout)
+	}
+
+	for retry := 0; ; retry++ {
+		attempt, err := rewind(req, retry)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attempt)
+		if retry >= t.config.MaxRetries || !retryable(resp, err) {
+			return resp, err
+		}
+
+		wait, fromHeader := retryAfter(resp)
+		if !fromHeader {
+			wait = t.backoff(retry)
+		}
+
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+
+		t.logger.Info(fmt.Sprintf(
+			"Retrying %s %s in %s after %s (retry %d of %d)",
+			req.Method,
+			req.URL.Path,
+			wait.Round(time.Millisecond),
+			failureFor(resp, err),
+			retry+1,
+			t.config.MaxRetries,
+		))
+
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		err = sleep(req.Context(), wait)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff doubles from MinBackoff up to MaxBackoff, and picks a random wait
+// between half of that and all of it so that clients that failed together
+// do not retry together.
+func (t *retryTransport) backoff(retry int) time.Duration {
+	backoff := t.config.MinBackoff
+	for i := 0; i < retry && backoff < t.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > t.config.MaxBackoff {
+		backoff = t.config.MaxBackoff
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// idempotent reports whether a request can be repeated without changing the
+// outcome. Besides the methods that are idempotent by definition, this
+// includes the POST that fetches a product file download link.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut:
+		return true
+	case http.MethodPost:
+		return strings.HasSuffix(req.URL.Path, "/download")
+	}
+	return false
+}
+
+// rewind returns the request to send for the given retry, with a fresh copy
+// of the body if it has one.
+func rewind(req *http.Request, retry int) (*http.Request, error) {
+	if retry == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errors.New("cannot retry request: body cannot be rewound")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := req.Clone(req.Context())
+	attempt.Body = body
+	return attempt, nil
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+
+		_, isNetErr := err.(net.Error)
+		return isNetErr || err == io.EOF || err == io.ErrUnexpectedEOF
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait requested by the Retry-After header, which is
+// either a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func failureFor(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return resp.Status
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
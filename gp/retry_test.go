@@ -0,0 +1,172 @@
+package gp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger/loggerfakes"
+	"github.com/pivotal-cf/pivnet-cli/v3/gp"
+	"github.com/pivotal-cf/pivnet-cli/v3/gp/gpfakes"
+)
+
+var _ = Describe("Retries", func() {
+	const (
+		productSlug = "product-slug"
+	)
+
+	var (
+		server *ghttp.Server
+
+		retryConfig gp.RetryConfig
+		fakeLogger  *loggerfakes.FakeLogger
+
+		client *gp.Client
+
+		fileGroupsPath string
+		fileGroups     []pivnet.FileGroup
+	)
+
+	unavailable := func(header http.Header) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", fileGroupsPath),
+			ghttp.RespondWith(http.StatusServiceUnavailable, `{"message":"unavailable"}`, header),
+		)
+	}
+
+	ok := func() http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", fileGroupsPath),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.FileGroupsResponse{FileGroups: fileGroups}),
+		)
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeLogger = &loggerfakes.FakeLogger{}
+
+		retryConfig = gp.RetryConfig{
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: 10 * time.Millisecond,
+		}
+
+		fileGroupsPath = fmt.Sprintf("%s/products/%s/file_groups", apiPrefix, productSlug)
+		fileGroups = []pivnet.FileGroup{{ID: 1234, Name: "some-file-group"}}
+	})
+
+	JustBeforeEach(func() {
+		config := pivnet.ClientConfig{
+			Host:      server.URL(),
+			UserAgent: "some-user-agent",
+		}
+		client = gp.NewClient(context.Background(), &gpfakes.FakeAccessTokenService{}, config, retryConfig, fakeLogger)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("retries reads that fail with a transient error", func() {
+		server.AppendHandlers(
+			unavailable(nil),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", fileGroupsPath),
+				ghttp.RespondWith(http.StatusTooManyRequests, "Retry later"),
+			),
+			ok(),
+		)
+
+		returned, err := client.FileGroups(productSlug)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(returned).To(Equal(fileGroups))
+
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+		Expect(fakeLogger.InfoCallCount()).To(Equal(2))
+	})
+
+	It("gives up after the maximum number of retries", func() {
+		server.AppendHandlers(unavailable(nil), unavailable(nil), unavailable(nil))
+
+		_, err := client.FileGroups(productSlug)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("503"))
+
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("does not retry errors that are not transient", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", fileGroupsPath),
+				ghttp.RespondWith(http.StatusNotFound, `{"message":"not found"}`),
+			),
+		)
+
+		_, err := client.FileGroups(productSlug)
+		Expect(err).To(HaveOccurred())
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("does not retry requests that change data", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", fileGroupsPath),
+				ghttp.RespondWith(http.StatusServiceUnavailable, `{"message":"unavailable"}`),
+			),
+		)
+
+		_, err := client.CreateFileGroup(productSlug, "some-file-group")
+		Expect(err).To(HaveOccurred())
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("waits as long as the Retry-After header asks", func() {
+		server.AppendHandlers(unavailable(http.Header{"Retry-After": []string{"1"}}), ok())
+
+		start := time.Now()
+		_, err := client.FileGroups(productSlug)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	Context("when the retry timeout would be exceeded", func() {
+		BeforeEach(func() {
+			retryConfig.Timeout = 100 * time.Millisecond
+		})
+
+		It("does not retry", func() {
+			server.AppendHandlers(unavailable(http.Header{"Retry-After": []string{"60"}}))
+
+			_, err := client.FileGroups(productSlug)
+			Expect(err).To(HaveOccurred())
+
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when retries are disabled", func() {
+		BeforeEach(func() {
+			retryConfig.MaxRetries = 0
+		})
+
+		It("does not retry", func() {
+			server.AppendHandlers(unavailable(nil))
+
+			_, err := client.FileGroups(productSlug)
+			Expect(err).To(HaveOccurred())
+
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/pivotal-cf/pivnet-cli/v3/rc"
 	"github.com/pivotal-cf/pivnet-cli/v3/rc/filesystem"
 	"os"
+	"syscall"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pivotal-cf/pivnet-cli/v3/commands"
@@ -32,8 +34,22 @@ func main() {
 		return
 	}
 
+	ctx, stop := commands.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	commands.Context = ctx
+	defer commands.StopTimeout()
+
 	_, err := parser.Parse()
 	if err != nil {
+		switch commands.Context.Err() {
+		case context.DeadlineExceeded:
+			fmt.Fprintln(os.Stderr, errorhandler.RedFunc(fmt.Sprintf("Timed out after %s", commands.Pivnet.Timeout)))
+			os.Exit(commands.ExitCodeTimedOut)
+		case context.Canceled:
+			fmt.Fprintln(os.Stderr, errorhandler.RedFunc("Interrupted"))
+			os.Exit(commands.ExitCodeInterrupted)
+		}
+
 		if err == commands.ErrShowHelpMessage {
 			helpParser := flags.NewParser(&commands.Pivnet, flags.HelpFlag)
 			helpParser.NamespaceDelimiter = "-"
@@ -308,6 +308,71 @@ var _ = Describe("pivnet cli", func() {
 		})
 	}
 
+	Describe("stopping a command", func() {
+		var (
+			unblock chan struct{}
+		)
+
+		BeforeEach(func() {
+			unblock = make(chan struct{})
+
+			for i := 0; i < 2; i++ {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(
+							"GET",
+							fmt.Sprintf("%s/authentication", apiPrefix),
+						),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			}
+
+			login(legacyApiToken)
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						"GET",
+						fmt.Sprintf("%s/products/%s", apiPrefix, product.Slug),
+					),
+					func(w http.ResponseWriter, r *http.Request) {
+						<-unblock
+					},
+				),
+			)
+		})
+
+		AfterEach(func() {
+			close(unblock)
+			server.Close()
+		})
+
+		It("exits with 124 when it runs past --timeout", func() {
+			session := runMainWithArgs(
+				"--timeout=500ms",
+				"product",
+				"--product-slug", product.Slug,
+			)
+
+			Eventually(session, executableTimeout).Should(gexec.Exit(124))
+			Expect(session.Err).Should(gbytes.Say("Timed out after 500ms"))
+		})
+
+		It("exits with 130 when it is interrupted", func() {
+			session := runMainWithArgs(
+				"product",
+				"--product-slug", product.Slug,
+			)
+
+			Eventually(server.ReceivedRequests, executableTimeout).Should(HaveLen(3))
+			session.Interrupt()
+
+			Eventually(session, executableTimeout).Should(gexec.Exit(130))
+			Expect(session.Err).Should(gbytes.Say("Interrupted"))
+		})
+	})
+
 	Context("when using a legacy token", func() {
 		sharedAssertions(legacyApiToken)
 		BeforeEach(func() {
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket that shares a number of bytes per second between
+// every reader that uses it.
+type Limiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a limiter that allows bytesPerSecond bytes per second,
+// in bursts of up to one second's worth.
+func NewLimiter(bytesPerSecond int64) *Limiter {
+	burst := bytesPerSecond
+	if burst > int64(maxInt) {
+		burst = int64(maxInt)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Limiter{
+		rate:   float64(bytesPerSecond),
+		burst:  int(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+// WaitN blocks until n bytes are allowed, or until ctx is done. Callers are
+// served in the order they call WaitN, so concurrent readers get an even
+// share of the rate.
+func (l *Limiter) WaitN(ctx context.Context, n int) error {
+	l.mutex.Lock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > float64(l.burst) {
+		l.tokens = float64(l.burst)
+	}
+	l.last = now
+
+	l.tokens -= float64(n)
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+
+	l.mutex.Unlock()
+
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type reader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *Limiter
+}
+
+// NewReader returns a reader that reads from r no faster than limiter
+// allows. It stops with the error of ctx when ctx is done.
+func NewReader(ctx context.Context, r io.Reader, limiter *Limiter) io.Reader {
+	return &reader{
+		ctx:     ctx,
+		r:       r,
+		limiter: limiter,
+	}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(p) > r.limiter.burst {
+		p = p[:r.limiter.burst]
+	}
+
+	n, err := r.r.Read(p)
+	if n > 0 {
+		waitErr := r.limiter.WaitN(r.ctx, n)
+		if waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
+
+// ParseRate parses a number of bytes per second, with an optional K, M or G
+// suffix for multiples of 1024, e.g. 500K or 50M.
+func ParseRate(rate string) (int64, error) {
+	value := strings.TrimSpace(rate)
+
+	multiplier := float64(1)
+	if value != "" {
+		switch strings.ToUpper(value[len(value)-1:]) {
+		case "K":
+			multiplier = 1 << 10
+		case "M":
+			multiplier = 1 << 20
+		case "G":
+			multiplier = 1 << 30
+		}
+		if multiplier != 1 {
+			value = value[:len(value)-1]
+		}
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || !(number > 0) || number*multiplier > math.MaxInt64/2 {
+		return 0, fmt.Errorf("invalid rate '%s': must be a positive number of bytes per second, optionally followed by K, M or G e.g. 50M", rate)
+	}
+
+	bytesPerSecond := int64(number * multiplier)
+	if bytesPerSecond < 1 {
+		bytesPerSecond = 1
+	}
+
+	return bytesPerSecond, nil
+}
@@ -0,0 +1,102 @@
+package ratelimit_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/pivnet-cli/v3/ratelimit"
+)
+
+var _ = Describe("ratelimit", func() {
+	Describe("ParseRate", func() {
+		expectRate := func(rate string, expected int64) {
+			bytesPerSecond, err := ratelimit.ParseRate(rate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bytesPerSecond).To(Equal(expected))
+		}
+
+		It("parses a number of bytes", func() {
+			expectRate("2048", 2048)
+		})
+
+		It("parses K, M and G suffixes as multiples of 1024", func() {
+			expectRate("500K", 500*1024)
+			expectRate("50M", 50*1024*1024)
+			expectRate("2g", 2*1024*1024*1024)
+		})
+
+		It("parses fractions", func() {
+			expectRate("1.5M", 1536*1024)
+		})
+
+		It("returns an error for rates that are not positive numbers", func() {
+			for _, rate := range []string{"", "M", "fast", "-1M", "0", "NaN", "Inf", "50MB"} {
+				_, err := ratelimit.ParseRate(rate)
+				Expect(err).To(HaveOccurred(), rate)
+				Expect(err.Error()).To(ContainSubstring("invalid rate '%s'", rate))
+			}
+		})
+	})
+
+	Describe("NewReader", func() {
+		const (
+			bytesPerSecond = 64 * 1024
+		)
+
+		var (
+			limiter *ratelimit.Limiter
+		)
+
+		BeforeEach(func() {
+			limiter = ratelimit.NewLimiter(bytesPerSecond)
+		})
+
+		It("reads everything from the underlying reader", func() {
+			contents := bytes.Repeat([]byte("a"), 1000)
+
+			read, err := ioutil.ReadAll(ratelimit.NewReader(context.Background(), bytes.NewReader(contents), limiter))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read).To(Equal(contents))
+		})
+
+		It("shares the rate between concurrent readers", func() {
+			// The first second's worth is allowed straight away, so reading two
+			// seconds' worth in total takes about a second.
+			var wg sync.WaitGroup
+			start := time.Now()
+
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					r := ratelimit.NewReader(context.Background(), bytes.NewReader(make([]byte, bytesPerSecond/2)), limiter)
+					_, err := io.Copy(ioutil.Discard, r)
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+
+			wg.Wait()
+			Expect(time.Since(start)).To(BeNumerically("~", time.Second, 300*time.Millisecond))
+		})
+
+		It("stops waiting when the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			r := ratelimit.NewReader(ctx, bytes.NewReader(make([]byte, 10*bytesPerSecond)), limiter)
+			time.AfterFunc(100*time.Millisecond, cancel)
+
+			start := time.Now()
+			_, err := io.Copy(ioutil.Discard, r)
+			Expect(err).To(Equal(context.Canceled))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+	})
+})
@@ -10,6 +10,7 @@ type PivnetProfile struct {
 	Host              string `yaml:"host"`
 	AccessToken       string `yaml:"access_token"`
 	AccessTokenExpiry int64  `yaml:"access_token_expiry"`
+	CacheDir          string `yaml:"cache_dir,omitempty"`
 }
 
 func (p *PivnetProfile) Validate() error {
@@ -127,6 +127,30 @@ profiles:
 			Expect(string(invokedContents)).To(Equal(string(expectedBytes)))
 		})
 
+		Context("when the profile has a cache directory", func() {
+			BeforeEach(func() {
+				configContents = append(configContents, []byte("  cache_dir: /some/cache/dir\n")...)
+			})
+
+			It("keeps the cache directory", func() {
+				err := rcHandler.SaveProfile(
+					profile.Name,
+					profile.APIToken,
+					profile.Host,
+					"some-other-access-token",
+					profile.AccessTokenExpiry,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var pivnetRC rc.PivnetRC
+				err = yaml.Unmarshal(fakePivnetRCReadWriter.WriteToFileArgsForCall(0), &pivnetRC)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(pivnetRC.Profiles[0].CacheDir).To(Equal("/some/cache/dir"))
+				Expect(pivnetRC.Profiles[0].AccessToken).To(Equal("some-other-access-token"))
+			})
+		})
+
 		Context("when profile does not yet exist", func() {
 			var (
 				newName              string
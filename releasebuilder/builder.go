@@ -0,0 +1,133 @@
+package releasebuilder
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logger"
+)
+
+type ReleaseUpdater interface {
+	UpdateRelease(productSlug string, release pivnet.Release) (pivnet.Release, error)
+}
+
+//go:generate counterfeiter . PivnetClient
+type PivnetClient interface {
+	ReleaseUpdater
+	DeleteRelease(productSlug string, release pivnet.Release) error
+}
+
+// Step is a single change to a release that is being built.
+type Step struct {
+	Description string
+	Apply       func(release *pivnet.Release) error
+}
+
+type Builder struct {
+	pivnetClient PivnetClient
+	l            logger.Logger
+}
+
+func NewBuilder(pivnetClient PivnetClient, l logger.Logger) Builder {
+	return Builder{
+		pivnetClient: pivnetClient,
+		l:            l,
+	}
+}
+
+// Build applies steps to release, which has just been created, and then sets
+// its availability. The availability is set last so that the release is not
+// visible to users before it is complete. If a step fails the release is
+// deleted, so that no partial release is left behind.
+func (b Builder) Build(
+	productSlug string,
+	release *pivnet.Release,
+	availability string,
+	steps []Step,
+) error {
+	if availability != "" && availability != release.Availability {
+		steps = append(steps, SetAvailability(b.pivnetClient, productSlug, availability))
+	}
+
+	for _, step := range steps {
+		err := step.Apply(release)
+		if err != nil {
+			return b.rollback(productSlug, *release, step.Description, err)
+		}
+
+		b.l.Info(fmt.Sprintf("Release %s: %s", release.Version, step.Description))
+	}
+
+	return nil
+}
+
+// SetAvailability returns the step that sets the availability of a release.
+func SetAvailability(pivnetClient ReleaseUpdater, productSlug string, availability string) Step {
+	return Step{
+		Description: fmt.Sprintf("set availability to '%s'", availability),
+		Apply: func(release *pivnet.Release) error {
+			release.Availability = availability
+			updated, err := pivnetClient.UpdateRelease(productSlug, *release)
+			if err != nil {
+				return err
+			}
+			*release = updated
+			return nil
+		},
+	}
+}
+
+// rollback deletes release after step failed with stepErr, and returns the
+// error to report.
+func (b Builder) rollback(
+	productSlug string,
+	release pivnet.Release,
+	step string,
+	stepErr error,
+) error {
+	b.l.Info(fmt.Sprintf("Failed to %s, deleting release %s", step, release.Version))
+
+	err := b.pivnetClient.DeleteRelease(productSlug, release)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to %s: %s (deleting the partial release %s also failed: %s, delete it with delete-release)",
+			step,
+			stepErr,
+			release.Version,
+			err,
+		)
+	}
+
+	return fmt.Errorf(
+		"failed to %s: %s (the partial release %s was deleted)",
+		step,
+		stepErr,
+		release.Version,
+	)
+}
+
+// PrintReleaseTable prints the release that was built as a table.
+func PrintReleaseTable(outputWriter io.Writer, release pivnet.Release) {
+	table := tablewriter.NewWriter(outputWriter)
+	table.SetHeader([]string{
+		"ID",
+		"Version",
+		"Description",
+		"Updated At",
+		"Availability",
+		"Release Type",
+	})
+
+	table.Append([]string{
+		strconv.Itoa(release.ID),
+		release.Version,
+		release.Description,
+		release.UpdatedAt,
+		release.Availability,
+		string(release.ReleaseType),
+	})
+	table.Render()
+}
@@ -0,0 +1,140 @@
+package releasebuilder_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/go-pivnet/v7/logshim"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder/releasebuilderfakes"
+)
+
+var _ = Describe("Builder", func() {
+	var (
+		fakePivnetClient *releasebuilderfakes.FakePivnetClient
+
+		release      pivnet.Release
+		availability string
+		applied      []string
+		steps        []releasebuilder.Step
+
+		builder releasebuilder.Builder
+	)
+
+	step := func(description string, err error) releasebuilder.Step {
+		return releasebuilder.Step{
+			Description: description,
+			Apply: func(r *pivnet.Release) error {
+				applied = append(applied, description)
+				return err
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakePivnetClient = &releasebuilderfakes.FakePivnetClient{}
+
+		fakePivnetClient.UpdateReleaseStub = func(productSlug string, r pivnet.Release) (pivnet.Release, error) {
+			applied = append(applied, "update release")
+			return r, nil
+		}
+
+		release = pivnet.Release{ID: 99, Version: "2.10.4", Availability: "Admins Only"}
+		availability = "All Users"
+		applied = nil
+		steps = []releasebuilder.Step{
+			step("add product file 1", nil),
+			step("add file group 2", nil),
+		}
+
+		l := logshim.NewLogShim(log.New(GinkgoWriter, "", 0), log.New(GinkgoWriter, "", 0), true)
+		builder = releasebuilder.NewBuilder(fakePivnetClient, l)
+	})
+
+	It("applies the steps and then sets the availability", func() {
+		err := builder.Build("some-product", &release, availability, steps)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(applied).To(Equal([]string{
+			"add product file 1",
+			"add file group 2",
+			"update release",
+		}))
+
+		productSlug, updated := fakePivnetClient.UpdateReleaseArgsForCall(0)
+		Expect(productSlug).To(Equal("some-product"))
+		Expect(updated.ID).To(Equal(99))
+		Expect(updated.Availability).To(Equal("All Users"))
+
+		Expect(release.Availability).To(Equal("All Users"))
+		Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(0))
+	})
+
+	Context("when the release already has the availability", func() {
+		BeforeEach(func() {
+			availability = "Admins Only"
+		})
+
+		It("does not update the release", func() {
+			err := builder.Build("some-product", &release, availability, steps)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePivnetClient.UpdateReleaseCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a step fails", func() {
+		BeforeEach(func() {
+			steps = []releasebuilder.Step{
+				step("add product file 1", errors.New("product file error")),
+				step("add file group 2", nil),
+			}
+		})
+
+		It("deletes the release without applying the other steps", func() {
+			err := builder.Build("some-product", &release, availability, steps)
+			Expect(err).To(MatchError(
+				"failed to add product file 1: product file error (the partial release 2.10.4 was deleted)"))
+
+			Expect(applied).To(Equal([]string{"add product file 1"}))
+
+			Expect(fakePivnetClient.DeleteReleaseCallCount()).To(Equal(1))
+			productSlug, deleted := fakePivnetClient.DeleteReleaseArgsForCall(0)
+			Expect(productSlug).To(Equal("some-product"))
+			Expect(deleted.ID).To(Equal(99))
+		})
+
+		Context("when deleting the release fails", func() {
+			BeforeEach(func() {
+				fakePivnetClient.DeleteReleaseReturns(errors.New("delete error"))
+			})
+
+			It("reports that the partial release is left behind", func() {
+				err := builder.Build("some-product", &release, availability, steps)
+				Expect(err).To(MatchError(
+					"failed to add product file 1: product file error (deleting the partial release 2.10.4 also failed: delete error, delete it with delete-release)"))
+			})
+		})
+	})
+})
+
+var _ = Describe("PrintReleaseTable", func() {
+	It("prints the release", func() {
+		var outBuffer bytes.Buffer
+
+		releasebuilder.PrintReleaseTable(&outBuffer, pivnet.Release{
+			ID:           99,
+			Version:      "2.10.4",
+			Availability: "All Users",
+			ReleaseType:  "Minor Release",
+		})
+
+		Expect(outBuffer.String()).To(ContainSubstring("2.10.4"))
+		Expect(outBuffer.String()).To(ContainSubstring("All Users"))
+		Expect(outBuffer.String()).To(ContainSubstring("Minor Release"))
+	})
+})
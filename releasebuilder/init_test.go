@@ -0,0 +1,13 @@
+package releasebuilder_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReleaseBuilder(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Release Builder Suite")
+}
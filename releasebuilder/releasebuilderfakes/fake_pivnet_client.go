@@ -0,0 +1,195 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package releasebuilderfakes
+
+import (
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v7"
+	"github.com/pivotal-cf/pivnet-cli/v3/releasebuilder"
+)
+
+type FakePivnetClient struct {
+	DeleteReleaseStub        func(string, pivnet.Release) error
+	deleteReleaseMutex       sync.RWMutex
+	deleteReleaseArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.Release
+	}
+	deleteReleaseReturns struct {
+		result1 error
+	}
+	deleteReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateReleaseStub        func(string, pivnet.Release) (pivnet.Release, error)
+	updateReleaseMutex       sync.RWMutex
+	updateReleaseArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.Release
+	}
+	updateReleaseReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	updateReleaseReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePivnetClient) DeleteRelease(arg1 string, arg2 pivnet.Release) error {
+	fake.deleteReleaseMutex.Lock()
+	ret, specificReturn := fake.deleteReleaseReturnsOnCall[len(fake.deleteReleaseArgsForCall)]
+	fake.deleteReleaseArgsForCall = append(fake.deleteReleaseArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.Release
+	}{arg1, arg2})
+	stub := fake.DeleteReleaseStub
+	fakeReturns := fake.deleteReleaseReturns
+	fake.recordInvocation("DeleteRelease", []interface{}{arg1, arg2})
+	fake.deleteReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePivnetClient) DeleteReleaseCallCount() int {
+	fake.deleteReleaseMutex.RLock()
+	defer fake.deleteReleaseMutex.RUnlock()
+	return len(fake.deleteReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) DeleteReleaseCalls(stub func(string, pivnet.Release) error) {
+	fake.deleteReleaseMutex.Lock()
+	defer fake.deleteReleaseMutex.Unlock()
+	fake.DeleteReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) DeleteReleaseArgsForCall(i int) (string, pivnet.Release) {
+	fake.deleteReleaseMutex.RLock()
+	defer fake.deleteReleaseMutex.RUnlock()
+	argsForCall := fake.deleteReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) DeleteReleaseReturns(result1 error) {
+	fake.deleteReleaseMutex.Lock()
+	defer fake.deleteReleaseMutex.Unlock()
+	fake.DeleteReleaseStub = nil
+	fake.deleteReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) DeleteReleaseReturnsOnCall(i int, result1 error) {
+	fake.deleteReleaseMutex.Lock()
+	defer fake.deleteReleaseMutex.Unlock()
+	fake.DeleteReleaseStub = nil
+	if fake.deleteReleaseReturnsOnCall == nil {
+		fake.deleteReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePivnetClient) UpdateRelease(arg1 string, arg2 pivnet.Release) (pivnet.Release, error) {
+	fake.updateReleaseMutex.Lock()
+	ret, specificReturn := fake.updateReleaseReturnsOnCall[len(fake.updateReleaseArgsForCall)]
+	fake.updateReleaseArgsForCall = append(fake.updateReleaseArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.Release
+	}{arg1, arg2})
+	stub := fake.UpdateReleaseStub
+	fakeReturns := fake.updateReleaseReturns
+	fake.recordInvocation("UpdateRelease", []interface{}{arg1, arg2})
+	fake.updateReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetClient) UpdateReleaseCallCount() int {
+	fake.updateReleaseMutex.RLock()
+	defer fake.updateReleaseMutex.RUnlock()
+	return len(fake.updateReleaseArgsForCall)
+}
+
+func (fake *FakePivnetClient) UpdateReleaseCalls(stub func(string, pivnet.Release) (pivnet.Release, error)) {
+	fake.updateReleaseMutex.Lock()
+	defer fake.updateReleaseMutex.Unlock()
+	fake.UpdateReleaseStub = stub
+}
+
+func (fake *FakePivnetClient) UpdateReleaseArgsForCall(i int) (string, pivnet.Release) {
+	fake.updateReleaseMutex.RLock()
+	defer fake.updateReleaseMutex.RUnlock()
+	argsForCall := fake.updateReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePivnetClient) UpdateReleaseReturns(result1 pivnet.Release, result2 error) {
+	fake.updateReleaseMutex.Lock()
+	defer fake.updateReleaseMutex.Unlock()
+	fake.UpdateReleaseStub = nil
+	fake.updateReleaseReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) UpdateReleaseReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.updateReleaseMutex.Lock()
+	defer fake.updateReleaseMutex.Unlock()
+	fake.UpdateReleaseStub = nil
+	if fake.updateReleaseReturnsOnCall == nil {
+		fake.updateReleaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.updateReleaseReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteReleaseMutex.RLock()
+	defer fake.deleteReleaseMutex.RUnlock()
+	fake.updateReleaseMutex.RLock()
+	defer fake.updateReleaseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePivnetClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ releasebuilder.PivnetClient = new(FakePivnetClient)
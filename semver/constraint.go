@@ -0,0 +1,298 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Latest is the constraint that matches every version. Used to select the
+// highest release.
+const Latest = "latest"
+
+var (
+	spaceAfterOperatorRegexp = regexp.MustCompile(`(^|\s)(~>|>=|<=|!=|>|<|=|~|\^)\s+`)
+	operatorRegexp           = regexp.MustCompile(`^(~>|>=|<=|!=|>|<|=|~|\^)?(.*)$`)
+)
+
+// Constraint restricts versions to one or more ranges. Comparators separated
+// by spaces or commas must all match, alternatives are separated by "||".
+//
+// Supported comparators are =, !=, >, >=, <, <=, ~ (or ~>) and ^ followed by
+// a version, versions with x or * wildcards such as 2.10.x, and "latest".
+// Partial versions match every version they are a prefix of, so "2.10" and
+// "~2.10" both match 2.10.0 up to but excluding 2.11.0.
+//
+// Prereleases only match when the range names a prerelease of the same
+// major.minor.patch, so "latest" and "~2.10" skip 2.10.6-rc.1 while
+// ">=2.10.6-rc.1" matches it. Upper bounds also exclude the prereleases of
+// the bound itself, so neither "<1.8.0" nor "~1.7" match 1.8.0-rc.1.
+type Constraint struct {
+	original string
+	sets     []comparatorSet
+}
+
+type comparator func(Version) bool
+
+// comparatorSet is a range whose comparators must all match. prereleases
+// holds the prerelease versions named by the range.
+type comparatorSet struct {
+	comparators []comparator
+	prereleases []Version
+}
+
+func ParseConstraint(s string) (Constraint, error) {
+	c := Constraint{original: s}
+
+	for _, set := range strings.Split(s, "||") {
+		set = strings.Replace(set, ",", " ", -1)
+		set = spaceAfterOperatorRegexp.ReplaceAllString(set, "$1$2")
+
+		tokens := strings.Fields(set)
+		if len(tokens) == 0 {
+			return Constraint{}, fmt.Errorf("invalid version constraint '%s': empty range", s)
+		}
+
+		var cs comparatorSet
+		for _, token := range tokens {
+			cmp, named, err := parseComparator(token)
+			if err != nil {
+				return Constraint{}, fmt.Errorf("invalid version constraint '%s': %s", s, err.Error())
+			}
+			cs.comparators = append(cs.comparators, cmp)
+
+			if len(named.Prerelease) > 0 {
+				cs.prereleases = append(cs.prereleases, named)
+			}
+		}
+
+		c.sets = append(c.sets, cs)
+	}
+
+	return c, nil
+}
+
+// Check reports whether v satisfies the constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, set := range c.sets {
+		if set.matches(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c Constraint) String() string {
+	return c.original
+}
+
+func (s comparatorSet) matches(v Version) bool {
+	if len(v.Prerelease) > 0 && !s.namesPrereleaseOf(v) {
+		return false
+	}
+
+	for _, cmp := range s.comparators {
+		if !cmp(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s comparatorSet) namesPrereleaseOf(v Version) bool {
+	release := Version{Segments: v.Segments}
+
+	for _, named := range s.prereleases {
+		if release.Compare(Version{Segments: named.Segments}) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// partial is a version that may be missing trailing segments, e.g. 2.10 or
+// 2.10.x.
+type partial struct {
+	version   Version
+	specified int
+	wildcard  bool
+}
+
+func (p partial) complete() bool {
+	return !p.wildcard && p.specified >= 3
+}
+
+// bump returns the lowest version above every version that shares the first
+// n segments of p, excluding prereleases.
+func (p partial) bump(n int) Version {
+	segments := make([]int, n)
+	copy(segments, p.version.Segments[:n])
+	segments[n-1]++
+
+	return Version{Segments: segments, Prerelease: []string{"0"}}
+}
+
+func parsePartial(s string) (partial, error) {
+	rest := strings.TrimPrefix(s, "v")
+	if i := strings.Index(rest, "+"); i >= 0 {
+		rest = rest[:i]
+	}
+
+	var p partial
+
+	if i := strings.Index(rest, "-"); i >= 0 {
+		prerelease, err := parseIdentifiers(rest[i+1:])
+		if err != nil {
+			return partial{}, fmt.Errorf("invalid prerelease in '%s': %s", s, err.Error())
+		}
+		p.version.Prerelease = prerelease
+		rest = rest[:i]
+	}
+
+	for _, segment := range strings.Split(rest, ".") {
+		if segment == "x" || segment == "X" || segment == "*" {
+			p.wildcard = true
+			continue
+		}
+
+		if p.wildcard {
+			return partial{}, fmt.Errorf("'%s' has a number after a wildcard", s)
+		}
+
+		n, err := parseNumber(segment)
+		if err != nil {
+			return partial{}, fmt.Errorf("invalid version '%s': %s", s, err.Error())
+		}
+
+		p.version.Segments = append(p.version.Segments, n)
+		p.specified++
+	}
+
+	if len(p.version.Prerelease) > 0 && !p.complete() {
+		return partial{}, fmt.Errorf("'%s' has a prerelease but is not a complete version", s)
+	}
+
+	return p, nil
+}
+
+// parseComparator parses a single comparator and also returns the version it
+// names, so that ranges naming a prerelease can match prereleases.
+func parseComparator(token string) (comparator, Version, error) {
+	if token == Latest {
+		return anyVersion, Version{}, nil
+	}
+
+	matches := operatorRegexp.FindStringSubmatch(token)
+	op, versionString := matches[1], matches[2]
+
+	p, err := parsePartial(versionString)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	cmp, err := comparatorFor(op, p)
+	return cmp, p.version, err
+}
+
+func comparatorFor(op string, p partial) (comparator, error) {
+
+	if p.specified == 0 {
+		switch op {
+		case "", "=", ">=", "<=", "~", "~>", "^":
+			return anyVersion, nil
+		default:
+			return nil, fmt.Errorf("'%s' cannot be used with a wildcard", op)
+		}
+	}
+
+	lower := p.version
+
+	switch op {
+	case "", "=":
+		if p.complete() {
+			return equal(lower), nil
+		}
+		return between(lower, p.bump(p.specified)), nil
+	case "!=":
+		if p.complete() {
+			return not(equal(lower)), nil
+		}
+		return not(between(lower, p.bump(p.specified))), nil
+	case ">":
+		if p.complete() {
+			return greaterThan(lower), nil
+		}
+		return atLeast(p.bump(p.specified)), nil
+	case ">=":
+		return atLeast(lower), nil
+	case "<":
+		if len(lower.Prerelease) == 0 {
+			lower.Prerelease = []string{"0"}
+		}
+		return lessThan(lower), nil
+	case "<=":
+		if p.complete() {
+			return not(greaterThan(lower)), nil
+		}
+		return lessThan(p.bump(p.specified)), nil
+	case "~", "~>":
+		if p.specified == 1 {
+			return between(lower, p.bump(1)), nil
+		}
+		return between(lower, p.bump(2)), nil
+	case "^":
+		n := p.specified
+		for i, s := range lower.Segments {
+			if s != 0 {
+				n = i + 1
+				break
+			}
+		}
+		return between(lower, p.bump(n)), nil
+	}
+
+	return nil, fmt.Errorf("unknown operator '%s'", op)
+}
+
+func anyVersion(Version) bool {
+	return true
+}
+
+func equal(o Version) comparator {
+	return func(v Version) bool {
+		return v.Compare(o) == 0
+	}
+}
+
+func greaterThan(o Version) comparator {
+	return func(v Version) bool {
+		return v.Compare(o) > 0
+	}
+}
+
+func atLeast(o Version) comparator {
+	return func(v Version) bool {
+		return v.Compare(o) >= 0
+	}
+}
+
+func lessThan(o Version) comparator {
+	return func(v Version) bool {
+		return v.Compare(o) < 0
+	}
+}
+
+func between(lower, upper Version) comparator {
+	return func(v Version) bool {
+		return v.Compare(lower) >= 0 && v.Compare(upper) < 0
+	}
+}
+
+func not(c comparator) comparator {
+	return func(v Version) bool {
+		return !c(v)
+	}
+}
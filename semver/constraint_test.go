@@ -0,0 +1,73 @@
+package semver_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/semver"
+)
+
+var _ = Describe("Constraint", func() {
+	DescribeTable("Check", func(constraint string, version string, expected bool) {
+		c, err := semver.ParseConstraint(constraint)
+		Expect(err).NotTo(HaveOccurred())
+
+		v, err := semver.ParseLenient(version)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Check(v)).To(Equal(expected))
+	},
+		Entry("exact version", "1.2.3", "1.2.3", true),
+		Entry("exact version with a different patch", "1.2.3", "1.2.4", false),
+		Entry("exact version with =", "=1.2.3", "1.2.3", true),
+		Entry("partial version", "2.10", "2.10.7", true),
+		Entry("partial version with a different minor", "2.10", "2.11.0", false),
+		Entry("wildcard", "2.10.x", "2.10.7", true),
+		Entry("wildcard with a different minor", "2.10.*", "2.9.7", false),
+		Entry("latest", "latest", "0.0.1", true),
+		Entry("latest excludes prereleases", "latest", "2.11.0-rc.1", false),
+		Entry("star", "*", "4.5.6", true),
+		Entry("tilde on a minor", "~2.10", "2.10.99", true),
+		Entry("tilde on a minor excludes the next minor", "~2.10", "2.11.0", false),
+		Entry("tilde excludes the next minor's prereleases", "~2.10", "2.11.0-rc.1", false),
+		Entry("tilde excludes prereleases", "~2.10", "2.10.6-rc.1", false),
+		Entry("tilde excludes nonstandard builds", "~2.10", "2.10.3-build.12", false),
+		Entry("tilde on a patch", "~2.10.3", "2.10.2", false),
+		Entry("tilde on a major", "~2", "2.99.0", true),
+		Entry("pessimistic operator", "~> 2.10.3", "2.10.5", true),
+		Entry("caret", "^1.2.3", "1.9.0", true),
+		Entry("caret excludes the next major", "^1.2.3", "2.0.0", false),
+		Entry("caret on zero major", "^0.2.3", "0.3.0", false),
+		Entry("caret on zero minor", "^0.0.3", "0.0.4", false),
+		Entry("range", ">=1.7.0 <1.8.0", "1.7.12", true),
+		Entry("range upper bound", ">=1.7.0 <1.8.0", "1.8.0", false),
+		Entry("range excludes prereleases of the upper bound", ">=1.7.0 <1.8.0", "1.8.0-rc.1", false),
+		Entry("range lower bound", ">=1.7.0 <1.8.0", "1.6.9", false),
+		Entry("range with commas", ">=1.7.0, <1.8.0", "1.7.0", true),
+		Entry("range with spaces after operators", ">= 1.7.0 < 1.8.0", "1.7.0", true),
+		Entry("greater than", ">1.2.3", "1.2.3", false),
+		Entry("greater than a partial version", ">1.2", "1.2.9", false),
+		Entry("less than or equal", "<=1.2.3", "1.2.3", true),
+		Entry("less than or equal a partial version", "<=1.2", "1.2.9", true),
+		Entry("not equal", "!=1.2.3", "1.2.3", false),
+		Entry("alternatives", "1.x || >=3.0.0", "3.1.0", true),
+		Entry("alternatives without a match", "1.x || >=3.0.0", "2.1.0", false),
+		Entry("prerelease lower bound", ">=1.2.3-rc.1", "1.2.3-rc.2", true),
+		Entry("prerelease lower bound excludes other prereleases", ">=1.2.3-rc.1", "1.2.4-rc.1", false),
+		Entry("prerelease lower bound includes releases", ">=1.2.3-rc.1", "1.2.4", true),
+		Entry("exact prerelease", "1.2.3-rc.1", "1.2.3-rc.1", true),
+	)
+
+	DescribeTable("invalid constraints", func(constraint string) {
+		_, err := semver.ParseConstraint(constraint)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("when empty", ""),
+		Entry("when an alternative is empty", "1.x ||"),
+		Entry("when not a version", "some-version"),
+		Entry("when a number follows a wildcard", "1.x.3"),
+		Entry("when a partial version has a prerelease", "1.2-rc.1"),
+		Entry("when an operator is used with a wildcard", ">*"),
+	)
+})
@@ -25,7 +25,23 @@ var _ = Describe("semantic versioning comparison", func() {
 		Entry("when letter on right side", "v1.2.3", "1.2.3", 0),
 		Entry("when letter on left side", "1.2.3", "v1.2.3", 0),
 		Entry("when consecutive dots on the both sides", "1..2", "1..2", 0),
-		Entry("when consecutive dots on the left side", "1..2", "1.2", 0),
-		Entry("when consecutive dots on the right side", "1.2", "1..2", 0),
+		Entry("when segments are compared numerically", "1.10.0", "1.9.0", 1),
+		Entry("when right side has a prerelease", "1.2.0", "1.2.0-rc.1", 1),
+		Entry("when left side has a prerelease", "1.2.0-rc.1", "1.2.0", -1),
+		Entry("when prerelease identifiers differ", "1.2.0-rc.1", "1.2.0-build.1", 1),
+		Entry("when prerelease numbers differ", "1.2.0-rc.2", "1.2.0-rc.10", -1),
+		Entry("when only build metadata differs", "1.2.0+build.1", "1.2.0+build.2", 0),
+		Entry("when left side has build metadata", "1.2.0+build.1", "1.2.0", 0),
+		Entry("when nonstandard pivnet build", "2.10.3-build.12", "2.10.3-build.9", 1),
+		Entry("when there is a fourth segment", "1.0.0.1", "1.0.0", 1),
+	)
+
+	DescribeTable("errors", func(s1, s2 string){
+		_, err := semver.Compare(s1, s2)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("when consecutive dots on the left side", "1..2", "1.2"),
+		Entry("when consecutive dots on the right side", "1.2", "1..2"),
+		Entry("when a segment is not a number", "1.2.a", "1.2.3"),
 	)
 })
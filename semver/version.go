@@ -0,0 +1,225 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed version number. See Parse and ParseLenient.
+type Version struct {
+	Segments   []int
+	Prerelease []string
+	Build      []string
+}
+
+// Parse parses a version as defined by Semantic Versioning 2.0.0, e.g.
+// 1.2.3-rc.1+build.5.
+func Parse(s string) (Version, error) {
+	v, err := ParseLenient(s)
+	if err != nil {
+		return Version{}, err
+	}
+
+	if strings.HasPrefix(s, "v") || strings.TrimSpace(s) != s {
+		return Version{}, fmt.Errorf("invalid semantic version '%s'", s)
+	}
+
+	if len(v.Segments) != 3 {
+		return Version{}, fmt.Errorf("invalid semantic version '%s': expected major.minor.patch", s)
+	}
+
+	core := s
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+
+	for _, segment := range strings.Split(core, ".") {
+		if hasLeadingZero(segment) {
+			return Version{}, fmt.Errorf("invalid semantic version '%s': '%s' has a leading zero", s, segment)
+		}
+	}
+
+	for _, identifier := range v.Prerelease {
+		if _, err := parseNumber(identifier); err == nil && hasLeadingZero(identifier) {
+			return Version{}, fmt.Errorf("invalid semantic version '%s': '%s' has a leading zero", s, identifier)
+		}
+	}
+
+	return v, nil
+}
+
+// ParseLenient parses versions that Pivnet uses but that are not semantic
+// versions, such as v2.10, 1.0.0.1 or 2.10.3-build.12. Any number of numeric
+// segments and a leading 'v' are accepted.
+func ParseLenient(s string) (Version, error) {
+	rest := strings.TrimPrefix(strings.TrimSpace(s), "v")
+
+	var v Version
+
+	if i := strings.Index(rest, "+"); i >= 0 {
+		build, err := parseIdentifiers(rest[i+1:])
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid build metadata in version '%s': %s", s, err.Error())
+		}
+		v.Build = build
+		rest = rest[:i]
+	}
+
+	if i := strings.Index(rest, "-"); i >= 0 {
+		prerelease, err := parseIdentifiers(rest[i+1:])
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid prerelease in version '%s': %s", s, err.Error())
+		}
+		v.Prerelease = prerelease
+		rest = rest[:i]
+	}
+
+	for _, segment := range strings.Split(rest, ".") {
+		n, err := parseNumber(segment)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version '%s': %s", s, err.Error())
+		}
+		v.Segments = append(v.Segments, n)
+	}
+
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 depending on whether v has lower, equal or
+// higher precedence than o. Missing segments count as zero and build
+// metadata is ignored.
+func (v Version) Compare(o Version) int {
+	length := len(v.Segments)
+	if len(o.Segments) > length {
+		length = len(o.Segments)
+	}
+
+	for i := 0; i < length; i++ {
+		c := compareInts(segmentAt(v.Segments, i), segmentAt(o.Segments, i))
+		if c != 0 {
+			return c
+		}
+	}
+
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+func (v Version) String() string {
+	var segments []string
+	for _, s := range v.Segments {
+		segments = append(segments, strconv.Itoa(s))
+	}
+
+	s := strings.Join(segments, ".")
+
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+
+	if len(v.Build) > 0 {
+		s += "+" + strings.Join(v.Build, ".")
+	}
+
+	return s
+}
+
+func parseNumber(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty segment")
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("segment '%s' is not a number", s)
+		}
+	}
+
+	return strconv.Atoi(s)
+}
+
+func hasLeadingZero(s string) bool {
+	return len(s) > 1 && s[0] == '0'
+}
+
+func parseIdentifiers(s string) ([]string, error) {
+	identifiers := strings.Split(s, ".")
+
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			return nil, fmt.Errorf("empty identifier")
+		}
+
+		for _, r := range identifier {
+			if !isIdentifierRune(r) {
+				return nil, fmt.Errorf("identifier '%s' contains '%c'", identifier, r)
+			}
+		}
+	}
+
+	return identifiers, nil
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '-' ||
+		(r >= '0' && r <= '9') ||
+		(r >= 'a' && r <= 'z') ||
+		(r >= 'A' && r <= 'Z')
+}
+
+func segmentAt(segments []int, i int) int {
+	if i < len(segments) {
+		return segments[i]
+	}
+	return 0
+}
+
+func compareInts(a, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease orders prerelease identifiers as described in
+// https://semver.org/#spec-item-11. A version without a prerelease is higher
+// than one with.
+func comparePrerelease(p1, p2 []string) int {
+	if len(p1) == 0 && len(p2) == 0 {
+		return 0
+	}
+	if len(p1) == 0 {
+		return 1
+	}
+	if len(p2) == 0 {
+		return -1
+	}
+
+	for i := 0; i < len(p1) && i < len(p2); i++ {
+		c := compareIdentifiers(p1[i], p2[i])
+		if c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(p1), len(p2))
+}
+
+func compareIdentifiers(i1, i2 string) int {
+	n1, err1 := parseNumber(i1)
+	n2, err2 := parseNumber(i2)
+
+	switch {
+	case err1 == nil && err2 == nil:
+		return compareInts(n1, n2)
+	case err1 == nil:
+		return -1
+	case err2 == nil:
+		return 1
+	default:
+		return strings.Compare(i1, i2)
+	}
+}
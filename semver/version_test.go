@@ -0,0 +1,94 @@
+package semver_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/pivnet-cli/v3/semver"
+)
+
+var _ = Describe("Version", func() {
+	Describe("Parse", func() {
+		It("parses semantic versions", func() {
+			v, err := semver.Parse("1.2.3-rc.1+build.5")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(v.Segments).To(Equal([]int{1, 2, 3}))
+			Expect(v.Prerelease).To(Equal([]string{"rc", "1"}))
+			Expect(v.Build).To(Equal([]string{"build", "5"}))
+		})
+
+		It("accepts leading zeros in build metadata", func() {
+			_, err := semver.Parse("1.2.3+001")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("invalid semantic versions", func(s string) {
+			_, err := semver.Parse(s)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("when empty", ""),
+			Entry("when there is a leading v", "v1.2.3"),
+			Entry("when the patch is missing", "1.2"),
+			Entry("when there is a fourth segment", "1.0.0.1"),
+			Entry("when a segment has a leading zero", "1.02.3"),
+			Entry("when a numeric prerelease identifier has a leading zero", "1.2.3-rc.01"),
+			Entry("when there is surrounding whitespace", " 1.2.3"),
+		)
+	})
+
+	Describe("ParseLenient", func() {
+		It("parses segments, prerelease and build metadata", func() {
+			v, err := semver.ParseLenient("v2.10.3-build.12+sha.abc")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(v.Segments).To(Equal([]int{2, 10, 3}))
+			Expect(v.Prerelease).To(Equal([]string{"build", "12"}))
+			Expect(v.Build).To(Equal([]string{"sha", "abc"}))
+			Expect(v.String()).To(Equal("2.10.3-build.12+sha.abc"))
+		})
+
+		It("accepts any number of segments", func() {
+			v, err := semver.ParseLenient("1.0.0.1")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(v.Segments).To(Equal([]int{1, 0, 0, 1}))
+		})
+
+		DescribeTable("invalid versions", func(s string) {
+			_, err := semver.ParseLenient(s)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("when empty", ""),
+			Entry("when a segment is not a number", "1.a.3"),
+			Entry("when a segment is empty", "1..3"),
+			Entry("when the prerelease is empty", "1.2.3-"),
+			Entry("when a prerelease identifier is empty", "1.2.3-rc..1"),
+			Entry("when the prerelease has invalid characters", "1.2.3-rc_1"),
+		)
+	})
+
+	DescribeTable("Compare", func(s1, s2 string, expected int) {
+		v1, err := semver.ParseLenient(s1)
+		Expect(err).NotTo(HaveOccurred())
+
+		v2, err := semver.ParseLenient(s2)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(v1.Compare(v2)).To(Equal(expected))
+		Expect(v2.Compare(v1)).To(Equal(-expected))
+	},
+		Entry("when equal", "1.2.3", "1.2.3", 0),
+		Entry("when the patch is greater", "1.2.4", "1.2.3", 1),
+		Entry("when the minor is greater numerically", "1.10.0", "1.9.0", 1),
+		Entry("when segments are missing", "1.2", "1.2.0", 0),
+		Entry("when there is a fourth segment", "1.0.0.1", "1.0.0", 1),
+		Entry("when only one has a prerelease", "1.2.0", "1.2.0-rc.1", 1),
+		Entry("when prerelease numbers differ", "1.2.0-rc.10", "1.2.0-rc.9", 1),
+		Entry("when prerelease identifiers differ", "1.2.0-rc.1", "1.2.0-beta.2", 1),
+		Entry("when a numeric identifier is compared to a string", "1.2.0-alpha", "1.2.0-1", 1),
+		Entry("when one prerelease has more identifiers", "1.2.0-alpha.1", "1.2.0-alpha", 1),
+		Entry("when only build metadata differs", "1.2.0+1", "1.2.0+2", 0),
+	)
+})